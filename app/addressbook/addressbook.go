@@ -0,0 +1,245 @@
+package addressbook
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/raedahgroup/godcr/app/jsonfile"
+)
+
+// FileName is the name of the file in the app data directory that contacts are saved to.
+const FileName = "addressbook.json"
+
+// csvHeader is the first row of csv files that contacts are exported to and imported from.
+var csvHeader = []string{"name", "address", "network", "notes"}
+
+// Contact is a named address that funds can be sent to.
+type Contact struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Network string `json:"network"`
+	Notes   string `json:"notes"`
+}
+
+// AddressValidator checks if an address is valid for the network of the current wallet.
+// `walletcore.Wallet.ValidateAddress` satisfies this type.
+type AddressValidator func(address string) (bool, error)
+
+// AddressBook holds the contacts saved by the user.
+// Contact names are unique per network, ignoring case.
+// Contacts are saved to a json file after every change so that they are shared by all godcr interfaces.
+type AddressBook struct {
+	filePath string
+
+	mu       sync.RWMutex
+	contacts []*Contact
+}
+
+// Load reads the contacts previously saved in `appDataDir`.
+// If `appDataDir` is empty, contacts are only kept in memory.
+func Load(appDataDir string) (*AddressBook, error) {
+	addressBook := &AddressBook{}
+	if appDataDir == "" {
+		return addressBook, nil
+	}
+
+	addressBook.filePath = filepath.Join(appDataDir, FileName)
+	fileContent, err := ioutil.ReadFile(addressBook.filePath)
+	if os.IsNotExist(err) {
+		return addressBook, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading address book file: %s", err.Error())
+	}
+
+	if err = json.Unmarshal(fileContent, &addressBook.contacts); err != nil {
+		return nil, fmt.Errorf("error reading address book file: %s", err.Error())
+	}
+	return addressBook, nil
+}
+
+// Add validates and saves a new contact.
+// The contact's address is checked with `validateAddress` which should validate addresses for the contact's network.
+func (addressBook *AddressBook) Add(contact Contact, validateAddress AddressValidator) error {
+	addressBook.mu.Lock()
+	defer addressBook.mu.Unlock()
+
+	if err := addressBook.validateContact(&contact, validateAddress); err != nil {
+		return err
+	}
+
+	addressBook.contacts = append(addressBook.contacts, &contact)
+	return addressBook.save()
+}
+
+// Remove deletes the contact with `name` on `network`.
+func (addressBook *AddressBook) Remove(name, network string) error {
+	addressBook.mu.Lock()
+	defer addressBook.mu.Unlock()
+
+	for i, contact := range addressBook.contacts {
+		if contact.Network == network && strings.EqualFold(contact.Name, name) {
+			addressBook.contacts = append(addressBook.contacts[:i], addressBook.contacts[i+1:]...)
+			return addressBook.save()
+		}
+	}
+	return fmt.Errorf("no contact named %s", name)
+}
+
+// Contacts returns the contacts saved for `network`, sorted by name.
+func (addressBook *AddressBook) Contacts(network string) []Contact {
+	addressBook.mu.RLock()
+	defer addressBook.mu.RUnlock()
+
+	var contacts []Contact
+	for _, contact := range addressBook.contacts {
+		if contact.Network == network {
+			contacts = append(contacts, *contact)
+		}
+	}
+
+	sort.Slice(contacts, func(i, j int) bool {
+		return strings.ToLower(contacts[i].Name) < strings.ToLower(contacts[j].Name)
+	})
+	return contacts
+}
+
+// ContactByName returns the contact with `name` on `network`, ignoring case.
+// The second return value is false if there is no such contact.
+func (addressBook *AddressBook) ContactByName(name, network string) (Contact, bool) {
+	addressBook.mu.RLock()
+	defer addressBook.mu.RUnlock()
+
+	if contact := addressBook.findContact(name, network); contact != nil {
+		return *contact, true
+	}
+	return Contact{}, false
+}
+
+// ImportCSV reads contacts from `reader` and adds them to the address book.
+// The csv data must start with the header row `name,address,network,notes`.
+// Rows with an empty network are imported for `network`, rows for other networks are rejected
+// because their addresses cannot be checked with `validateAddress`.
+// No contact is added if any row is invalid. Returns the number of contacts imported.
+func (addressBook *AddressBook) ImportCSV(reader io.Reader, network string, validateAddress AddressValidator) (int, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = len(csvHeader)
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return 0, fmt.Errorf("error reading csv data: %s", err.Error())
+	}
+	if len(records) == 0 || !strings.EqualFold(strings.Join(records[0], ","), strings.Join(csvHeader, ",")) {
+		return 0, fmt.Errorf("csv data must begin with the header row: %s", strings.Join(csvHeader, ","))
+	}
+
+	addressBook.mu.Lock()
+	defer addressBook.mu.Unlock()
+
+	// validate all rows before adding any, so that a bad row does not leave a partial import
+	existingContacts := addressBook.contacts
+	defer func() {
+		if err != nil {
+			addressBook.contacts = existingContacts
+		}
+	}()
+	addressBook.contacts = append([]*Contact{}, existingContacts...)
+
+	for i, record := range records[1:] {
+		contact := &Contact{
+			Name:    record[0],
+			Address: record[1],
+			Network: record[2],
+			Notes:   record[3],
+		}
+		if contact.Network == "" {
+			contact.Network = network
+		}
+		if contact.Network != network {
+			err = fmt.Errorf("row %d: contact %s is for %s, only %s contacts can be imported", i+2, contact.Name, contact.Network, network)
+			return 0, err
+		}
+		if err = addressBook.validateContact(contact, validateAddress); err != nil {
+			err = fmt.Errorf("row %d: %s", i+2, err.Error())
+			return 0, err
+		}
+		addressBook.contacts = append(addressBook.contacts, contact)
+	}
+
+	if err = addressBook.save(); err != nil {
+		return 0, err
+	}
+	return len(records) - 1, nil
+}
+
+// ExportCSV writes the contacts saved for `network` to `writer` as csv, beginning with a header row.
+func (addressBook *AddressBook) ExportCSV(writer io.Writer, network string) error {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, contact := range addressBook.Contacts(network) {
+		err := csvWriter.Write([]string{contact.Name, contact.Address, contact.Network, contact.Notes})
+		if err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// validateContact returns an error if `contact` cannot be added to the address book, the caller must hold the lock.
+func (addressBook *AddressBook) validateContact(contact *Contact, validateAddress AddressValidator) error {
+	contact.Name = strings.TrimSpace(contact.Name)
+	contact.Address = strings.TrimSpace(contact.Address)
+
+	if contact.Name == "" {
+		return errors.New("contact name cannot be empty")
+	}
+	if contact.Network == "" {
+		return errors.New("contact network cannot be empty")
+	}
+	if addressBook.findContact(contact.Name, contact.Network) != nil {
+		return fmt.Errorf("a contact named %s already exists", contact.Name)
+	}
+
+	isValid, err := validateAddress(contact.Address)
+	if err != nil {
+		return fmt.Errorf("error validating address of %s: %s", contact.Name, err.Error())
+	}
+	if !isValid {
+		return fmt.Errorf("%s is not a valid %s address", contact.Address, contact.Network)
+	}
+	return nil
+}
+
+// findContact returns the contact with `name` on `network` or nil, the caller must hold the lock.
+func (addressBook *AddressBook) findContact(name, network string) *Contact {
+	for _, contact := range addressBook.contacts {
+		if contact.Network == network && strings.EqualFold(contact.Name, name) {
+			return contact
+		}
+	}
+	return nil
+}
+
+// save writes the contacts to file, the caller must hold the write lock.
+func (addressBook *AddressBook) save() error {
+	if addressBook.filePath == "" {
+		return nil
+	}
+
+	if err := jsonfile.Save(addressBook.filePath, addressBook.contacts); err != nil {
+		return fmt.Errorf("error saving address book: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package addressbook
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testNetwork = "testnet3"
+
+// validAddress accepts addresses that start with "Ts", like testnet addresses.
+func validAddress(address string) (bool, error) {
+	return strings.HasPrefix(address, "Ts"), nil
+}
+
+func testDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "godcr-addressbook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func contactNames(contacts []Contact) string {
+	names := make([]string, len(contacts))
+	for i, contact := range contacts {
+		names[i] = contact.Name
+	}
+	return strings.Join(names, ",")
+}
+
+func TestAddressBookSaved(t *testing.T) {
+	dir, cleanup := testDir(t)
+	defer cleanup()
+
+	addressBook, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"bob", "Alice", "carol"} {
+		contact := Contact{Name: name, Address: "Ts" + name, Network: testNetwork}
+		if err = addressBook.Add(contact, validAddress); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = addressBook.Remove("CAROL", testNetwork); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = addressBook.Add(Contact{Name: "alice", Address: "Tsother", Network: testNetwork}, validAddress); err == nil {
+		t.Fatal("added a contact with the name of an existing contact")
+	}
+	if err = addressBook.Add(Contact{Name: "dave", Address: "Dsdave", Network: testNetwork}, validAddress); err == nil {
+		t.Fatal("added a contact with an invalid address")
+	}
+
+	reloaded, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := contactNames(reloaded.Contacts(testNetwork)); names != "Alice,bob" {
+		t.Fatalf("reloaded contacts %s, want Alice,bob", names)
+	}
+}
+
+func TestImportCSV(t *testing.T) {
+	dir, cleanup := testDir(t)
+	defer cleanup()
+
+	addressBook, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = addressBook.Add(Contact{Name: "alice", Address: "Tsalice", Network: testNetwork}, validAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		csv     string
+		wantErr bool
+	}{
+		{
+			name:    "missing header",
+			csv:     "bob,Tsbob,,\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid address",
+			csv:     "name,address,network,notes\nbob,Tsbob,,\ncarol,Dscarol,,\n",
+			wantErr: true,
+		},
+		{
+			name:    "other network",
+			csv:     "name,address,network,notes\nbob,Tsbob,,\ncarol,Dscarol,mainnet,\n",
+			wantErr: true,
+		},
+		{
+			name:    "existing name",
+			csv:     "name,address,network,notes\nbob,Tsbob,,\nALICE,Tsalice2,,\n",
+			wantErr: true,
+		},
+		{
+			name:    "duplicate rows",
+			csv:     "name,address,network,notes\nbob,Tsbob,,\nbob,Tsbob2,,\n",
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			csv:  "name,address,network,notes\nbob,Tsbob,,friend\ncarol,Tscarol,testnet3,\n",
+		},
+	}
+
+	for _, test := range tests {
+		imported, err := addressBook.ImportCSV(strings.NewReader(test.csv), testNetwork, validAddress)
+		if test.wantErr {
+			if err == nil {
+				t.Fatalf("%s: expected an error", test.name)
+			}
+			// no contact is kept from a failed import
+			if names := contactNames(addressBook.Contacts(testNetwork)); names != "alice" {
+				t.Fatalf("%s: contacts after failed import %s, want alice", test.name, names)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if imported != 2 {
+			t.Fatalf("%s: imported %d contacts, want 2", test.name, imported)
+		}
+	}
+
+	reloaded, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := contactNames(reloaded.Contacts(testNetwork)); names != "alice,bob,carol" {
+		t.Fatalf("reloaded contacts %s, want alice,bob,carol", names)
+	}
+}
+
+func TestImportCSVSaveError(t *testing.T) {
+	dir, cleanup := testDir(t)
+	defer cleanup()
+
+	addressBook, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = addressBook.Add(Contact{Name: "alice", Address: "Tsalice", Network: testNetwork}, validAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	// replacing the address book file with a non-empty directory makes saving fail
+	filePath := filepath.Join(dir, FileName)
+	if err = os.Remove(filePath); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(filepath.Join(filePath, "blocked"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	csv := "name,address,network,notes\nbob,Tsbob,,\n"
+	if _, err = addressBook.ImportCSV(strings.NewReader(csv), testNetwork, validAddress); err == nil {
+		t.Fatal("expected an error saving the imported contacts")
+	}
+	if names := contactNames(addressBook.Contacts(testNetwork)); names != "alice" {
+		t.Fatalf("contacts after failed save %s, want alice", names)
+	}
+}
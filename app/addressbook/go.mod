@@ -0,0 +1,7 @@
+module github.com/raedahgroup/godcr/app/addressbook
+
+go 1.12
+
+require github.com/raedahgroup/godcr/app/jsonfile v0.0.0-00010101000000-000000000000
+
+replace github.com/raedahgroup/godcr/app/jsonfile => ../jsonfile
@@ -13,36 +13,68 @@ var AppConfigFilePath = filepath.Join(defaultAppDataDir, "godcr.conf")
 
 // ConfFileOptions holds the top-level options/flags that should be set in config file rather than in command-line
 type ConfFileOptions struct {
-	AppDataDir       string `long:"appdata" description:"Path to application data directory."`
-	DefaultWalletDir string `long:"wallet" description:"Directory of wallet to connect to by default."`
-	WalletRPCServer  string `long:"walletrpcserver" description:"RPC server address of running dcrwallet daemon. Required to connect to wallet via dcrwallet."`
-	WalletRPCCert    string `long:"walletrpccert" description:"Path to dcrwallet certificate file. Required if walletrpcserver is set."`
-	NoWalletRPCTLS   bool   `long:"nowalletrpctls" description:"Disable TLS when connecting to dcrwallet daemon via RPC."`
-	HTTPHost         string `long:"httphost" description:"HTTP server host address or IP when running godcr in http mode."`
-	HTTPPort         string `long:"httpport" description:"HTTP server port when running godcr in http mode."`
-	DebugLevel       string `long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
-
-	Settings `group:"Settings"`
+	AppDataDir          string   `long:"appdata" description:"Path to application data directory."`
+	DefaultWalletDir    string   `long:"wallet" description:"Name (from wallets) or directory of wallet to connect to by default. Can also be passed on the command-line to use another wallet."`
+	Wallets             []string `long:"wallets" description:"Wallets to open together, as name:directory, e.g. personal:/home/me/.godcr/mainnet. Repeat the option for each wallet."`
+	WalletRPCServer     string   `long:"walletrpcserver" description:"RPC server address of running dcrwallet daemon. Required to connect to wallet via dcrwallet."`
+	WalletRPCCert       string   `long:"walletrpccert" description:"Path to dcrwallet certificate file. Required if walletrpcserver is set."`
+	NoWalletRPCTLS      bool     `long:"nowalletrpctls" description:"Disable TLS when connecting to dcrwallet daemon via RPC."`
+	WalletRPCClientCert string   `long:"walletrpcclientcert" description:"Path to the client certificate presented to dcrwallet. Required if dcrwallet is run with --clientcafile."`
+	WalletRPCClientKey  string   `long:"walletrpcclientkey" description:"Path to the private key of walletrpcclientcert."`
+	SyncMode            string   `long:"syncmode" description:"How wallets opened in-process sync with the network {spv, rpc}. rpc syncs only with the dcrd node set by dcrdrpcserver." choice:"spv" choice:"rpc"`
+	SPVConnect          []string `long:"spvconnect" description:"Peer to connect to for spv sync, as host or host:port. Repeat the option for each peer. Peers are discovered automatically if not set."`
+	DcrdRPCServer       string   `long:"dcrdrpcserver" description:"RPC server address of the dcrd node to sync with when syncmode is rpc, e.g. 127.0.0.1:9109."`
+	DcrdRPCUser         string   `long:"dcrdrpcuser" description:"Username for the dcrd RPC server."`
+	DcrdRPCPass         string   `long:"dcrdrpcpass" description:"Password for the dcrd RPC server."`
+	DcrdRPCCert         string   `long:"dcrdrpccert" description:"Path to dcrd certificate file."`
+	NoDcrdRPCTLS        bool     `long:"nodcrdrpctls" description:"Disable TLS when connecting to dcrd via RPC."`
+	HTTPHost            string   `long:"httphost" description:"HTTP server host address or IP when running godcr in http mode."`
+	HTTPPort            string   `long:"httpport" description:"HTTP server port when running godcr in http mode."`
+	DebugLevel          string   `long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
+	UseMockWallet       bool     `long:"usemockwallet" description:"Use an in-memory wallet with sample data instead of a real wallet. The spending passphrase is 'mockwallet'."`
+
+	Settings    `group:"Settings"`
+	TicketBuyer TicketBuyerConfig `group:"TicketBuyer"`
 }
 
 type Settings struct {
 	SpendUnconfirmed                    bool     `long:"spendunconfirmed" description:"Spend unconfirmed funds"`
 	ShowIncomingTransactionNotification bool     `long:"incomingtxnotification" description:"Show incoming transaction notification"`
 	ShowNewBlockNotification            bool     `long:"newblocknotification" description:"Show new block notification"`
-	CurrencyConverter                   string   `long:"currencyconverter" description:"Currency Converter {none, bitrex}" choice:"none" choice:"bitrex" default:"none"`
+	CurrencyConverter                   string   `long:"currencyconverter" description:"Source of the exchange rates used to show DCR amounts in fiat {none, bittrex, coingecko, coinpaprika, file}" choice:"none" choice:"bittrex" choice:"bitrex" choice:"coingecko" choice:"coinpaprika" choice:"file" default:"none"`
+	FiatCurrency                        string   `long:"fiatcurrency" description:"Fiat currency that DCR amounts are converted to, e.g. USD, EUR, GBP, NGN"`
+	ExchangeRatesFile                   string   `long:"exchangeratesfile" description:"Path to a json file mapping currency codes to the value of 1 DCR, used when currencyconverter is file"`
 	HiddenAccounts                      []uint32 `long:"hiddenaccounts" description:"Accounts with ignored balances"`
 	DefaultAccount                      uint32   `long:"defaultaccount" description:"Default account for incoming and outgoing transactions"`
+	CoinSelection                       string   `long:"coinselection" description:"Strategy for automatically selecting the inputs of transactions {largest-first, smallest-first, branch-and-bound, oldest-first, privacy}" choice:"largest-first" choice:"smallest-first" choice:"branch-and-bound" choice:"oldest-first" choice:"privacy"`
+}
+
+// TicketBuyerConfig holds the options used by the automatic ticket buyer running in the web and nuklear interfaces
+type TicketBuyerConfig struct {
+	Enabled           bool    `long:"enabled" description:"Start the ticket buyer of each wallet on launch, the spending passphrases are requested before the interface starts and tickets are bought once the wallet is synced"`
+	BalanceToMaintain float64 `long:"balancetomaintain" description:"Amount of funds in DCR to keep in the source account, tickets are only bought with funds above this amount"`
+	MaxPrice          float64 `long:"maxprice" description:"Maximum ticket price in DCR to buy tickets at, 0 means no limit"`
+	SourceAccount     uint32  `long:"sourceaccount" description:"Account to buy tickets from"`
+	VSPHost           string  `long:"vsphost" description:"Host of the voting service provider to buy tickets through, leave empty for solo voting"`
+	MaxPerBlock       uint32  `long:"maxperblock" description:"Maximum number of tickets to buy per block"`
 }
 
 func defaultFileOptions() ConfFileOptions {
 	return ConfFileOptions{
 		AppDataDir:    defaultAppDataDir,
 		WalletRPCCert: defaultRPCCertFile,
+		SyncMode:      defaultSyncMode,
+		DcrdRPCCert:   defaultDcrdRPCCertFile,
 		HTTPHost:      defaultHTTPHost,
 		HTTPPort:      defaultHTTPPort,
 		DebugLevel:    defaultLogLevel,
 		Settings: Settings{
 			CurrencyConverter: defaultCurrencyConverter,
+			FiatCurrency:      defaultFiatCurrency,
+			CoinSelection:     defaultCoinSelection,
+		},
+		TicketBuyer: TicketBuyerConfig{
+			MaxPerBlock: defaultTicketBuyerMaxPerBlock,
 		},
 	}
 }
@@ -15,12 +15,18 @@ const (
 	defaultHTTPPort          = "7778"
 	defaultLogLevel          = "info"
 	defaultCurrencyConverter = "none"
+	defaultFiatCurrency      = "USD"
+	defaultCoinSelection     = "largest-first"
+	defaultSyncMode          = "spv"
+
+	defaultTicketBuyerMaxPerBlock = 1
 )
 
 var (
 	defaultAppDataDir          = dcrutil.AppDataDir("godcr", false)
 	DefaultDcrwalletAppDataDir = dcrutil.AppDataDir("dcrwallet", false)
 	defaultRPCCertFile         = filepath.Join(DefaultDcrwalletAppDataDir, "rpc.cert")
+	defaultDcrdRPCCertFile     = filepath.Join(dcrutil.AppDataDir("dcrd", false), "rpc.cert")
 	LogFile                    = filepath.Join(defaultAppDataDir, "logs/godcr.log")
 )
 
@@ -32,6 +38,7 @@ type Config struct {
 
 // CommandLineOptions holds the top-level options/flags that are displayed on the command-line menu
 type CommandLineOptions struct {
+	MockWallet bool `long:"mockwallet" description:"Use an in-memory wallet with sample data instead of a real wallet. The spending passphrase is 'mockwallet'."`
 	CliOptions
 }
 
@@ -103,10 +110,19 @@ func LoadConfig() (*Config, []string, error) {
 	return &config, unknownArgs, err
 }
 
+// commandLineConfigFileOptions are config file options that may also be passed on the command-line,
+// e.g. `--wallet business` to use another wallet than the default for one run.
+var commandLineConfigFileOptions = []string{"--wallet"}
+
 // hasConfigFileOption checks if an unknown arg found in command-line is a config file option that should only be set in the config file
 func hasConfigFileOption(commandLineArgs []string) bool {
 	configFileOptions := configFileOptions()
 	isConfigFileOption := func(option string) bool {
+		for _, commandLineOption := range commandLineConfigFileOptions {
+			if strings.EqualFold(commandLineOption, option) {
+				return false
+			}
+		}
 		for _, configFileOption := range configFileOptions {
 			if strings.EqualFold(configFileOption, option) {
 				return true
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NamedWallet is a wallet listed in the wallets config option.
+type NamedWallet struct {
+	Name string
+	Dir  string
+}
+
+// NamedWallets parses the wallets config option, each value is a wallet name and directory separated by a colon.
+// An error is returned if a value is not in that format or if a name is used more than once.
+func (options ConfFileOptions) NamedWallets() ([]NamedWallet, error) {
+	namedWallets := make([]NamedWallet, 0, len(options.Wallets))
+	for _, option := range options.Wallets {
+		nameAndDir := strings.SplitN(option, ":", 2)
+		if len(nameAndDir) != 2 || strings.TrimSpace(nameAndDir[0]) == "" || strings.TrimSpace(nameAndDir[1]) == "" {
+			return nil, fmt.Errorf("invalid wallets option %q, expected name:directory", option)
+		}
+
+		namedWallet := NamedWallet{
+			Name: strings.TrimSpace(nameAndDir[0]),
+			Dir:  strings.TrimSpace(nameAndDir[1]),
+		}
+		for _, existing := range namedWallets {
+			if strings.EqualFold(existing.Name, namedWallet.Name) {
+				return nil, fmt.Errorf("wallet name %s is used more than once in the wallets option", namedWallet.Name)
+			}
+		}
+		namedWallets = append(namedWallets, namedWallet)
+	}
+
+	return namedWallets, nil
+}
+
+// WalletDir returns the directory of the wallet to connect to by default.
+// The wallet option may hold the name of one of the named wallets or a wallet directory.
+func (options ConfFileOptions) WalletDir() (string, error) {
+	if options.DefaultWalletDir == "" {
+		return "", nil
+	}
+
+	namedWallets, err := options.NamedWallets()
+	if err != nil {
+		return "", err
+	}
+	for _, namedWallet := range namedWallets {
+		if strings.EqualFold(namedWallet.Name, options.DefaultWalletDir) {
+			return namedWallet.Dir, nil
+		}
+	}
+
+	return options.DefaultWalletDir, nil
+}
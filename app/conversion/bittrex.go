@@ -0,0 +1,79 @@
+package conversion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// BittrexBaseURL is the address of the bittrex API used if Bittrex.BaseURL is not set.
+const BittrexBaseURL = "https://api.bittrex.com"
+
+// Bittrex gets DCR rates from the bittrex exchange.
+// Bittrex has no DCR to fiat market, so the rate is derived from the BTC-DCR and USD-BTC markets and only USD is supported.
+type Bittrex struct {
+	// BaseURL is the address of the bittrex API, BittrexBaseURL is used if it is empty.
+	BaseURL string
+
+	// HTTPClient is used to send requests to the API, a client with a 5 second timeout is used if it is nil.
+	HTTPClient *http.Client
+}
+
+// marketSummaryResult holds the response of the bittrex getmarketsummary API.
+type marketSummaryResult struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Result  []marketSummary `json:"result"`
+}
+
+// marketSummary defines the rate for a single market.
+type marketSummary struct {
+	MarketName string
+	Rate       float64 `json:"Last"`
+}
+
+// Name returns SourceBittrex.
+func (bittrex *Bittrex) Name() string {
+	return SourceBittrex
+}
+
+// DcrRate returns the value of 1 DCR in USD, other currencies return ErrUnsupportedCurrency.
+func (bittrex *Bittrex) DcrRate(ctx context.Context, currency string) (float64, error) {
+	if NormalizeCurrency(currency) != "USD" {
+		return 0, ErrUnsupportedCurrency
+	}
+
+	dcrInBtc, err := bittrex.marketRate(ctx, "btc-dcr")
+	if err != nil {
+		return 0, err
+	}
+
+	btcInUsd, err := bittrex.marketRate(ctx, "usd-btc")
+	if err != nil {
+		return 0, err
+	}
+
+	return dcrInBtc * btcInUsd, nil
+}
+
+func (bittrex *Bittrex) marketRate(ctx context.Context, marketName string) (float64, error) {
+	baseURL := bittrex.BaseURL
+	if baseURL == "" {
+		baseURL = BittrexBaseURL
+	}
+	url := fmt.Sprintf("%s/api/v1.1/public/getmarketsummary?market=%s", baseURL, marketName)
+
+	var result marketSummaryResult
+	if err := getJSON(ctx, bittrex.HTTPClient, url, &result); err != nil {
+		return 0, err
+	}
+	if !result.Success {
+		return 0, errors.New(result.Message)
+	}
+	if len(result.Result) == 0 {
+		return 0, fmt.Errorf("no summary returned for market %s", marketName)
+	}
+
+	return result.Result[0].Rate, nil
+}
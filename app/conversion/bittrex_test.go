@@ -0,0 +1,68 @@
+package conversion
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBittrexDcrRate(t *testing.T) {
+	_, server := newTestAPI(map[string]string{
+		"/api/v1.1/public/getmarketsummary?market=btc-dcr": `{"success":true,"message":"","result":[{"MarketName":"BTC-DCR","Last":0.002}]}`,
+		"/api/v1.1/public/getmarketsummary?market=usd-btc": `{"success":true,"message":"","result":[{"MarketName":"USD-BTC","Last":10000}]}`,
+	})
+	defer server.Close()
+
+	bittrex := &Bittrex{BaseURL: server.URL}
+	rate, err := bittrex.DcrRate(context.Background(), "usd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate != 20 {
+		t.Errorf("got rate %f, want 20", rate)
+	}
+
+	if _, err = bittrex.DcrRate(context.Background(), "EUR"); err != ErrUnsupportedCurrency {
+		t.Errorf("got error %v for EUR, want %v", err, ErrUnsupportedCurrency)
+	}
+}
+
+func TestBittrexErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses map[string]string
+	}{
+		{
+			name: "unsuccessful response",
+			responses: map[string]string{
+				"/api/v1.1/public/getmarketsummary?market=btc-dcr": `{"success":false,"message":"INVALID_MARKET","result":null}`,
+			},
+		},
+		{
+			name: "no market summary",
+			responses: map[string]string{
+				"/api/v1.1/public/getmarketsummary?market=btc-dcr": `{"success":true,"message":"","result":[]}`,
+			},
+		},
+		{
+			name: "second market unavailable",
+			responses: map[string]string{
+				"/api/v1.1/public/getmarketsummary?market=btc-dcr": `{"success":true,"message":"","result":[{"MarketName":"BTC-DCR","Last":0.002}]}`,
+			},
+		},
+		{
+			name: "invalid json",
+			responses: map[string]string{
+				"/api/v1.1/public/getmarketsummary?market=btc-dcr": `{"success":`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		_, server := newTestAPI(test.responses)
+		bittrex := &Bittrex{BaseURL: server.URL}
+		if rate, err := bittrex.DcrRate(context.Background(), "USD"); err == nil {
+			t.Errorf("%s: got rate %f, want an error", test.name, rate)
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,64 @@
+package conversion
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CachedSource wraps a RateSource and reuses the rates it returns until they are older than the cache TTL,
+// so that rates are not fetched every time a page is displayed.
+type CachedSource struct {
+	source RateSource
+	ttl    time.Duration
+
+	// now returns the current time, it is replaced to control the age of cached rates.
+	now func() time.Time
+
+	mu    sync.Mutex
+	rates map[string]cachedRate
+}
+
+type cachedRate struct {
+	rate      float64
+	fetchedAt time.Time
+}
+
+// NewCachedSource returns a source that caches the rates returned by `source` for `ttl`.
+func NewCachedSource(source RateSource, ttl time.Duration) *CachedSource {
+	return &CachedSource{
+		source: source,
+		ttl:    ttl,
+		now:    time.Now,
+		rates:  make(map[string]cachedRate),
+	}
+}
+
+// Name returns the name of the cached source.
+func (cache *CachedSource) Name() string {
+	return cache.source.Name()
+}
+
+// DcrRate returns the cached rate for `currency` or fetches it from the cached source if it is expired or not cached.
+// Failed requests are not cached.
+func (cache *CachedSource) DcrRate(ctx context.Context, currency string) (float64, error) {
+	currency = NormalizeCurrency(currency)
+
+	cache.mu.Lock()
+	cached, ok := cache.rates[currency]
+	cache.mu.Unlock()
+	if ok && cache.now().Sub(cached.fetchedAt) < cache.ttl {
+		return cached.rate, nil
+	}
+
+	rate, err := cache.source.DcrRate(ctx, currency)
+	if err != nil {
+		return 0, err
+	}
+
+	cache.mu.Lock()
+	cache.rates[currency] = cachedRate{rate: rate, fetchedAt: cache.now()}
+	cache.mu.Unlock()
+
+	return rate, nil
+}
@@ -0,0 +1,98 @@
+package conversion
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCachedSourceTTL(t *testing.T) {
+	api, server := newTestAPI(map[string]string{
+		"/v1/tickers/dcr-decred?quotes=USD": `{"quotes":{"USD":{"price":20}}}`,
+		"/v1/tickers/dcr-decred?quotes=EUR": `{"quotes":{"EUR":{"price":18}}}`,
+	})
+	defer server.Close()
+
+	now := time.Date(2019, 8, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewCachedSource(&CoinPaprika{BaseURL: server.URL}, time.Minute)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	fetchRate := func(currency string, want float64, wantRequests int) {
+		t.Helper()
+		rate, err := cache.DcrRate(context.Background(), currency)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rate != want {
+			t.Errorf("got %s rate %f, want %f", currency, rate, want)
+		}
+		if api.requestCount() != wantRequests {
+			t.Errorf("api received %d requests, want %d", api.requestCount(), wantRequests)
+		}
+	}
+
+	fetchRate("USD", 20, 1)
+
+	// cached rates are used until they expire
+	now = now.Add(59 * time.Second)
+	fetchRate("usd", 20, 1)
+
+	// each currency is cached separately
+	fetchRate("EUR", 18, 2)
+
+	// expired rates are fetched again
+	api.setResponse("/v1/tickers/dcr-decred?quotes=USD", `{"quotes":{"USD":{"price":21}}}`)
+	now = now.Add(time.Second)
+	fetchRate("USD", 21, 3)
+	fetchRate("USD", 21, 3)
+}
+
+func TestCachedSourceDoesNotCacheErrors(t *testing.T) {
+	api, server := newTestAPI(map[string]string{})
+	defer server.Close()
+
+	cache := NewCachedSource(&CoinPaprika{BaseURL: server.URL}, time.Minute)
+
+	if _, err := cache.DcrRate(context.Background(), "USD"); err == nil {
+		t.Fatal("expected an error when the api is unavailable")
+	}
+
+	// the source is asked again once it is available, rather than returning the earlier error
+	api.setResponse("/v1/tickers/dcr-decred?quotes=USD", `{"quotes":{"USD":{"price":20}}}`)
+	rate, err := cache.DcrRate(context.Background(), "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate != 20 {
+		t.Errorf("got rate %f, want 20", rate)
+	}
+	if api.requestCount() != 2 {
+		t.Errorf("api received %d requests, want 2", api.requestCount())
+	}
+}
+
+func TestCachedSourceExpiredRateNotReturned(t *testing.T) {
+	api, server := newTestAPI(map[string]string{
+		"/v1/tickers/dcr-decred?quotes=USD": `{"quotes":{"USD":{"price":20}}}`,
+	})
+	defer server.Close()
+
+	now := time.Date(2019, 8, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewCachedSource(&CoinPaprika{BaseURL: server.URL}, time.Minute)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	if _, err := cache.DcrRate(context.Background(), "USD"); err != nil {
+		t.Fatal(err)
+	}
+
+	// an expired rate is not returned if it cannot be refreshed
+	api.setResponse("/v1/tickers/dcr-decred?quotes=USD", "")
+	now = now.Add(2 * time.Minute)
+	if rate, err := cache.DcrRate(context.Background(), "USD"); err == nil {
+		t.Errorf("got expired rate %f, want an error", rate)
+	}
+}
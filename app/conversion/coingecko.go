@@ -0,0 +1,81 @@
+package conversion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// CoinGeckoBaseURL is the address of the coingecko API used if CoinGecko.BaseURL is not set.
+const CoinGeckoBaseURL = "https://api.coingecko.com"
+
+// CoinGecko gets DCR rates from the coingecko price aggregator, which supports most fiat currencies.
+type CoinGecko struct {
+	// BaseURL is the address of the coingecko API, CoinGeckoBaseURL is used if it is empty.
+	BaseURL string
+
+	// HTTPClient is used to send requests to the API, a client with a 5 second timeout is used if it is nil.
+	HTTPClient *http.Client
+}
+
+// Name returns SourceCoinGecko.
+func (coinGecko *CoinGecko) Name() string {
+	return SourceCoinGecko
+}
+
+// DcrRate returns the value of 1 DCR in `currency`.
+func (coinGecko *CoinGecko) DcrRate(ctx context.Context, currency string) (float64, error) {
+	baseURL := coinGecko.BaseURL
+	if baseURL == "" {
+		baseURL = CoinGeckoBaseURL
+	}
+	// coingecko uses lower case currency codes
+	currency = strings.ToLower(NormalizeCurrency(currency))
+	url := fmt.Sprintf("%s/api/v3/simple/price?ids=decred&vs_currencies=%s", baseURL, currency)
+
+	// the response has the form {"decred":{"usd":20.5}}
+	var result map[string]map[string]float64
+	if err := getJSON(ctx, coinGecko.HTTPClient, url, &result); err != nil {
+		return 0, err
+	}
+
+	rate, ok := result["decred"][currency]
+	if !ok {
+		return 0, ErrUnsupportedCurrency
+	}
+	return rate, nil
+}
+
+// coinGeckoHistory holds the part of the coingecko coin history response that has the rates.
+type coinGeckoHistory struct {
+	MarketData struct {
+		CurrentPrice map[string]float64 `json:"current_price"`
+	} `json:"market_data"`
+}
+
+// DcrRateOn returns the value of 1 DCR in `currency` at the start of the UTC date of `date`.
+func (coinGecko *CoinGecko) DcrRateOn(ctx context.Context, currency string, date time.Time) (float64, error) {
+	baseURL := coinGecko.BaseURL
+	if baseURL == "" {
+		baseURL = CoinGeckoBaseURL
+	}
+	currency = strings.ToLower(NormalizeCurrency(currency))
+	url := fmt.Sprintf("%s/api/v3/coins/decred/history?date=%s&localization=false", baseURL, date.UTC().Format("02-01-2006"))
+
+	var history coinGeckoHistory
+	if err := getJSON(ctx, coinGecko.HTTPClient, url, &history); err != nil {
+		return 0, err
+	}
+
+	rate, ok := history.MarketData.CurrentPrice[currency]
+	if !ok {
+		// coingecko returns no market data for dates before decred was listed
+		if len(history.MarketData.CurrentPrice) == 0 {
+			return 0, ErrRateNotFound
+		}
+		return 0, ErrUnsupportedCurrency
+	}
+	return rate, nil
+}
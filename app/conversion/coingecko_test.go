@@ -0,0 +1,64 @@
+package conversion
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCoinGeckoDcrRate(t *testing.T) {
+	_, server := newTestAPI(map[string]string{
+		"/api/v3/simple/price?ids=decred&vs_currencies=eur": `{"decred":{"eur":18.5}}`,
+		"/api/v3/simple/price?ids=decred&vs_currencies=xyz": `{"decred":{}}`,
+	})
+	defer server.Close()
+
+	coinGecko := &CoinGecko{BaseURL: server.URL}
+	rate, err := coinGecko.DcrRate(context.Background(), " EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate != 18.5 {
+		t.Errorf("got rate %f, want 18.5", rate)
+	}
+
+	if _, err = coinGecko.DcrRate(context.Background(), "XYZ"); err != ErrUnsupportedCurrency {
+		t.Errorf("got error %v for XYZ, want %v", err, ErrUnsupportedCurrency)
+	}
+	if _, err = coinGecko.DcrRate(context.Background(), "GBP"); err == nil {
+		t.Error("expected an error when the api responds with status 404")
+	}
+}
+
+func TestCoinGeckoDcrRateOn(t *testing.T) {
+	_, server := newTestAPI(map[string]string{
+		"/api/v3/coins/decred/history?date=15-03-2019&localization=false": `{"market_data":{"current_price":{"usd":17.2,"eur":15.1}}}`,
+		"/api/v3/coins/decred/history?date=01-01-2015&localization=false": `{"id":"decred"}`,
+	})
+	defer server.Close()
+
+	coinGecko := &CoinGecko{BaseURL: server.URL}
+	tests := []struct {
+		name     string
+		currency string
+		date     time.Time
+		want     float64
+		wantErr  error
+	}{
+		{"rate on date", "EUR", time.Date(2019, 3, 15, 22, 30, 0, 0, time.UTC), 15.1, nil},
+		{"date in another time zone", "usd", time.Date(2019, 3, 16, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)), 17.2, nil},
+		{"unsupported currency", "NGN", time.Date(2019, 3, 15, 0, 0, 0, 0, time.UTC), 0, ErrUnsupportedCurrency},
+		{"date before listing", "USD", time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC), 0, ErrRateNotFound},
+	}
+
+	for _, test := range tests {
+		rate, err := coinGecko.DcrRateOn(context.Background(), test.currency, test.date)
+		if err != test.wantErr {
+			t.Errorf("%s: got error %v, want %v", test.name, err, test.wantErr)
+			continue
+		}
+		if rate != test.want {
+			t.Errorf("%s: got rate %f, want %f", test.name, rate, test.want)
+		}
+	}
+}
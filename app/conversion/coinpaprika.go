@@ -0,0 +1,52 @@
+package conversion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CoinPaprikaBaseURL is the address of the coinpaprika API used if CoinPaprika.BaseURL is not set.
+const CoinPaprikaBaseURL = "https://api.coinpaprika.com"
+
+// CoinPaprika gets DCR rates from the coinpaprika price aggregator.
+type CoinPaprika struct {
+	// BaseURL is the address of the coinpaprika API, CoinPaprikaBaseURL is used if it is empty.
+	BaseURL string
+
+	// HTTPClient is used to send requests to the API, a client with a 5 second timeout is used if it is nil.
+	HTTPClient *http.Client
+}
+
+// coinPaprikaTicker holds the part of the coinpaprika ticker response that has the rates.
+type coinPaprikaTicker struct {
+	Quotes map[string]struct {
+		Price float64 `json:"price"`
+	} `json:"quotes"`
+}
+
+// Name returns SourceCoinPaprika.
+func (coinPaprika *CoinPaprika) Name() string {
+	return SourceCoinPaprika
+}
+
+// DcrRate returns the value of 1 DCR in `currency`.
+func (coinPaprika *CoinPaprika) DcrRate(ctx context.Context, currency string) (float64, error) {
+	baseURL := coinPaprika.BaseURL
+	if baseURL == "" {
+		baseURL = CoinPaprikaBaseURL
+	}
+	currency = NormalizeCurrency(currency)
+	url := fmt.Sprintf("%s/v1/tickers/dcr-decred?quotes=%s", baseURL, currency)
+
+	var ticker coinPaprikaTicker
+	if err := getJSON(ctx, coinPaprika.HTTPClient, url, &ticker); err != nil {
+		return 0, err
+	}
+
+	quote, ok := ticker.Quotes[currency]
+	if !ok {
+		return 0, ErrUnsupportedCurrency
+	}
+	return quote.Price, nil
+}
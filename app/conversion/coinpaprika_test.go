@@ -0,0 +1,45 @@
+package conversion
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCoinPaprikaDcrRate(t *testing.T) {
+	_, server := newTestAPI(map[string]string{
+		"/v1/tickers/dcr-decred?quotes=NGN": `{"id":"dcr-decred","quotes":{"NGN":{"price":7250.5}}}`,
+		"/v1/tickers/dcr-decred?quotes=XYZ": `{"id":"dcr-decred","quotes":{}}`,
+	})
+	defer server.Close()
+
+	coinPaprika := &CoinPaprika{BaseURL: server.URL}
+	rate, err := coinPaprika.DcrRate(context.Background(), "ngn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate != 7250.5 {
+		t.Errorf("got rate %f, want 7250.5", rate)
+	}
+
+	if _, err = coinPaprika.DcrRate(context.Background(), "XYZ"); err != ErrUnsupportedCurrency {
+		t.Errorf("got error %v for XYZ, want %v", err, ErrUnsupportedCurrency)
+	}
+	if _, err = coinPaprika.DcrRate(context.Background(), "USD"); err == nil {
+		t.Error("expected an error when the api responds with status 404")
+	}
+}
+
+func TestCoinPaprikaContextCancelled(t *testing.T) {
+	_, server := newTestAPI(map[string]string{
+		"/v1/tickers/dcr-decred?quotes=USD": `{"quotes":{"USD":{"price":20}}}`,
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	coinPaprika := &CoinPaprika{BaseURL: server.URL}
+	if _, err := coinPaprika.DcrRate(ctx, "USD"); err == nil {
+		t.Error("expected an error when the context is cancelled")
+	}
+}
@@ -0,0 +1,155 @@
+package conversion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Names of the exchange rate sources that can be set in the CurrencyConverter setting.
+const (
+	SourceNone        = "none"
+	SourceBittrex     = "bittrex"
+	SourceCoinGecko   = "coingecko"
+	SourceCoinPaprika = "coinpaprika"
+	SourceFile        = "file"
+
+	// legacySourceBittrex is the misspelt name that older config files use for the bittrex source.
+	legacySourceBittrex = "bitrex"
+)
+
+// SourceNames lists the names of all exchange rate sources, including SourceNone which disables conversion.
+var SourceNames = []string{
+	SourceNone,
+	SourceBittrex,
+	SourceCoinGecko,
+	SourceCoinPaprika,
+	SourceFile,
+}
+
+// DefaultFiatCurrency is the currency that DCR amounts are converted to if none is set.
+const DefaultFiatCurrency = "USD"
+
+// FiatCurrencies lists the fiat currencies offered for conversion in the interfaces.
+// Not every source supports every currency, unsupported currencies return ErrUnsupportedCurrency.
+var FiatCurrencies = []string{"USD", "EUR", "GBP", "NGN", "JPY", "CNY", "INR", "CAD", "AUD", "BRL", "ZAR", "KRW"}
+
+// DefaultCacheTTL is how long rates fetched from online sources are reused before being fetched again.
+const DefaultCacheTTL = 5 * time.Minute
+
+// defaultHTTPTimeout is the timeout of http requests made by the online sources if they are not given an http client.
+const defaultHTTPTimeout = 5 * time.Second
+
+// ErrUnsupportedCurrency is returned by a RateSource that does not provide rates for the requested currency.
+var ErrUnsupportedCurrency = errors.New("currency not supported by exchange rate source")
+
+// ErrRateNotFound is returned by a HistoricalRateSource that has no rate for the requested date.
+var ErrRateNotFound = errors.New("no exchange rate found for date")
+
+// RateSource provides the exchange rate of DCR in fiat currencies.
+type RateSource interface {
+	// Name returns the name of the source as used in the CurrencyConverter setting.
+	Name() string
+
+	// DcrRate returns the value of 1 DCR in `currency`, an ISO 4217 currency code such as USD or EUR.
+	DcrRate(ctx context.Context, currency string) (float64, error)
+}
+
+// HistoricalRateSource is a RateSource that also provides the rates of past dates, used to fill in the fiat value of old transactions.
+type HistoricalRateSource interface {
+	RateSource
+
+	// DcrRateOn returns the value of 1 DCR in `currency` on the UTC date of `date`.
+	DcrRateOn(ctx context.Context, currency string, date time.Time) (float64, error)
+}
+
+// NewHistoricalRateSource creates the exchange rate source named `name` if it provides past rates.
+func NewHistoricalRateSource(name string) (HistoricalRateSource, error) {
+	sourceName, _ := CanonicalSourceName(name)
+	switch sourceName {
+	case SourceCoinGecko:
+		return &CoinGecko{}, nil
+	default:
+		return nil, fmt.Errorf("%s does not provide past exchange rates, use %s", name, SourceCoinGecko)
+	}
+}
+
+// NewRateSource creates the exchange rate source named `name`, wrapped in a cache that reuses rates for DefaultCacheTTL.
+// `ratesFilePath` is only used by SourceFile. Returns nil if `name` is SourceNone or empty.
+func NewRateSource(name, ratesFilePath string) (RateSource, error) {
+	if name == "" {
+		return nil, nil
+	}
+	sourceName, ok := CanonicalSourceName(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown exchange rate source: %s", name)
+	}
+
+	var source RateSource
+	switch sourceName {
+	case SourceNone:
+		return nil, nil
+	case SourceBittrex:
+		source = &Bittrex{}
+	case SourceCoinGecko:
+		source = &CoinGecko{}
+	case SourceCoinPaprika:
+		source = &CoinPaprika{}
+	case SourceFile:
+		staticSource, err := LoadRatesFile(ratesFilePath)
+		if err != nil {
+			return nil, err
+		}
+		source = staticSource
+	}
+
+	return NewCachedSource(source, DefaultCacheTTL), nil
+}
+
+// CanonicalSourceName returns the name of the exchange rate source that `name` refers to, mapping the legacy
+// bittrex name and ignoring case. Returns false if `name` is not the name of a source or SourceNone.
+func CanonicalSourceName(name string) (string, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == legacySourceBittrex {
+		return SourceBittrex, true
+	}
+	for _, sourceName := range SourceNames {
+		if name == sourceName {
+			return sourceName, true
+		}
+	}
+	return "", false
+}
+
+// NormalizeCurrency returns `currency` in upper case without surrounding spaces, the form expected by all sources.
+func NormalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+// getJSON sends a GET request to `url` and decodes the json response body into `result`.
+func getJSON(ctx context.Context, httpClient *http.Client, url string, result interface{}) error {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultHTTPTimeout}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status: %s", res.Status)
+	}
+
+	return json.NewDecoder(res.Body).Decode(result)
+}
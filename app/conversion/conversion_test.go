@@ -0,0 +1,102 @@
+package conversion
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// testAPI is a fake exchange rate API that returns the response set for each request URI.
+// Requests for other URIs fail with status 404.
+type testAPI struct {
+	mu        sync.Mutex
+	responses map[string]string
+	requests  int
+}
+
+func newTestAPI(responses map[string]string) (*testAPI, *httptest.Server) {
+	api := &testAPI{responses: responses}
+	return api, httptest.NewServer(api)
+}
+
+func (api *testAPI) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	api.mu.Lock()
+	api.requests++
+	response, ok := api.responses[req.URL.RequestURI()]
+	api.mu.Unlock()
+
+	if !ok {
+		http.NotFound(res, req)
+		return
+	}
+	res.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(res, response)
+}
+
+// setResponse sets the response to requests for `requestURI`, or removes it if `response` is empty.
+func (api *testAPI) setResponse(requestURI, response string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	if response == "" {
+		delete(api.responses, requestURI)
+	} else {
+		api.responses[requestURI] = response
+	}
+}
+
+// requestCount returns the number of requests received by the api.
+func (api *testAPI) requestCount() int {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return api.requests
+}
+
+func TestCanonicalSourceName(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   string
+		wantOk bool
+	}{
+		{"coingecko", SourceCoinGecko, true},
+		{" CoinPaprika ", SourceCoinPaprika, true},
+		{"bitrex", SourceBittrex, true},
+		{"none", SourceNone, true},
+		{"kraken", "", false},
+	}
+
+	for _, test := range tests {
+		got, ok := CanonicalSourceName(test.name)
+		if got != test.want || ok != test.wantOk {
+			t.Errorf("%q: got %q, %v, want %q, %v", test.name, got, ok, test.want, test.wantOk)
+		}
+	}
+}
+
+func TestNewRateSource(t *testing.T) {
+	for _, name := range []string{"", SourceNone} {
+		source, err := NewRateSource(name, "")
+		if err != nil || source != nil {
+			t.Errorf("%q: got source %v and error %v, want no source", name, source, err)
+		}
+	}
+
+	source, err := NewRateSource("bitrex", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source.Name() != SourceBittrex {
+		t.Errorf("legacy bittrex name created %s source", source.Name())
+	}
+	if _, ok := source.(*CachedSource); !ok {
+		t.Errorf("online source is not cached")
+	}
+
+	if _, err = NewRateSource("kraken", ""); err == nil {
+		t.Error("expected an error for an unknown source")
+	}
+	if _, err = NewRateSource(SourceFile, ""); err == nil {
+		t.Error("expected an error for the file source without a rates file")
+	}
+}
@@ -0,0 +1,58 @@
+package conversion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// StaticSource returns fixed rates, for use without internet access.
+type StaticSource struct {
+	rates map[string]float64
+}
+
+// NewStaticSource returns a source that provides `rates`, the value of 1 DCR keyed by currency code.
+func NewStaticSource(rates map[string]float64) *StaticSource {
+	source := &StaticSource{
+		rates: make(map[string]float64, len(rates)),
+	}
+	for currency, rate := range rates {
+		source.rates[NormalizeCurrency(currency)] = rate
+	}
+	return source
+}
+
+// LoadRatesFile reads rates from the json file at `filePath` that maps currency codes
+// to the value of 1 DCR in that currency, e.g. {"USD": 20.5, "EUR": 18.2}.
+func LoadRatesFile(filePath string) (*StaticSource, error) {
+	if filePath == "" {
+		return nil, fmt.Errorf("exchange rates file is not set")
+	}
+
+	fileContent, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading exchange rates file: %s", err.Error())
+	}
+
+	var rates map[string]float64
+	if err = json.Unmarshal(fileContent, &rates); err != nil {
+		return nil, fmt.Errorf("error reading exchange rates file: %s", err.Error())
+	}
+
+	return NewStaticSource(rates), nil
+}
+
+// Name returns SourceFile.
+func (source *StaticSource) Name() string {
+	return SourceFile
+}
+
+// DcrRate returns the rate saved for `currency`.
+func (source *StaticSource) DcrRate(_ context.Context, currency string) (float64, error) {
+	rate, ok := source.rates[NormalizeCurrency(currency)]
+	if !ok {
+		return 0, ErrUnsupportedCurrency
+	}
+	return rate, nil
+}
@@ -0,0 +1,50 @@
+package conversion
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStaticSourceCached(t *testing.T) {
+	source := NewStaticSource(map[string]float64{"usd": 20, " EUR ": 18})
+	cache := NewCachedSource(source, time.Minute)
+
+	if rate, err := cache.DcrRate(context.Background(), "USD"); err != nil || rate != 20 {
+		t.Errorf("got USD rate %f and error %v, want 20", rate, err)
+	}
+	if rate, err := cache.DcrRate(context.Background(), "eur"); err != nil || rate != 18 {
+		t.Errorf("got EUR rate %f and error %v, want 18", rate, err)
+	}
+	if _, err := cache.DcrRate(context.Background(), "GBP"); err != ErrUnsupportedCurrency {
+		t.Errorf("got error %v for GBP, want %v", err, ErrUnsupportedCurrency)
+	}
+}
+
+func TestLoadRatesFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "godcr-conversion")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, "rates.json")
+	if err = ioutil.WriteFile(filePath, []byte(`{"ngn": 7250.5}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := LoadRatesFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate, err := source.DcrRate(context.Background(), "NGN"); err != nil || rate != 7250.5 {
+		t.Errorf("got NGN rate %f and error %v, want 7250.5", rate, err)
+	}
+
+	if _, err = LoadRatesFile(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("expected an error for a missing rates file")
+	}
+}
@@ -0,0 +1,114 @@
+package events
+
+import "sync"
+
+// Bus delivers published events to subscribers.
+// Each subscriber receives events in the order they were published, on a goroutine of its own,
+// so that slow subscribers do not hold up the wallet publishing the events or other subscribers.
+// Publish may be called on a nil Bus, which discards the event.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	handler    func(Event)
+	eventTypes map[Type]bool
+
+	mu      sync.Mutex
+	pending []Event
+	wake    chan struct{}
+	done    chan struct{}
+}
+
+// NewBus creates a bus with no subscribers.
+func NewBus() *Bus {
+	return &Bus{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Subscribe calls `handler` for each event of the specified types published after this call,
+// or for every event if no type is specified. The returned function stops the subscription;
+// events that are pending when it is called are dropped.
+func (bus *Bus) Subscribe(handler func(Event), eventTypes ...Type) (unsubscribe func()) {
+	sub := &subscriber{
+		handler: handler,
+		wake:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	if len(eventTypes) > 0 {
+		sub.eventTypes = make(map[Type]bool, len(eventTypes))
+		for _, eventType := range eventTypes {
+			sub.eventTypes[eventType] = true
+		}
+	}
+
+	bus.mu.Lock()
+	bus.subscribers[sub] = struct{}{}
+	bus.mu.Unlock()
+
+	go sub.deliverEvents()
+
+	var unsubscribeOnce sync.Once
+	return func() {
+		unsubscribeOnce.Do(func() {
+			bus.mu.Lock()
+			delete(bus.subscribers, sub)
+			bus.mu.Unlock()
+			close(sub.done)
+		})
+	}
+}
+
+// Publish queues `event` for delivery to the subscribers of its type and returns without waiting for them.
+func (bus *Bus) Publish(event Event) {
+	if bus == nil {
+		return
+	}
+
+	bus.mu.RLock()
+	defer bus.mu.RUnlock()
+
+	for sub := range bus.subscribers {
+		if sub.eventTypes == nil || sub.eventTypes[event.Type] {
+			sub.queue(event)
+		}
+	}
+}
+
+func (sub *subscriber) queue(event Event) {
+	sub.mu.Lock()
+	sub.pending = append(sub.pending, event)
+	sub.mu.Unlock()
+
+	select {
+	case sub.wake <- struct{}{}:
+	default:
+		// the subscriber has already been woken to deliver pending events
+	}
+}
+
+func (sub *subscriber) deliverEvents() {
+	for {
+		select {
+		case <-sub.done:
+			return
+		case <-sub.wake:
+		}
+
+		sub.mu.Lock()
+		events := sub.pending
+		sub.pending = nil
+		sub.mu.Unlock()
+
+		for _, event := range events {
+			select {
+			case <-sub.done:
+				return
+			default:
+				sub.handler(event)
+			}
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package events
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testTimeout = 5 * time.Second
+
+// recorder collects the events delivered to a subscriber.
+type recorder struct {
+	mu       sync.Mutex
+	events   []Event
+	received chan struct{}
+}
+
+func newRecorder() *recorder {
+	return &recorder{received: make(chan struct{}, 100)}
+}
+
+func (rec *recorder) handle(event Event) {
+	rec.mu.Lock()
+	rec.events = append(rec.events, event)
+	rec.mu.Unlock()
+	rec.received <- struct{}{}
+}
+
+// waitFor waits until `count` events have been delivered to the recorder and returns all delivered events.
+func (rec *recorder) waitFor(t *testing.T, count int) []Event {
+	t.Helper()
+
+	timeout := time.After(testTimeout)
+	for {
+		rec.mu.Lock()
+		delivered := len(rec.events)
+		rec.mu.Unlock()
+		if delivered >= count {
+			break
+		}
+
+		select {
+		case <-rec.received:
+		case <-timeout:
+			t.Fatalf("%d events delivered, want %d", delivered, count)
+		}
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return append([]Event(nil), rec.events...)
+}
+
+func TestBusDeliversEventsInOrder(t *testing.T) {
+	bus := NewBus()
+	rec := newRecorder()
+	unsubscribe := bus.Subscribe(rec.handle)
+	defer unsubscribe()
+
+	var want []Event
+	for height := int32(1); height <= 50; height++ {
+		event := Event{Type: BlockAttached, Data: Block{Height: height}}
+		bus.Publish(event)
+		want = append(want, event)
+	}
+
+	if got := rec.waitFor(t, len(want)); !reflect.DeepEqual(got, want) {
+		t.Errorf("delivered %+v, want %+v", got, want)
+	}
+}
+
+func TestBusFiltersEventTypes(t *testing.T) {
+	bus := NewBus()
+	txRecorder := newRecorder()
+	defer bus.Subscribe(txRecorder.handle, TxReceived, TxConfirmed)()
+	allRecorder := newRecorder()
+	defer bus.Subscribe(allRecorder.handle)()
+
+	events := []Event{
+		{Type: TxReceived, Data: Tx{Hash: "a1", BlockHeight: -1}},
+		{Type: BlockAttached, Data: Block{Height: 10}},
+		{Type: TxConfirmed, Data: Tx{Hash: "a1", BlockHeight: 10}},
+		{Type: BalanceChanged},
+	}
+	for _, event := range events {
+		bus.Publish(event)
+	}
+
+	if got := allRecorder.waitFor(t, len(events)); !reflect.DeepEqual(got, events) {
+		t.Errorf("subscriber to all events got %+v, want %+v", got, events)
+	}
+	want := []Event{events[0], events[2]}
+	if got := txRecorder.waitFor(t, len(want)); !reflect.DeepEqual(got, want) {
+		t.Errorf("tx subscriber got %+v, want %+v", got, want)
+	}
+}
+
+func TestBusSlowSubscriberDoesNotBlock(t *testing.T) {
+	bus := NewBus()
+
+	release := make(chan struct{})
+	defer bus.Subscribe(func(Event) {
+		<-release
+	})()
+	defer close(release)
+
+	rec := newRecorder()
+	defer bus.Subscribe(rec.handle)()
+
+	published := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			bus.Publish(Event{Type: SyncProgress, Data: SyncProgressData{Percentage: int32(i * 10)}})
+		}
+		close(published)
+	}()
+
+	select {
+	case <-published:
+	case <-time.After(testTimeout):
+		t.Fatal("publishing is blocked by a slow subscriber")
+	}
+	rec.waitFor(t, 10)
+}
+
+func TestBusUnsubscribe(t *testing.T) {
+	bus := NewBus()
+	rec := newRecorder()
+	unsubscribe := bus.Subscribe(rec.handle)
+
+	bus.Publish(Event{Type: ConnectionChanged, Data: Connection{Connected: false}})
+	rec.waitFor(t, 1)
+
+	unsubscribe()
+	unsubscribe() // unsubscribing twice is harmless
+	bus.Publish(Event{Type: ConnectionChanged, Data: Connection{Connected: true}})
+
+	// wait for an event delivered to a later subscriber, the unsubscribed handler would have been called by then
+	lateRecorder := newRecorder()
+	defer bus.Subscribe(lateRecorder.handle)()
+	bus.Publish(Event{Type: BalanceChanged})
+	lateRecorder.waitFor(t, 1)
+
+	if got := rec.waitFor(t, 1); len(got) != 1 {
+		t.Errorf("%d events delivered after unsubscribing", len(got)-1)
+	}
+}
+
+func TestNilBusPublish(t *testing.T) {
+	var bus *Bus
+	bus.Publish(Event{Type: BalanceChanged})
+}
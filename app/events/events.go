@@ -0,0 +1,76 @@
+// Package events provides a publish/subscribe bus through which wallets notify godcr interfaces
+// of new transactions and blocks, sync progress, balance changes and changes to the connection to the wallet.
+// The package has no dependencies outside the standard library so that it can be used by all interfaces,
+// regardless of the dcrlibwallet version they are built with.
+package events
+
+// Type identifies the kind of an event.
+type Type string
+
+const (
+	// TxReceived is published when the wallet sees a new transaction that is not yet mined. Data is a Tx.
+	TxReceived Type = "txReceived"
+
+	// TxConfirmed is published for each transaction of the wallet that is included in a newly attached block.
+	// Data is a Tx with BlockHeight set.
+	TxConfirmed Type = "txConfirmed"
+
+	// BlockAttached is published when a new block is attached to the wallet's best chain. Data is a Block.
+	BlockAttached Type = "blockAttached"
+
+	// SyncProgress is published as the wallet syncs with the network. Data is a SyncProgressData.
+	SyncProgress Type = "syncProgress"
+
+	// BalanceChanged is published after transactions that may change the balance of the wallet are received or mined.
+	// Data is nil, subscribers read the new balance from the wallet.
+	BalanceChanged Type = "balanceChanged"
+
+	// ConnectionChanged is published when the connection to the wallet is lost or restored. Data is a Connection.
+	ConnectionChanged Type = "connectionChanged"
+)
+
+// Event is a notification published on a Bus.
+type Event struct {
+	Type Type
+
+	// Wallet names the wallet that the event is about on buses shared by several wallets.
+	// Wallet mediums each publish to their own bus and leave it empty.
+	Wallet string
+
+	// Data holds the details of the event, its type depends on Type.
+	Data interface{}
+}
+
+// Tx is the data of TxReceived and TxConfirmed events.
+type Tx struct {
+	Hash string
+	// Amount and Fee are in atoms.
+	Amount int64
+	Fee    int64
+	// Direction is the dcrlibwallet transaction direction: 0 for sent, 1 for received and 2 for transferred within the wallet.
+	Direction int32
+	// BlockHeight is -1 for transactions that are not yet mined.
+	BlockHeight int32
+}
+
+// Block is the data of BlockAttached events.
+type Block struct {
+	Height int32
+	// Timestamp is the unix time of the block in seconds.
+	Timestamp int64
+}
+
+// SyncProgressData is the data of SyncProgress events.
+type SyncProgressData struct {
+	// Percentage is the progress of the whole sync operation, from 0 to 100.
+	Percentage     int32
+	ConnectedPeers int32
+	// Done is set once the sync ends, Error is also set if the sync failed.
+	Done  bool
+	Error string
+}
+
+// Connection is the data of ConnectionChanged events.
+type Connection struct {
+	Connected bool
+}
@@ -3,15 +3,37 @@ module github.com/raedahgroup/godcr/app
 go 1.12
 
 require (
+	github.com/decred/dcrd/blockchain/stake v1.1.0
+	github.com/decred/dcrd/chaincfg v1.3.0
 	github.com/decred/dcrd/chaincfg/chainhash v1.0.1
+	github.com/decred/dcrd/dcrec/secp256k1 v1.0.1
 	github.com/decred/dcrd/dcrutil v1.2.0
 	github.com/decred/dcrd/hdkeychain v1.1.1
+	github.com/decred/dcrd/txscript v1.0.2
 	github.com/decred/dcrd/wire v1.2.0
 	github.com/decred/dcrwallet v1.2.2
+	github.com/decred/dcrwallet/chain v1.1.1
+	github.com/decred/dcrwallet/errors v1.0.1
 	github.com/decred/dcrwallet/rpc/walletrpc v0.1.0
 	github.com/decred/dcrwallet/wallet v1.3.0
 	github.com/decred/dcrwallet/walletseed v1.0.1
+	github.com/decred/slog v1.0.0
 	github.com/jessevdk/go-flags v1.4.0
 	github.com/raedahgroup/dcrlibwallet v1.0.1-0.20190807181808-37b6666fe764
+	github.com/raedahgroup/godcr/app/events v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/jsonfile v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/paymenturi v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/seedbackup v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/txfilter v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/txlabels v0.0.0-00010101000000-000000000000
 	google.golang.org/grpc v1.14.0
 )
+
+replace (
+	github.com/raedahgroup/godcr/app/events => ./events
+	github.com/raedahgroup/godcr/app/jsonfile => ./jsonfile
+	github.com/raedahgroup/godcr/app/paymenturi => ./paymenturi
+	github.com/raedahgroup/godcr/app/seedbackup => ./seedbackup
+	github.com/raedahgroup/godcr/app/txfilter => ./txfilter
+	github.com/raedahgroup/godcr/app/txlabels => ./txlabels
+)
@@ -56,6 +56,10 @@ func PrintCommandHelp(output io.Writer, appName string, command *flags.Command)
 	fmt.Fprintln(tabWriter, fmt.Sprintf("%s. %s\n", command.ShortDescription, command.LongDescription))
 
 	usageText := fmt.Sprintf("Usage:\n  %s %s", appName, command.Name)
+	subcommands := command.Commands()
+	if len(subcommands) > 0 {
+		usageText += " <subcommand>"
+	}
 	args := command.Args()
 	if args != nil && len(args) > 0 {
 		usageText += " [args]"
@@ -76,6 +80,14 @@ func PrintCommandHelp(output io.Writer, appName string, command *flags.Command)
 		fmt.Fprintln(tabWriter)
 	}
 
+	if len(subcommands) > 0 {
+		fmt.Fprintln(tabWriter, "Subcommands:")
+		for _, subcommand := range subcommands {
+			fmt.Fprintln(tabWriter, fmt.Sprintf("  %s \t %s", subcommand.Name, subcommand.ShortDescription))
+		}
+		fmt.Fprintln(tabWriter)
+	}
+
 	printOptions(tabWriter, "Command options:", command.Options())
 
 	fmt.Fprintln(tabWriter, fmt.Sprintf("Use `%s -h` to view application options", appName))
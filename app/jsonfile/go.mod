@@ -0,0 +1,3 @@
+module github.com/raedahgroup/godcr/app/jsonfile
+
+go 1.12
@@ -0,0 +1,47 @@
+// Package jsonfile saves the json files that godcr keeps its own data in, such as the address book and transaction labels.
+// The package has no dependencies outside the standard library so that the stores of all interfaces can use it.
+package jsonfile
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Save writes `value` as json to `filePath`, creating the file's directory if it does not exist.
+// The json is written to a temporary file that then replaces the file at `filePath`,
+// so the saved file is never left partly written if godcr stops or the disk fills up while saving.
+func Save(filePath string, value interface{}) error {
+	fileContent, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(filePath)
+	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	// the temporary file is created with 0600 permissions and in the same directory so that renaming it does not copy
+	tempFile, err := ioutil.TempFile(dir, filepath.Base(filePath)+".tmp")
+	if err != nil {
+		return err
+	}
+	// the temporary file no longer exists once renamed, removing it only cleans up after errors
+	defer os.Remove(tempFile.Name())
+
+	if _, err = tempFile.Write(fileContent); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err = tempFile.Sync(); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err = tempFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), filePath)
+}
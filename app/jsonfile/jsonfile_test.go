@@ -0,0 +1,83 @@
+package jsonfile
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "godcr-jsonfile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the directory of the file is created if missing
+	filePath := filepath.Join(dir, "data", "values.json")
+
+	for _, value := range []map[string]int{{"a": 1, "b": 2}, {"c": 3}} {
+		if err = Save(filePath, value); err != nil {
+			t.Fatal(err)
+		}
+
+		fileContent, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var saved map[string]int
+		if err = json.Unmarshal(fileContent, &saved); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(saved, value) {
+			t.Fatalf("saved %v, want %v", saved, value)
+		}
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("saved file has permissions %v, want 0600", info.Mode().Perm())
+	}
+
+	// no temporary files are left behind
+	files, err := ioutil.ReadDir(filepath.Dir(filePath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("found %d files after saving, want only the saved file", len(files))
+	}
+}
+
+func TestSaveError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "godcr-jsonfile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, "values.json")
+	if err = Save(filePath, []string{"saved"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// values that cannot be encoded leave the saved file unchanged
+	if err = Save(filePath, func() {}); err == nil {
+		t.Fatal("expected an error saving a value that cannot be encoded")
+	}
+
+	fileContent, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved []string
+	if err = json.Unmarshal(fileContent, &saved); err != nil || !reflect.DeepEqual(saved, []string{"saved"}) {
+		t.Fatalf("saved file changed to %s", fileContent)
+	}
+}
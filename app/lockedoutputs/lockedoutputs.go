@@ -0,0 +1,119 @@
+// Package lockedoutputs keeps the unspent outputs of a wallet that the user has locked (frozen),
+// for wallet mediums that cannot persist locked outputs in the wallet itself.
+package lockedoutputs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/raedahgroup/godcr/app/jsonfile"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// FileName is the name of the file that the locked outputs of a wallet are saved to, in the directory where godcr keeps the wallet's data.
+const FileName = "lockedoutputs.json"
+
+// Store keeps the keys of unspent outputs that the user has locked (frozen).
+// Locked outputs are never selected automatically to fund transactions.
+// It is used by the wallet mediums to implement `Wallet.LockUnspent` and `Wallet.LockedOutputs`.
+type Store struct {
+	filePath string
+
+	mu         sync.RWMutex
+	outputKeys map[string]bool
+}
+
+// Load reads the locked outputs previously saved to `filePath`.
+// If `filePath` is empty, locked outputs are only kept in memory.
+func Load(filePath string) (*Store, error) {
+	lockedOutputs := &Store{
+		filePath:   filePath,
+		outputKeys: make(map[string]bool),
+	}
+	if filePath == "" {
+		return lockedOutputs, nil
+	}
+
+	fileContent, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return lockedOutputs, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading locked outputs file: %s", err.Error())
+	}
+
+	var outputKeys []string
+	if err = json.Unmarshal(fileContent, &outputKeys); err != nil {
+		return nil, fmt.Errorf("error reading locked outputs file: %s", err.Error())
+	}
+	for _, outputKey := range outputKeys {
+		lockedOutputs.outputKeys[outputKey] = true
+	}
+
+	return lockedOutputs, nil
+}
+
+// Lock locks or unlocks the outputs identified by `outputKeys` and saves the updated set.
+func (lockedOutputs *Store) Lock(outputKeys []string, lock bool) error {
+	lockedOutputs.mu.Lock()
+	defer lockedOutputs.mu.Unlock()
+
+	for _, outputKey := range outputKeys {
+		if lock {
+			lockedOutputs.outputKeys[outputKey] = true
+		} else {
+			delete(lockedOutputs.outputKeys, outputKey)
+		}
+	}
+
+	return lockedOutputs.save()
+}
+
+// IsLocked returns true if the output identified by `outputKey` is locked.
+func (lockedOutputs *Store) IsLocked(outputKey string) bool {
+	lockedOutputs.mu.RLock()
+	defer lockedOutputs.mu.RUnlock()
+	return lockedOutputs.outputKeys[outputKey]
+}
+
+// OutputKeys returns the keys of all locked outputs, sorted.
+func (lockedOutputs *Store) OutputKeys() []string {
+	lockedOutputs.mu.RLock()
+	defer lockedOutputs.mu.RUnlock()
+	return lockedOutputs.sortedOutputKeys()
+}
+
+// MarkLocked sets the `Locked` field of each of `utxos`.
+func (lockedOutputs *Store) MarkLocked(utxos []*walletcore.UnspentOutput) {
+	lockedOutputs.mu.RLock()
+	defer lockedOutputs.mu.RUnlock()
+
+	for _, utxo := range utxos {
+		utxo.Locked = lockedOutputs.outputKeys[utxo.OutputKey]
+	}
+}
+
+// save writes the locked output keys to file, the caller must hold the write lock.
+func (lockedOutputs *Store) save() error {
+	if lockedOutputs.filePath == "" {
+		return nil
+	}
+
+	if err := jsonfile.Save(lockedOutputs.filePath, lockedOutputs.sortedOutputKeys()); err != nil {
+		return fmt.Errorf("error saving locked outputs: %s", err.Error())
+	}
+	return nil
+}
+
+// sortedOutputKeys returns the locked output keys in sorted order, the caller must hold the lock.
+func (lockedOutputs *Store) sortedOutputKeys() []string {
+	outputKeys := make([]string, 0, len(lockedOutputs.outputKeys))
+	for outputKey := range lockedOutputs.outputKeys {
+		outputKeys = append(outputKeys, outputKey)
+	}
+	sort.Strings(outputKeys)
+	return outputKeys
+}
@@ -0,0 +1,46 @@
+package lockedoutputs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "godcr-lockedoutputs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the wallet's data directory is created on the first save
+	filePath := filepath.Join(dir, "wallet", FileName)
+	store, err := Load(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Lock([]string{"tx2:0", "tx1:1", "tx1:0"}, true); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Lock([]string{"tx1:1"}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := Load(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if outputKeys := reloaded.OutputKeys(); !reflect.DeepEqual(outputKeys, []string{"tx1:0", "tx2:0"}) {
+		t.Fatalf("reloaded locked outputs %v, want [tx1:0 tx2:0]", outputKeys)
+	}
+
+	utxos := []*walletcore.UnspentOutput{{OutputKey: "tx1:0"}, {OutputKey: "tx1:1"}}
+	reloaded.MarkLocked(utxos)
+	if !utxos[0].Locked || utxos[1].Locked {
+		t.Fatalf("marked locked %v and %v, want true and false", utxos[0].Locked, utxos[1].Locked)
+	}
+}
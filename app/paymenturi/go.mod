@@ -0,0 +1,3 @@
+module github.com/raedahgroup/godcr/app/paymenturi
+
+go 1.12
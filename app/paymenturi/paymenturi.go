@@ -0,0 +1,132 @@
+package paymenturi
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Scheme is the URI scheme of decred payment requests.
+const Scheme = "decred"
+
+// atomsPerDCR is the number of atoms in one DCR.
+const atomsPerDCR = 1e8
+
+// PaymentRequest is a request for payment to an address, encoded as a `decred:<address>?amount=&label=&message=` URI
+// that can be shown as a QR code on receive screens and pasted into send forms.
+type PaymentRequest struct {
+	Address string
+
+	// Amount is the requested amount in atoms, 0 if no amount is requested.
+	Amount int64
+
+	// Label is the name of the recipient and Message describes what the payment is for, both are optional.
+	Label   string
+	Message string
+}
+
+// IsPaymentURI returns true if `text` starts with the decred URI scheme, ignoring case.
+func IsPaymentURI(text string) bool {
+	text = strings.TrimSpace(text)
+	return len(text) > len(Scheme) && strings.EqualFold(text[:len(Scheme)+1], Scheme+":")
+}
+
+// HasDetails returns true if an amount, label or message is requested in addition to the address.
+func (request PaymentRequest) HasDetails() bool {
+	return request.Amount > 0 || request.Label != "" || request.Message != ""
+}
+
+// String returns the request as a URI. Only the address is included if no other field is set.
+func (request PaymentRequest) String() string {
+	var params []string
+	if request.Amount > 0 {
+		params = append(params, "amount="+FormatAmount(request.Amount))
+	}
+	if request.Label != "" {
+		params = append(params, "label="+escape(request.Label))
+	}
+	if request.Message != "" {
+		params = append(params, "message="+escape(request.Message))
+	}
+
+	uri := Scheme + ":" + request.Address
+	if len(params) > 0 {
+		uri += "?" + strings.Join(params, "&")
+	}
+	return uri
+}
+
+// Parse reads a payment request from `uri`. The address is not validated, use wallet.ValidateAddress for that.
+func Parse(uri string) (*PaymentRequest, error) {
+	uri = strings.TrimSpace(uri)
+	if !IsPaymentURI(uri) {
+		return nil, fmt.Errorf("not a %s payment uri", Scheme)
+	}
+
+	uri = strings.TrimPrefix(uri[len(Scheme)+1:], "//")
+	address, query := uri, ""
+	if queryStart := strings.Index(uri, "?"); queryStart >= 0 {
+		address, query = uri[:queryStart], uri[queryStart+1:]
+	}
+
+	request := &PaymentRequest{Address: strings.TrimSpace(address)}
+	if request.Address == "" {
+		return nil, fmt.Errorf("payment uri has no address")
+	}
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment uri parameters: %s", err.Error())
+	}
+	for name, values := range params {
+		value := values[0]
+		switch name {
+		case "amount":
+			request.Amount, err = ParseAmount(value)
+			if err != nil {
+				return nil, err
+			}
+		case "label":
+			request.Label = value
+		case "message":
+			request.Message = value
+		default:
+			// parameters prefixed with req- are required, so the request cannot be paid without understanding them
+			if strings.HasPrefix(name, "req-") {
+				return nil, fmt.Errorf("unsupported required payment uri parameter: %s", name)
+			}
+		}
+	}
+
+	return request, nil
+}
+
+// FormatAmount returns `atoms` in DCR without trailing zeros, e.g. 150000000 is 1.5.
+func FormatAmount(atoms int64) string {
+	amount := strconv.FormatInt(atoms/atomsPerDCR, 10)
+	if fraction := atoms % atomsPerDCR; fraction != 0 {
+		amount += "." + strings.TrimRight(fmt.Sprintf("%08d", fraction), "0")
+	}
+	return amount
+}
+
+// ParseAmount converts a DCR amount to atoms, an empty amount is 0.
+func ParseAmount(amountText string) (int64, error) {
+	amountText = strings.TrimSpace(amountText)
+	if amountText == "" {
+		return 0, nil
+	}
+
+	amountDCR, err := strconv.ParseFloat(amountText, 64)
+	if err != nil || math.IsNaN(amountDCR) || math.IsInf(amountDCR, 0) || amountDCR < 0 {
+		return 0, fmt.Errorf("invalid amount: %s", amountText)
+	}
+	return int64(math.Round(amountDCR * atomsPerDCR)), nil
+}
+
+// escape encodes `text` for use as a uri parameter value, with spaces encoded as %20 rather than +.
+func escape(text string) string {
+	return strings.Replace(url.QueryEscape(text), "+", "%20", -1)
+}
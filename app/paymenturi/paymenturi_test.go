@@ -0,0 +1,165 @@
+package paymenturi
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testAddress = "TsfDLrRkk9ciUuwfp2b8PawwnukYD7yAjGd"
+
+func TestPaymentRequestString(t *testing.T) {
+	tests := []struct {
+		name    string
+		request PaymentRequest
+		want    string
+	}{
+		{
+			name:    "address only",
+			request: PaymentRequest{Address: testAddress},
+			want:    "decred:" + testAddress,
+		},
+		{
+			name:    "whole amount",
+			request: PaymentRequest{Address: testAddress, Amount: 2e8},
+			want:    "decred:" + testAddress + "?amount=2",
+		},
+		{
+			name:    "all fields",
+			request: PaymentRequest{Address: testAddress, Amount: 150000001, Label: "Ada's shop", Message: "order #12 & tax"},
+			want:    "decred:" + testAddress + "?amount=1.50000001&label=Ada%27s%20shop&message=order%20%2312%20%26%20tax",
+		},
+		{
+			name:    "message without amount",
+			request: PaymentRequest{Address: testAddress, Message: "rent"},
+			want:    "decred:" + testAddress + "?message=rent",
+		},
+	}
+
+	for _, test := range tests {
+		if got := test.request.String(); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     string
+		want    *PaymentRequest
+		wantErr bool
+	}{
+		{
+			name: "address only",
+			uri:  "decred:" + testAddress,
+			want: &PaymentRequest{Address: testAddress},
+		},
+		{
+			name: "all fields",
+			uri:  "decred:" + testAddress + "?amount=1.5&label=Ada%27s%20shop&message=order+%2312",
+			want: &PaymentRequest{Address: testAddress, Amount: 1.5e8, Label: "Ada's shop", Message: "order #12"},
+		},
+		{
+			name: "upper case scheme, slashes and surrounding spaces",
+			uri:  "  DECRED://" + testAddress + "?amount=0.00000001 ",
+			want: &PaymentRequest{Address: testAddress, Amount: 1},
+		},
+		{
+			name: "unknown optional parameter is ignored",
+			uri:  "decred:" + testAddress + "?amount=3&foo=bar",
+			want: &PaymentRequest{Address: testAddress, Amount: 3e8},
+		},
+		{
+			name:    "unknown required parameter",
+			uri:     "decred:" + testAddress + "?req-expires=100",
+			wantErr: true,
+		},
+		{
+			name:    "not a decred uri",
+			uri:     "bitcoin:" + testAddress,
+			wantErr: true,
+		},
+		{
+			name:    "bare address",
+			uri:     testAddress,
+			wantErr: true,
+		},
+		{
+			name:    "no address",
+			uri:     "decred:?amount=1",
+			wantErr: true,
+		},
+		{
+			name:    "invalid amount",
+			uri:     "decred:" + testAddress + "?amount=1.5dcr",
+			wantErr: true,
+		},
+		{
+			name:    "negative amount",
+			uri:     "decred:" + testAddress + "?amount=-1",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := Parse(test.uri)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: got %+v, want an error", test.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err.Error())
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestParseString(t *testing.T) {
+	request := PaymentRequest{Address: testAddress, Amount: 123456789, Label: "a+b=c", Message: "100% paid?"}
+	parsed, err := Parse(request.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *parsed != request {
+		t.Errorf("parsed %+v from %s, want %+v", parsed, request.String(), request)
+	}
+}
+
+func TestAmounts(t *testing.T) {
+	tests := []struct {
+		atoms int64
+		text  string
+	}{
+		{0, "0"},
+		{1, "0.00000001"},
+		{1e8, "1"},
+		{12345e4, "1.2345"},
+		{21e14, "21000000"},
+	}
+
+	for _, test := range tests {
+		if got := FormatAmount(test.atoms); got != test.text {
+			t.Errorf("FormatAmount(%d) = %s, want %s", test.atoms, got, test.text)
+		}
+		if got, err := ParseAmount(test.text); err != nil || got != test.atoms {
+			t.Errorf("ParseAmount(%s) = %d, %v, want %d", test.text, got, err, test.atoms)
+		}
+	}
+
+	if atoms, err := ParseAmount(" "); err != nil || atoms != 0 {
+		t.Errorf("ParseAmount of empty amount = %d, %v, want 0", atoms, err)
+	}
+	for _, invalid := range []string{"abc", "NaN", "Inf", "-0.5"} {
+		if _, err := ParseAmount(invalid); err == nil {
+			t.Errorf("ParseAmount(%s) did not return an error", invalid)
+		}
+	}
+	if !IsPaymentURI(" Decred:abc") || IsPaymentURI("decredabc") || IsPaymentURI("decred") {
+		t.Error("IsPaymentURI does not match the decred scheme")
+	}
+}
@@ -0,0 +1,177 @@
+package seedbackup
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raedahgroup/godcr/app/jsonfile"
+)
+
+// FileName is the name of the file that the seed backup status of a wallet is saved to, in the wallet's directory.
+const FileName = "seedbackup.json"
+
+// QuizWordCount is the number of randomly chosen seed words the user is asked to confirm after a new seed is displayed.
+const QuizWordCount = 4
+
+// Store records whether the user has confirmed having a backup of a wallet's seed.
+// For wallets created by godcr, a salted hash of the seed is also kept so that the backup can be verified
+// again later without access to the wallet's private keys. The seed itself is never saved.
+type Store struct {
+	filePath string
+
+	mu     sync.RWMutex
+	status status
+}
+
+type status struct {
+	Verified   bool      `json:"verified"`
+	VerifiedAt time.Time `json:"verifiedAt,omitempty"`
+	Salt       string    `json:"salt,omitempty"`
+	SeedHash   string    `json:"seedHash,omitempty"`
+}
+
+// Load reads the seed backup status previously saved to `filePath`.
+// If `filePath` is empty, the status is only kept in memory.
+func Load(filePath string) (*Store, error) {
+	store := &Store{filePath: filePath}
+	if filePath == "" {
+		return store, nil
+	}
+
+	fileContent, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return store, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading seed backup file: %s", err.Error())
+	}
+
+	if err = json.Unmarshal(fileContent, &store.status); err != nil {
+		return nil, fmt.Errorf("error reading seed backup file: %s", err.Error())
+	}
+	return store, nil
+}
+
+// Verified returns true if the user has confirmed having a backup of the wallet's seed.
+func (store *Store) Verified() bool {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return store.status.Verified
+}
+
+// RecordSeed saves a salted hash of `seed` for checking seed backups against later, see MatchesSeed.
+// It is meant to be called when a wallet is created from `seed` and resets the verified status.
+func (store *Store) RecordSeed(seed string) error {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("error saving seed backup status: %s", err.Error())
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.status = status{
+		Salt:     hex.EncodeToString(salt),
+		SeedHash: seedHash(salt, seed),
+	}
+	return store.save()
+}
+
+// HasSeedHash returns true if a hash of the wallet's seed was saved by RecordSeed.
+func (store *Store) HasSeedHash() bool {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return store.status.SeedHash != ""
+}
+
+// MatchesSeed returns true if `seed` is the seed recorded with RecordSeed.
+// Case and extra whitespace between words are ignored.
+func (store *Store) MatchesSeed(seed string) bool {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	salt, err := hex.DecodeString(store.status.Salt)
+	if err != nil || store.status.SeedHash == "" {
+		return false
+	}
+	return seedHash(salt, seed) == store.status.SeedHash
+}
+
+// SetVerified records that the user has confirmed having a backup of the wallet's seed.
+func (store *Store) SetVerified() error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.status.Verified = true
+	store.status.VerifiedAt = time.Now().UTC()
+	return store.save()
+}
+
+// save writes the status to file, the caller must hold the write lock.
+func (store *Store) save() error {
+	if store.filePath == "" {
+		return nil
+	}
+
+	if err := jsonfile.Save(store.filePath, store.status); err != nil {
+		return fmt.Errorf("error saving seed backup status: %s", err.Error())
+	}
+	return nil
+}
+
+func seedHash(salt []byte, seed string) string {
+	hash := sha256.New()
+	hash.Write(salt)
+	hash.Write([]byte(strings.Join(SeedWords(seed), " ")))
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// SeedWords splits `seed` into its words, in lower case.
+func SeedWords(seed string) []string {
+	return strings.Fields(strings.ToLower(seed))
+}
+
+// QuizPositions returns `count` randomly chosen, distinct positions of words in a seed of `wordCount` words,
+// in ascending order. Positions start at 1 as they are shown to the user.
+func QuizPositions(wordCount, count int) ([]int, error) {
+	if count > wordCount {
+		count = wordCount
+	}
+
+	positions := make([]int, 0, count)
+	chosen := make(map[int]bool, count)
+	for len(positions) < count {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(wordCount)))
+		if err != nil {
+			return nil, fmt.Errorf("error choosing seed words: %s", err.Error())
+		}
+
+		position := int(n.Int64()) + 1
+		if !chosen[position] {
+			chosen[position] = true
+			positions = append(positions, position)
+		}
+	}
+
+	sort.Ints(positions)
+	return positions, nil
+}
+
+// CheckWord returns true if `word` is the word at `position` in `seed`, ignoring case.
+// Positions start at 1.
+func CheckWord(seed string, position int, word string) bool {
+	seedWords := SeedWords(seed)
+	if position < 1 || position > len(seedWords) {
+		return false
+	}
+	return seedWords[position-1] == strings.ToLower(strings.TrimSpace(word))
+}
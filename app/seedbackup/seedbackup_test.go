@@ -0,0 +1,52 @@
+package seedbackup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "godcr-seedbackup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	const seed = "abandon ability able about above absent"
+
+	filePath := filepath.Join(dir, FileName)
+	store, err := Load(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.RecordSeed(seed); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := Load(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.Verified() || !reloaded.HasSeedHash() {
+		t.Fatal("reloaded status is verified or has no seed hash")
+	}
+	if !reloaded.MatchesSeed("  ABANDON ability able   about above absent") {
+		t.Fatal("recorded seed does not match")
+	}
+	if reloaded.MatchesSeed("ability abandon able about above absent") {
+		t.Fatal("a different seed matches")
+	}
+
+	if err = reloaded.SetVerified(); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err = Load(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reloaded.Verified() || !reloaded.MatchesSeed(seed) {
+		t.Fatal("verified status or seed hash not saved")
+	}
+}
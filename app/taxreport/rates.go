@@ -0,0 +1,134 @@
+package taxreport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/raedahgroup/godcr/app/conversion"
+	"github.com/raedahgroup/godcr/app/txrates"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// RecentTransactionAge is the maximum age of a transaction for the current exchange rate to be saved as its rate.
+// Older transactions that are indexed for the first time, e.g. after restoring a wallet, need their rates filled in with Backfill.
+const RecentTransactionAge = time.Hour
+
+// historyPageSize is the number of txs read from the wallet at a time.
+const historyPageSize int32 = 100
+
+// RecordRecentRates saves the current rate from `source` in `currency` as the rate of the transactions made within
+// RecentTransactionAge that have no rate saved yet. Calling it as new transactions are indexed records the rate of
+// each transaction at the time it is first seen. Returns the number of transactions that rates were saved for.
+func RecordRecentRates(ctx context.Context, wallet walletcore.Wallet, rates *txrates.Store, source conversion.RateSource,
+	currency string) (int, error) {
+
+	cutoff := time.Now().Add(-RecentTransactionAge).Unix()
+
+	var txHashes []string
+	var offset int32
+	for {
+		txs, err := wallet.TransactionHistory(offset, historyPageSize, nil)
+		if err != nil {
+			return 0, fmt.Errorf("error reading transaction history: %s", err.Error())
+		}
+
+		reachedOldTxs := false
+		for _, tx := range txs {
+			if tx.Timestamp < cutoff {
+				// txs are read newest first, so the remaining txs are also too old
+				reachedOldTxs = true
+				break
+			}
+			if _, ok := rates.Rate(tx.Hash, currency); !ok {
+				txHashes = append(txHashes, tx.Hash)
+			}
+		}
+
+		if reachedOldTxs || int32(len(txs)) < historyPageSize {
+			break
+		}
+		offset += int32(len(txs))
+	}
+
+	if len(txHashes) == 0 {
+		return 0, nil
+	}
+
+	rateValue, err := source.DcrRate(ctx, currency)
+	if err != nil {
+		return 0, fmt.Errorf("error fetching exchange rate: %s", err.Error())
+	}
+
+	rate := txrates.Rate{
+		Value:      rateValue,
+		Source:     source.Name(),
+		RecordedAt: time.Now().Unix(),
+	}
+	newRates := make(map[string]txrates.Rate, len(txHashes))
+	for _, txHash := range txHashes {
+		newRates[txHash] = rate
+	}
+
+	if err = rates.SetRates(currency, newRates); err != nil {
+		return 0, err
+	}
+	return len(newRates), nil
+}
+
+// RateOnFunc returns the value of 1 DCR on the UTC date of `date`.
+// It should return conversion.ErrRateNotFound if there is no rate for the date.
+type RateOnFunc func(ctx context.Context, date time.Time) (float64, error)
+
+// Backfill saves rates in `currency` for the wallet's transactions that have none, looking up the rate on the date
+// of each transaction with `rateOn`. Saved rates are replaced if `overwrite` is true. `sourceName` is saved with
+// each rate to note where it came from. The rates found before an error occurs are saved, so Backfill can be run
+// again to continue. Returns the number of transactions that rates were saved for and the number with no rate found.
+func Backfill(ctx context.Context, wallet walletcore.Wallet, rates *txrates.Store, currency, sourceName string,
+	rateOn RateOnFunc, overwrite bool) (filled, notFound int, err error) {
+
+	txs, err := walletcore.FilterTransactionHistory(wallet, "", nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	newRates := make(map[string]txrates.Rate)
+
+	// many txs are made on the same day, so each date is only looked up once
+	ratesByDate := make(map[string]float64)
+	recordedAt := time.Now().Unix()
+
+	for _, tx := range txs {
+		if _, ok := rates.Rate(tx.Hash, currency); ok && !overwrite {
+			continue
+		}
+
+		txDate := time.Unix(tx.Timestamp, 0).UTC()
+		dateKey := txDate.Format(txrates.DateFormat)
+		rateValue, ok := ratesByDate[dateKey]
+		if !ok {
+			rateValue, err = rateOn(ctx, txDate)
+			if err == conversion.ErrRateNotFound {
+				err = nil
+				notFound++
+				continue
+			}
+			if err != nil {
+				err = fmt.Errorf("error fetching rate for %s: %s", dateKey, err.Error())
+				break
+			}
+			ratesByDate[dateKey] = rateValue
+		}
+
+		newRates[tx.Hash] = txrates.Rate{
+			Value:      rateValue,
+			Source:     sourceName,
+			RecordedAt: recordedAt,
+		}
+	}
+
+	if saveErr := rates.SetRates(currency, newRates); saveErr != nil && err == nil {
+		err = saveErr
+	}
+	return len(newRates), notFound, err
+}
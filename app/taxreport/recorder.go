@@ -0,0 +1,74 @@
+package taxreport
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/conversion"
+	"github.com/raedahgroup/godcr/app/txrates"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// recordRateTimeout limits how long fetching the current exchange rate of new transactions may take.
+const recordRateTimeout = 30 * time.Second
+
+// Recorder saves the current exchange rate in the currency set in settings as the rate of new transactions,
+// using the exchange rate source set in settings. Nothing is recorded while currency conversion is disabled.
+type Recorder struct {
+	rates    *txrates.Store
+	settings *config.Settings
+
+	sourceMu     sync.Mutex
+	source       conversion.RateSource
+	sourceConfig string
+}
+
+// NewRecorder returns a Recorder that saves rates to `rates`.
+// `settings` is read on each recording so that changes to the currency settings take effect without a restart.
+func NewRecorder(rates *txrates.Store, settings *config.Settings) *Recorder {
+	return &Recorder{
+		rates:    rates,
+		settings: settings,
+	}
+}
+
+// Record saves the current exchange rate as the rate of the recent transactions of `wallet` that have none,
+// see RecordRecentRates. Returns the number of transactions that rates were saved for.
+func (recorder *Recorder) Record(ctx context.Context, wallet walletcore.Wallet) (int, error) {
+	source, err := recorder.rateSource()
+	if err != nil || source == nil {
+		return 0, err
+	}
+
+	currency := conversion.NormalizeCurrency(recorder.settings.FiatCurrency)
+	if currency == "" {
+		currency = conversion.DefaultFiatCurrency
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, recordRateTimeout)
+	defer cancel()
+	return RecordRecentRates(ctx, wallet, recorder.rates, source, currency)
+}
+
+// rateSource returns the cached exchange rate source set in settings, or nil if currency conversion is disabled.
+// The source is re-created when the source settings change.
+func (recorder *Recorder) rateSource() (conversion.RateSource, error) {
+	recorder.sourceMu.Lock()
+	defer recorder.sourceMu.Unlock()
+
+	sourceConfig := recorder.settings.CurrencyConverter + "|" + recorder.settings.ExchangeRatesFile
+	if recorder.sourceConfig == sourceConfig {
+		return recorder.source, nil
+	}
+
+	source, err := conversion.NewRateSource(recorder.settings.CurrencyConverter, recorder.settings.ExchangeRatesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	recorder.source = source
+	recorder.sourceConfig = sourceConfig
+	return source, nil
+}
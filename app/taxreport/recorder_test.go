@@ -0,0 +1,72 @@
+package taxreport
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/txrates"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "godcr-taxreport")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ratesFile := filepath.Join(dir, "rates.json")
+	if err = ioutil.WriteFile(ratesFile, []byte(`{"USD": 20, "EUR": 18}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	wallet := &testWallet{
+		txs: []*walletcore.Transaction{
+			{Transaction: &txhelper.Transaction{Hash: "new", Timestamp: time.Now().Unix()}},
+			{Transaction: &txhelper.Transaction{Hash: "old", Timestamp: time.Now().Add(-2 * RecentTransactionAge).Unix()}},
+		},
+	}
+
+	rates, err := txrates.Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := &config.Settings{CurrencyConverter: "none"}
+	recorder := NewRecorder(rates, settings)
+
+	recorded, err := recorder.Record(context.Background(), wallet)
+	if err != nil || recorded != 0 {
+		t.Fatalf("recorded %d rates with conversion disabled, error: %v", recorded, err)
+	}
+
+	// settings changes take effect on the next recording
+	settings.CurrencyConverter = "file"
+	settings.ExchangeRatesFile = ratesFile
+	settings.FiatCurrency = "eur"
+
+	recorded, err = recorder.Record(context.Background(), wallet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recorded != 1 {
+		t.Fatalf("recorded %d rates, want 1", recorded)
+	}
+	if rate, ok := rates.Rate("new", "EUR"); !ok || rate.Value != 18 {
+		t.Fatalf("got rate %+v for the new tx, want 18", rate)
+	}
+	if _, ok := rates.Rate("old", "EUR"); ok {
+		t.Fatal("rate recorded for a tx older than RecentTransactionAge")
+	}
+
+	// txs with a rate are not recorded again
+	recorded, err = recorder.Record(context.Background(), wallet)
+	if err != nil || recorded != 0 {
+		t.Fatalf("recorded %d rates again, error: %v", recorded, err)
+	}
+}
@@ -0,0 +1,171 @@
+package taxreport
+
+import (
+	"sort"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrwallet/wallet"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/godcr/app/txrates"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// YearReport sums up the fiat value of the wallet's transactions in a calendar year, using the rate saved for each transaction.
+type YearReport struct {
+	Year int `json:"year"`
+
+	// Received is the value of funds received from other wallets, excluding staking rewards.
+	Received float64 `json:"received"`
+
+	// Sent is the value of funds sent to other wallets, excluding fees.
+	Sent float64 `json:"sent"`
+
+	// Fees is the value of the fees paid by the wallet's transactions, including ticket purchases.
+	Fees float64 `json:"fees"`
+
+	// StakingRewards is the value of the rewards received by votes, the returned ticket price is not included.
+	StakingRewards float64 `json:"staking_rewards"`
+
+	// Proceeds is the value of the DCR disposed of in the year, i.e. the value of the sent funds.
+	// CostBasis is the value of that DCR when it was received, matched with received funds first in first out.
+	// Fees are disposals without proceeds, so the cost basis of fees is counted as a loss.
+	Proceeds  float64 `json:"proceeds"`
+	CostBasis float64 `json:"cost_basis"`
+	Gains     float64 `json:"gains"`
+
+	TransactionCount int `json:"transaction_count"`
+
+	// MissingRates is the number of transactions in the year with no rate saved in the report currency.
+	// The fiat value of such transactions is counted as 0, fill in their rates with Backfill.
+	MissingRates int `json:"missing_rates"`
+}
+
+// Report holds the yearly reports of the wallet's transactions in a fiat currency.
+type Report struct {
+	Currency string `json:"currency"`
+
+	// Years has a report for each year that the wallet has transactions in, oldest first.
+	Years []*YearReport `json:"years"`
+}
+
+// voteTxType is the type of vote transactions, the rewards of votes are reported as staking rewards.
+var voteTxType = txhelper.FormatTransactionType(wallet.TransactionTypeVote)
+
+// lot is an amount of DCR received at the same time, used to match disposals with acquisitions first in first out.
+type lot struct {
+	atoms int64
+	rate  float64
+}
+
+// Generate creates the yearly reports of the wallet's transactions in `currency`.
+// Years are calendar years in UTC.
+func Generate(wallet walletcore.Wallet, rates *txrates.Store, currency string) (*Report, error) {
+	txs, err := walletcore.FilterTransactionHistory(wallet, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// txs are read newest first, lots must be matched oldest first
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].Timestamp < txs[j].Timestamp
+	})
+
+	report := &Report{Currency: currency}
+	yearReports := make(map[int]*YearReport)
+	var lots []*lot
+
+	for _, tx := range txs {
+		year := time.Unix(tx.Timestamp, 0).UTC().Year()
+		yearReport, ok := yearReports[year]
+		if !ok {
+			yearReport = &YearReport{Year: year}
+			yearReports[year] = yearReport
+			report.Years = append(report.Years, yearReport)
+		}
+		yearReport.TransactionCount++
+
+		rate, ok := rates.Rate(tx.Hash, currency)
+		if !ok {
+			yearReport.MissingRates++
+		}
+		fiatValue := func(atoms int64) float64 {
+			return dcrutil.Amount(atoms).ToCoin() * rate.Value
+		}
+
+		netAtoms := netWalletAmount(tx)
+		switch {
+		case netAtoms > 0:
+			if tx.Type == voteTxType {
+				yearReport.StakingRewards += fiatValue(netAtoms)
+			} else {
+				yearReport.Received += fiatValue(netAtoms)
+			}
+			lots = append(lots, &lot{atoms: netAtoms, rate: rate.Value})
+
+		case netAtoms < 0:
+			var proceeds float64
+			if tx.Direction == txhelper.TransactionDirectionSent {
+				proceeds = fiatValue(tx.Amount)
+				yearReport.Sent += proceeds
+			}
+			yearReport.Fees += fiatValue(tx.Fee)
+
+			var costBasis float64
+			lots, costBasis = disposeLots(lots, -netAtoms)
+			yearReport.Proceeds += proceeds
+			yearReport.CostBasis += costBasis
+			yearReport.Gains += proceeds - costBasis
+		}
+	}
+
+	return report, nil
+}
+
+// Year returns the report for `year` or nil if the wallet has no transactions in that year.
+func (report *Report) Year(year int) *YearReport {
+	for _, yearReport := range report.Years {
+		if yearReport.Year == year {
+			return yearReport
+		}
+	}
+	return nil
+}
+
+// netWalletAmount returns the change in wallet balance caused by `tx`,
+// the total of the tx outputs paid to the wallet less the total of the tx inputs spent from the wallet.
+func netWalletAmount(tx *walletcore.Transaction) (netAtoms int64) {
+	for _, output := range tx.Outputs {
+		if output.AccountNumber != -1 {
+			netAtoms += output.Amount
+		}
+	}
+	for _, input := range tx.Inputs {
+		if input.AccountNumber != -1 {
+			netAtoms -= input.Amount
+		}
+	}
+	return
+}
+
+// disposeLots removes `atoms` from `lots`, oldest first, and returns the remaining lots and the value of the removed atoms
+// when they were received. Atoms in excess of the lots, e.g. if older txs are not indexed, have no cost basis.
+func disposeLots(lots []*lot, atoms int64) ([]*lot, float64) {
+	var costBasis float64
+	for atoms > 0 && len(lots) > 0 {
+		oldest := lots[0]
+		disposed := oldest.atoms
+		if disposed > atoms {
+			disposed = atoms
+		}
+
+		costBasis += dcrutil.Amount(disposed).ToCoin() * oldest.rate
+		oldest.atoms -= disposed
+		atoms -= disposed
+
+		if oldest.atoms == 0 {
+			lots = lots[1:]
+		}
+	}
+	return lots, costBasis
+}
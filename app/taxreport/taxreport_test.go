@@ -0,0 +1,252 @@
+package taxreport
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrwallet/wallet"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/dcrlibwallet/txindex"
+	"github.com/raedahgroup/godcr/app/txrates"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// testWallet returns a fixed transaction history, only the wallet functions used by the tax report are implemented.
+type testWallet struct {
+	walletcore.Wallet
+
+	// txs are ordered newest first, like the history returned by wallets
+	txs []*walletcore.Transaction
+}
+
+func (testWallet *testWallet) TransactionHistory(offset, count int32, _ *txindex.ReadFilter) ([]*walletcore.Transaction, error) {
+	if int(offset) >= len(testWallet.txs) {
+		return nil, nil
+	}
+	end := int(offset + count)
+	if end > len(testWallet.txs) {
+		end = len(testWallet.txs)
+	}
+	return testWallet.txs[offset:end], nil
+}
+
+// testTx is a transaction that spends `walletInputs` from the wallet and pays `walletOutputs` to the wallet
+// and `externalOutputs` to other wallets.
+type testTx struct {
+	hash            string
+	date            string
+	txType          wallet.TransactionType
+	direction       txhelper.TransactionDirection
+	walletInputs    []int64
+	walletOutputs   []int64
+	externalOutputs []int64
+	fee             int64
+	rate            float64
+}
+
+func (tx testTx) transaction(t *testing.T) *walletcore.Transaction {
+	timestamp, err := time.Parse("2006-01-02", tx.date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transaction := &txhelper.Transaction{
+		Hash:      tx.hash,
+		Type:      txhelper.FormatTransactionType(tx.txType),
+		Timestamp: timestamp.Unix(),
+		Fee:       tx.fee,
+		Direction: tx.direction,
+	}
+	for _, amount := range tx.walletInputs {
+		transaction.Inputs = append(transaction.Inputs, &txhelper.TxInput{Amount: amount, AccountNumber: 0})
+	}
+	for _, amount := range tx.walletOutputs {
+		transaction.Outputs = append(transaction.Outputs, &txhelper.TxOutput{Amount: amount, AccountNumber: 0})
+	}
+	for _, amount := range tx.externalOutputs {
+		transaction.Outputs = append(transaction.Outputs, &txhelper.TxOutput{Amount: amount, AccountNumber: -1})
+		transaction.Amount += amount
+	}
+	if tx.direction == txhelper.TransactionDirectionReceived {
+		for _, amount := range tx.walletOutputs {
+			transaction.Amount += amount
+		}
+	}
+
+	return &walletcore.Transaction{Transaction: transaction}
+}
+
+func TestGenerate(t *testing.T) {
+	testTxs := []testTx{
+		{
+			hash: "received-2018", date: "2018-06-01", txType: wallet.TransactionTypeRegular,
+			direction: txhelper.TransactionDirectionReceived, walletOutputs: []int64{2e8}, rate: 10,
+		},
+		{
+			hash: "received-2019", date: "2019-01-10", txType: wallet.TransactionTypeRegular,
+			direction: txhelper.TransactionDirectionReceived, walletOutputs: []int64{1e8}, rate: 20,
+		},
+		{
+			// spends the whole first lot and 0.51 DCR of the second lot
+			hash: "sent-2019", date: "2019-03-01", txType: wallet.TransactionTypeRegular,
+			direction: txhelper.TransactionDirectionSent, walletInputs: []int64{2e8, 1e8},
+			walletOutputs: []int64{0.49e8}, externalOutputs: []int64{2.5e8}, fee: 0.01e8, rate: 30,
+		},
+		{
+			// only the fee leaves the wallet, it is disposed of without proceeds
+			hash: "transfer-2019", date: "2019-04-01", txType: wallet.TransactionTypeRegular,
+			direction: txhelper.TransactionDirectionYourself, walletInputs: []int64{0.49e8},
+			walletOutputs: []int64{0.48e8}, fee: 0.01e8, rate: 30,
+		},
+		{
+			hash: "vote-2019", date: "2019-05-01", txType: wallet.TransactionTypeVote,
+			direction: txhelper.TransactionDirectionYourself, walletInputs: []int64{10e8},
+			walletOutputs: []int64{11.5e8}, rate: 40,
+		},
+		{
+			// no rate saved, the received funds have no value or cost basis
+			hash: "unrated-2019", date: "2019-06-01", txType: wallet.TransactionTypeRegular,
+			direction: txhelper.TransactionDirectionReceived, walletOutputs: []int64{1e8},
+		},
+		{
+			// spends the remaining 0.48 DCR of the second lot and 0.52 DCR of the vote reward
+			hash: "sent-2020", date: "2020-01-15", txType: wallet.TransactionTypeRegular,
+			direction: txhelper.TransactionDirectionSent, walletInputs: []int64{1e8},
+			externalOutputs: []int64{1e8}, rate: 50,
+		},
+	}
+
+	rates, err := txrates.Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	txRates := make(map[string]txrates.Rate)
+	testWallet := &testWallet{}
+	for i := len(testTxs) - 1; i >= 0; i-- {
+		testWallet.txs = append(testWallet.txs, testTxs[i].transaction(t))
+		if testTxs[i].rate != 0 {
+			txRates[testTxs[i].hash] = txrates.Rate{Value: testTxs[i].rate, Source: "test"}
+		}
+	}
+	if err = rates.SetRates("USD", txRates); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := Generate(testWallet, rates, "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []YearReport{
+		{Year: 2018, Received: 20, TransactionCount: 1},
+		{
+			Year: 2019, Received: 20, Sent: 75, Fees: 0.6, StakingRewards: 60,
+			Proceeds: 75, CostBasis: 30.4, Gains: 44.6, TransactionCount: 5, MissingRates: 1,
+		},
+		{Year: 2020, Sent: 50, Proceeds: 50, CostBasis: 30.4, Gains: 19.6, TransactionCount: 1},
+	}
+
+	if len(report.Years) != len(want) {
+		t.Fatalf("report has %d years, want %d", len(report.Years), len(want))
+	}
+	for i, wantYear := range want {
+		if report.Year(wantYear.Year) != report.Years[i] {
+			t.Errorf("report year %d is not in order", wantYear.Year)
+		}
+		checkYearReport(t, report.Years[i], wantYear)
+	}
+	if report.Year(2017) != nil {
+		t.Error("report has a year with no transactions")
+	}
+
+	// missing rates are counted per currency
+	eurReport, err := Generate(testWallet, rates, "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing := eurReport.Year(2019).MissingRates; missing != 5 {
+		t.Errorf("EUR report is missing %d rates in 2019, want 5", missing)
+	}
+}
+
+func checkYearReport(t *testing.T, got *YearReport, want YearReport) {
+	t.Helper()
+
+	values := []struct {
+		name      string
+		got, want float64
+	}{
+		{"received", got.Received, want.Received},
+		{"sent", got.Sent, want.Sent},
+		{"fees", got.Fees, want.Fees},
+		{"staking rewards", got.StakingRewards, want.StakingRewards},
+		{"proceeds", got.Proceeds, want.Proceeds},
+		{"cost basis", got.CostBasis, want.CostBasis},
+		{"gains", got.Gains, want.Gains},
+	}
+	for _, value := range values {
+		if math.Abs(value.got-value.want) > 1e-9 {
+			t.Errorf("%d %s: got %f, want %f", want.Year, value.name, value.got, value.want)
+		}
+	}
+	if got.TransactionCount != want.TransactionCount {
+		t.Errorf("%d: got %d transactions, want %d", want.Year, got.TransactionCount, want.TransactionCount)
+	}
+	if got.MissingRates != want.MissingRates {
+		t.Errorf("%d: got %d missing rates, want %d", want.Year, got.MissingRates, want.MissingRates)
+	}
+}
+
+func TestDisposeLots(t *testing.T) {
+	tests := []struct {
+		name          string
+		lots          []lot
+		atoms         int64
+		wantCostBasis float64
+		wantLots      []lot
+	}{
+		{
+			name:          "part of the oldest lot",
+			lots:          []lot{{atoms: 2e8, rate: 10}, {atoms: 1e8, rate: 20}},
+			atoms:         0.5e8,
+			wantCostBasis: 5,
+			wantLots:      []lot{{atoms: 1.5e8, rate: 10}, {atoms: 1e8, rate: 20}},
+		},
+		{
+			name:          "whole oldest lot and part of the next",
+			lots:          []lot{{atoms: 2e8, rate: 10}, {atoms: 1e8, rate: 20}},
+			atoms:         2.5e8,
+			wantCostBasis: 30,
+			wantLots:      []lot{{atoms: 0.5e8, rate: 20}},
+		},
+		{
+			name:          "more than all lots",
+			lots:          []lot{{atoms: 1e8, rate: 10}},
+			atoms:         3e8,
+			wantCostBasis: 10,
+			wantLots:      nil,
+		},
+	}
+
+	for _, test := range tests {
+		lots := make([]*lot, len(test.lots))
+		for i := range test.lots {
+			lots[i] = &test.lots[i]
+		}
+
+		remainingLots, costBasis := disposeLots(lots, test.atoms)
+		if math.Abs(costBasis-test.wantCostBasis) > 1e-9 {
+			t.Errorf("%s: cost basis is %f, want %f", test.name, costBasis, test.wantCostBasis)
+		}
+		if len(remainingLots) != len(test.wantLots) {
+			t.Errorf("%s: %d lots remain, want %d", test.name, len(remainingLots), len(test.wantLots))
+			continue
+		}
+		for i, remainingLot := range remainingLots {
+			if *remainingLot != test.wantLots[i] {
+				t.Errorf("%s: lot %d is %+v, want %+v", test.name, i, *remainingLot, test.wantLots[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package ticketbuyer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet/txrules"
+	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// waitingForSync is the decision of a running ticket buyer while the wallet is not synced
+const waitingForSync = "waiting for the wallet to sync"
+
+// Status describes the current state of the ticket buyer and the last decision it made
+type Status struct {
+	Running          bool   `json:"running"`
+	LastBlock        uint32 `json:"lastBlock"`
+	LastDecision     string `json:"lastDecision"`
+	TicketsPurchased int    `json:"ticketsPurchased"`
+	Error            string `json:"error"`
+}
+
+// TicketBuyer buys tickets automatically on every new block using the options set in the [TicketBuyer] config group.
+// Tickets are only bought while the wallet is synced, if the ticket price does not exceed the configured max price
+// and the source account has enough spendable funds above the configured balance to maintain.
+type TicketBuyer struct {
+	wallet         app.WalletMiddleware
+	config         config.TicketBuyerConfig
+	onStatusChange func(Status)
+
+	mu         sync.Mutex
+	status     Status
+	synced     bool
+	passphrase []byte
+	ctx        context.Context
+	cancel     context.CancelFunc
+
+	// unsubscribeSync stops following the wallet's sync progress, see Close.
+	// unsubscribeBlocks stops receiving new blocks, it is set while the ticket buyer is running.
+	unsubscribeSync   func()
+	unsubscribeBlocks func()
+
+	// purchaseMu ensures that tickets are not bought twice for the same block
+	// when a new block is attached while the best block is being processed after the wallet synced
+	purchaseMu sync.Mutex
+}
+
+// New creates a ticket buyer that is not yet running.
+// The ticket buyer follows the sync progress published on the wallet's events bus until Close is called,
+// so it should be created before the wallet starts syncing.
+// onStatusChange, if not nil, is called with the new status each time the ticket buyer status changes.
+func New(wallet app.WalletMiddleware, ticketBuyerConfig config.TicketBuyerConfig, onStatusChange func(Status)) *TicketBuyer {
+	tb := &TicketBuyer{
+		wallet:         wallet,
+		config:         ticketBuyerConfig,
+		onStatusChange: onStatusChange,
+	}
+	tb.unsubscribeSync = wallet.Events().Subscribe(tb.walletEventReceived, events.SyncProgress, events.ConnectionChanged)
+	return tb
+}
+
+// Start saves the spending passphrase and buys tickets on every new block until Stop is called or ctx is canceled.
+// If the wallet is not synced, the ticket buyer waits for the sync to complete before buying tickets,
+// it also pauses whenever the wallet loses sync and resumes once the wallet is synced again.
+// An incorrect passphrase is only detected and reported in the status when the first purchase is attempted.
+func (tb *TicketBuyer) Start(ctx context.Context, passphrase string) error {
+	if tb.wallet.IsWatchingOnlyWallet() {
+		return walletcore.ErrWatchingOnlyWallet
+	}
+
+	if tb.config.MaxPerBlock == 0 {
+		return errors.New("ticket buyer maxperblock option must be greater than 0")
+	}
+
+	tb.mu.Lock()
+	if tb.status.Running {
+		tb.mu.Unlock()
+		return errors.New("ticket buyer is already running")
+	}
+
+	tb.ctx, tb.cancel = context.WithCancel(ctx)
+	tb.unsubscribeBlocks = tb.wallet.Events().Subscribe(tb.walletEventReceived, events.BlockAttached)
+	tb.passphrase = []byte(passphrase)
+	tb.status = Status{Running: true, LastDecision: "waiting for next block"}
+	if !tb.synced {
+		tb.status.LastDecision = waitingForSync
+	}
+	status := tb.status
+	synced := tb.synced
+	buyerCtx := tb.ctx
+	tb.mu.Unlock()
+
+	log.Infof("Ticket buyer started, buying from account %d with max price %s, balance to maintain %s and max %d ticket(s) per block",
+		tb.config.SourceAccount, dcrAmount(tb.config.MaxPrice), dcrAmount(tb.config.BalanceToMaintain), tb.config.MaxPerBlock)
+	tb.notify(status)
+
+	if synced {
+		go tb.processBestBlock(buyerCtx)
+	}
+	return nil
+}
+
+// Stop stops the ticket buyer if it is running and clears the saved spending passphrase.
+func (tb *TicketBuyer) Stop() {
+	tb.mu.Lock()
+	if !tb.status.Running {
+		tb.mu.Unlock()
+		return
+	}
+
+	tb.cancel()
+	tb.unsubscribeBlocks()
+	tb.unsubscribeBlocks = nil
+	for i := range tb.passphrase {
+		tb.passphrase[i] = 0
+	}
+	tb.passphrase = nil
+	tb.status.Running = false
+	tb.status.LastDecision = "stopped"
+	status := tb.status
+	tb.mu.Unlock()
+
+	log.Info("Ticket buyer stopped")
+	tb.notify(status)
+}
+
+// Close stops the ticket buyer and stops following the wallet's sync progress.
+// The ticket buyer cannot be started again after it is closed.
+func (tb *TicketBuyer) Close() {
+	tb.Stop()
+	tb.unsubscribeSync()
+}
+
+// Status returns the current status of the ticket buyer.
+func (tb *TicketBuyer) Status() Status {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	return tb.status
+}
+
+// Config returns the options used by the ticket buyer.
+func (tb *TicketBuyer) Config() config.TicketBuyerConfig {
+	return tb.config
+}
+
+func (tb *TicketBuyer) walletEventReceived(event events.Event) {
+	switch event.Type {
+	case events.SyncProgress:
+		progress := event.Data.(events.SyncProgressData)
+		tb.setSynced(progress.Done && progress.Error == "")
+
+	case events.ConnectionChanged:
+		// the wallet is synced again when the connection is restored, and the sync progress is published then
+		if !event.Data.(events.Connection).Connected {
+			tb.setSynced(false)
+		}
+
+	case events.BlockAttached:
+		tb.mu.Lock()
+		buying := tb.status.Running && tb.synced
+		buyerCtx := tb.ctx
+		tb.mu.Unlock()
+
+		if buying {
+			tb.processBlock(buyerCtx, uint32(event.Data.(events.Block).Height))
+		}
+	}
+}
+
+// setSynced pauses a running ticket buyer when the wallet loses sync
+// and checks the best block for tickets to buy once the wallet is synced.
+func (tb *TicketBuyer) setSynced(synced bool) {
+	tb.mu.Lock()
+	if tb.synced == synced {
+		tb.mu.Unlock()
+		return
+	}
+	tb.synced = synced
+	running := tb.status.Running
+	lastBlock := tb.status.LastBlock
+	buyerCtx := tb.ctx
+	tb.mu.Unlock()
+
+	if !running {
+		return
+	}
+	if synced {
+		tb.processBestBlock(buyerCtx)
+	} else {
+		tb.updateStatus(lastBlock, waitingForSync, nil)
+	}
+}
+
+func (tb *TicketBuyer) processBestBlock(ctx context.Context) {
+	bestBlock, err := tb.wallet.BestBlock()
+	if err != nil {
+		tb.updateStatus(tb.Status().LastBlock, "", fmt.Errorf("error checking best block: %s", err.Error()))
+		return
+	}
+	tb.processBlock(ctx, bestBlock)
+}
+
+// processBlock decides if tickets should be bought at the specified block height and buys them if so.
+func (tb *TicketBuyer) processBlock(ctx context.Context, blockHeight uint32) {
+	tb.purchaseMu.Lock()
+	defer tb.purchaseMu.Unlock()
+
+	if ctx.Err() != nil || blockHeight <= tb.Status().LastBlock {
+		return
+	}
+
+	ticketPrice, err := tb.wallet.TicketPrice(ctx)
+	if err != nil {
+		tb.updateStatus(blockHeight, "", fmt.Errorf("error fetching ticket price: %s", err.Error()))
+		return
+	}
+	price := dcrutil.Amount(ticketPrice)
+
+	maxPrice := dcrAmount(tb.config.MaxPrice)
+	if maxPrice > 0 && price > maxPrice {
+		tb.updateStatus(blockHeight, fmt.Sprintf("not buying: ticket price %s is above max price %s", price, maxPrice), nil)
+		return
+	}
+
+	balance, err := tb.wallet.AccountBalance(tb.config.SourceAccount, walletcore.DefaultRequiredConfirmations)
+	if err != nil {
+		tb.updateStatus(blockHeight, "", fmt.Errorf("error fetching account balance: %s", err.Error()))
+		return
+	}
+
+	balanceToMaintain := dcrAmount(tb.config.BalanceToMaintain)
+	feePerTicket := estimatedFeePerTicket(walletcore.DefaultFeeRate, tb.config.VSPHost != "")
+	numTickets := ticketsToBuy(balance.Spendable, balanceToMaintain, price, feePerTicket, tb.config.MaxPerBlock)
+	if numTickets == 0 {
+		tb.updateStatus(blockHeight, fmt.Sprintf("not buying: spendable balance %s is not enough to buy a ticket at %s plus %s fees and maintain %s",
+			balance.Spendable, price, feePerTicket, balanceToMaintain), nil)
+		return
+	}
+
+	// copy the passphrase so that clearing it in Stop does not affect a purchase that is in progress
+	tb.mu.Lock()
+	passphrase := append([]byte(nil), tb.passphrase...)
+	tb.mu.Unlock()
+
+	ticketHashes, err := tb.wallet.PurchaseTicket(ctx, dcrlibwallet.PurchaseTicketsRequest{
+		Account:               tb.config.SourceAccount,
+		RequiredConfirmations: walletcore.DefaultRequiredConfirmations,
+		NumTickets:            numTickets,
+		Passphrase:            passphrase,
+		VSPHost:               tb.config.VSPHost,
+		TxFee:                 walletcore.DefaultFeeRate,
+		TicketFee:             walletcore.DefaultFeeRate,
+	})
+	if err != nil {
+		tb.updateStatus(blockHeight, "", fmt.Errorf("error purchasing %d ticket(s) at %s: %s", numTickets, price, err.Error()))
+		return
+	}
+
+	tb.mu.Lock()
+	tb.status.TicketsPurchased += len(ticketHashes)
+	tb.mu.Unlock()
+
+	for _, hash := range ticketHashes {
+		log.Infof("Ticket buyer purchased ticket %s", hash)
+	}
+	tb.updateStatus(blockHeight, fmt.Sprintf("bought %d ticket(s) at %s", len(ticketHashes), price), nil)
+}
+
+// updateStatus records and logs the decision or error of the ticket buyer at the specified block height
+// and reports the new status to the status change listener.
+func (tb *TicketBuyer) updateStatus(blockHeight uint32, decision string, err error) {
+	tb.mu.Lock()
+	if !tb.status.Running {
+		// ticket buyer was stopped while this block was being processed
+		tb.mu.Unlock()
+		return
+	}
+
+	tb.status.LastBlock = blockHeight
+	if err != nil {
+		tb.status.Error = err.Error()
+		log.Errorf("Ticket buyer, block %d: %s", blockHeight, err.Error())
+	} else {
+		tb.status.Error = ""
+		tb.status.LastDecision = decision
+		log.Infof("Ticket buyer, block %d: %s", blockHeight, decision)
+	}
+	status := tb.status
+	tb.mu.Unlock()
+
+	tb.notify(status)
+}
+
+func (tb *TicketBuyer) notify(status Status) {
+	if tb.onStatusChange != nil {
+		tb.onStatusChange(status)
+	}
+}
+
+// Script sizes of the outputs of ticket purchase transactions.
+const (
+	p2pkhScriptSize      = 25
+	stakeP2PKHScriptSize = 26 // p2pkh script with an OP_SSTX or OP_SSTXCHANGE tag
+	commitmentScriptSize = 32 // OP_RETURN followed by a 30 byte push of the commitment address and amount
+)
+
+// estimatedFeePerTicket returns the fees paid at `feeRate` atoms/kB for each ticket:
+// the fee of the ticket transaction and of the outputs of the split transaction that fund the ticket.
+// Tickets bought through a VSP have a second input, commitment and change output to pay the VSP fee.
+func estimatedFeePerTicket(feeRate int64, vsp bool) dcrutil.Amount {
+	numberOfInputs := 1
+	if vsp {
+		numberOfInputs = 2
+	}
+
+	var ticketOutputs []*wire.TxOut
+	for i := 0; i < numberOfInputs; i++ {
+		ticketOutputs = append(ticketOutputs,
+			wire.NewTxOut(0, make([]byte, commitmentScriptSize)),
+			wire.NewTxOut(0, make([]byte, stakeP2PKHScriptSize)))
+	}
+	ticketOutputs = append(ticketOutputs, wire.NewTxOut(0, make([]byte, stakeP2PKHScriptSize)))
+	ticketFee := walletcore.EstimateFee(numberOfInputs, ticketOutputs, 0, feeRate)
+
+	splitOutputsSize := numberOfInputs * wire.NewTxOut(0, make([]byte, p2pkhScriptSize)).SerializeSize()
+	splitOutputsFee := txrules.FeeForSerializeSize(dcrutil.Amount(feeRate), splitOutputsSize)
+
+	return dcrutil.Amount(ticketFee) + splitOutputsFee
+}
+
+// ticketsToBuy returns the number of tickets, up to `maxPerBlock`, that can be bought at `price` plus `feePerTicket`
+// with the `spendable` funds above `balanceToMaintain`.
+func ticketsToBuy(spendable, balanceToMaintain, price, feePerTicket dcrutil.Amount, maxPerBlock uint32) uint32 {
+	costPerTicket := price + feePerTicket
+	if spendable <= balanceToMaintain || costPerTicket <= 0 {
+		return 0
+	}
+
+	numTickets := uint32((spendable - balanceToMaintain) / costPerTicket)
+	if numTickets > maxPerBlock {
+		return maxPerBlock
+	}
+	return numTickets
+}
+
+func dcrAmount(dcr float64) dcrutil.Amount {
+	amount, err := dcrutil.NewAmount(dcr)
+	if err != nil {
+		return 0
+	}
+	return amount
+}
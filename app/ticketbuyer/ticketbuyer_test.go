@@ -0,0 +1,96 @@
+package ticketbuyer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/app/walletmediums/mockwallet"
+)
+
+func TestTicketsToBuy(t *testing.T) {
+	tests := []struct {
+		name              string
+		spendable         dcrutil.Amount
+		balanceToMaintain dcrutil.Amount
+		price             dcrutil.Amount
+		feePerTicket      dcrutil.Amount
+		maxPerBlock       uint32
+		want              uint32
+	}{
+		{"no funds above balance to maintain", 100e8, 100e8, 10e8, 1e4, 5, 0},
+		{"funds for the price but not the fee", 110e8, 100e8, 10e8, 1e4, 5, 0},
+		{"fee is paid for each ticket", 130e8, 100e8, 10e8, 1e4, 5, 2},
+		{"limited by max per block", 200e8, 0, 10e8, 1e4, 5, 5},
+		{"no ticket price", 200e8, 0, 0, 0, 5, 0},
+	}
+
+	for _, test := range tests {
+		got := ticketsToBuy(test.spendable, test.balanceToMaintain, test.price, test.feePerTicket, test.maxPerBlock)
+		if got != test.want {
+			t.Errorf("%s: got %d tickets, want %d", test.name, got, test.want)
+		}
+	}
+}
+
+func TestEstimatedFeePerTicket(t *testing.T) {
+	soloFee := estimatedFeePerTicket(walletcore.DefaultFeeRate, false)
+	if soloFee <= 0 {
+		t.Fatalf("solo ticket fee is %s, want a positive fee", soloFee)
+	}
+	if vspFee := estimatedFeePerTicket(walletcore.DefaultFeeRate, true); vspFee <= soloFee {
+		t.Errorf("vsp ticket fee %s is not higher than solo ticket fee %s", vspFee, soloFee)
+	}
+	if doubleRateFee := estimatedFeePerTicket(2*walletcore.DefaultFeeRate, false); doubleRateFee <= soloFee {
+		t.Errorf("ticket fee at double the fee rate %s is not higher than %s", doubleRateFee, soloFee)
+	}
+}
+
+func TestTicketBuyerWaitsForSync(t *testing.T) {
+	wallet, err := mockwallet.Connect("testnet3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wallet.CloseWallet()
+
+	statusChanges := make(chan Status, 10)
+	ticketBuyer := New(wallet, config.TicketBuyerConfig{MaxPerBlock: 1}, func(status Status) {
+		statusChanges <- status
+	})
+	defer ticketBuyer.Close()
+
+	nextStatus := func() Status {
+		select {
+		case status := <-statusChanges:
+			return status
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for ticket buyer status change")
+			return Status{}
+		}
+	}
+
+	if err = ticketBuyer.Start(context.Background(), mockwallet.PrivatePassphrase); err != nil {
+		t.Fatal(err)
+	}
+	if status := nextStatus(); status.LastDecision != waitingForSync {
+		t.Fatalf("ticket buyer started before sync with decision %q, want %q", status.LastDecision, waitingForSync)
+	}
+
+	// blocks attached before the wallet is synced are ignored
+	wallet.Events().Publish(events.Event{Type: events.BlockAttached, Data: events.Block{Height: 1}})
+	wallet.Events().Publish(events.Event{Type: events.SyncProgress, Data: events.SyncProgressData{Percentage: 100, Done: true}})
+	status := nextStatus()
+	bestBlock, _ := wallet.BestBlock()
+	if status.LastBlock != bestBlock {
+		t.Fatalf("ticket buyer processed block %d after sync, want best block %d", status.LastBlock, bestBlock)
+	}
+
+	wallet.Events().Publish(events.Event{Type: events.ConnectionChanged, Data: events.Connection{Connected: false}})
+	if status = nextStatus(); status.LastDecision != waitingForSync || !status.Running {
+		t.Fatalf("ticket buyer status after sync was lost is %+v, want running and %q", status, waitingForSync)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/raedahgroup/godcr/app/txfilter
+
+go 1.12
@@ -0,0 +1,189 @@
+package txfilter
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// atomsPerDCR is the number of atoms in one DCR.
+const atomsPerDCR = 1e8
+
+// DateFormat is the format of the dates that bound the transactions to return.
+const DateFormat = "2006-01-02"
+
+// Criteria narrows down transaction history beyond the direction and type filters supported by the tx index.
+// Zero values are ignored, so an empty Criteria matches every transaction.
+type Criteria struct {
+	// From and To bound the time of the transactions. From is inclusive, To is exclusive.
+	From time.Time
+	To   time.Time
+
+	// MinAmount and MaxAmount bound the amount of the transactions in atoms.
+	MinAmount int64
+	MaxAmount int64
+
+	// Address is an address that transactions must pay to.
+	Address string
+
+	// Account is the name of a wallet account that transactions must spend from or pay to, ignoring case.
+	Account string
+
+	// HashPrefix is the beginning of the hash of the transactions, ignoring case.
+	HashPrefix string
+}
+
+// Tx holds the transaction fields that criteria are checked against.
+// It lets the criteria be used with transactions from the different wallet libraries.
+type Tx struct {
+	Hash      string
+	Timestamp int64
+	Amount    int64
+	Addresses []string
+	Accounts  []string
+}
+
+// CriteriaInput holds criteria as entered by the user, with dates in DateFormat and amounts in DCR.
+// Empty fields are ignored.
+type CriteriaInput struct {
+	From       string
+	To         string
+	MinAmount  string
+	MaxAmount  string
+	Address    string
+	Account    string
+	HashPrefix string
+}
+
+// ParseCriteria converts criteria entered by the user to Criteria. The `To` date is inclusive.
+func ParseCriteria(input CriteriaInput) (*Criteria, error) {
+	criteria := &Criteria{
+		Address:    strings.TrimSpace(input.Address),
+		Account:    strings.TrimSpace(input.Account),
+		HashPrefix: strings.TrimSpace(input.HashPrefix),
+	}
+
+	var err error
+	criteria.From, criteria.To, err = ParseDateRange(strings.TrimSpace(input.From), strings.TrimSpace(input.To))
+	if err != nil {
+		return nil, err
+	}
+
+	if criteria.MinAmount, err = parseAmount(input.MinAmount); err != nil {
+		return nil, fmt.Errorf("invalid minimum amount: %s", err.Error())
+	}
+	if criteria.MaxAmount, err = parseAmount(input.MaxAmount); err != nil {
+		return nil, fmt.Errorf("invalid maximum amount: %s", err.Error())
+	}
+
+	if err = criteria.Validate(); err != nil {
+		return nil, err
+	}
+	return criteria, nil
+}
+
+// parseAmount converts a DCR amount to atoms, an empty amount is 0.
+func parseAmount(amountText string) (int64, error) {
+	amountText = strings.TrimSpace(amountText)
+	if amountText == "" {
+		return 0, nil
+	}
+
+	amountDCR, err := strconv.ParseFloat(amountText, 64)
+	if err != nil || math.IsNaN(amountDCR) || math.IsInf(amountDCR, 0) {
+		return 0, fmt.Errorf("%s is not a number", amountText)
+	}
+	return int64(math.Round(amountDCR * atomsPerDCR)), nil
+}
+
+// ParseDateRange parses the optional `from` and `to` dates, both in DateFormat and UTC, into the time range used by Criteria.
+// Both dates are inclusive, an empty date leaves that end of the range unbounded.
+func ParseDateRange(from, to string) (fromTime, toTime time.Time, err error) {
+	if from != "" {
+		fromTime, err = time.Parse(DateFormat, from)
+		if err != nil {
+			return fromTime, toTime, fmt.Errorf("invalid from date, use the format YYYY-MM-DD")
+		}
+	}
+	if to != "" {
+		toTime, err = time.Parse(DateFormat, to)
+		if err != nil {
+			return fromTime, toTime, fmt.Errorf("invalid to date, use the format YYYY-MM-DD")
+		}
+		// include txs on the `to` date
+		toTime = toTime.AddDate(0, 0, 1)
+	}
+	if !fromTime.IsZero() && !toTime.IsZero() && !fromTime.Before(toTime) {
+		return fromTime, toTime, fmt.Errorf("from date cannot be after to date")
+	}
+	return
+}
+
+// Validate returns an error if the criteria cannot match any transaction.
+func (criteria *Criteria) Validate() error {
+	if criteria.MinAmount < 0 || criteria.MaxAmount < 0 {
+		return fmt.Errorf("amounts cannot be negative")
+	}
+	if criteria.MaxAmount > 0 && criteria.MinAmount > criteria.MaxAmount {
+		return fmt.Errorf("minimum amount cannot be more than maximum amount")
+	}
+	return nil
+}
+
+// IsEmpty returns true if no criteria is set.
+func (criteria *Criteria) IsEmpty() bool {
+	return criteria == nil || *criteria == Criteria{}
+}
+
+// IsBeforeRange returns true if `timestamp` is earlier than the From time of the criteria.
+// Tx history is read newest first, so no more transactions can match once this is true.
+func (criteria *Criteria) IsBeforeRange(timestamp int64) bool {
+	return criteria != nil && !criteria.From.IsZero() && time.Unix(timestamp, 0).Before(criteria.From)
+}
+
+// Match returns true if `tx` satisfies all the criteria that are set.
+func (criteria *Criteria) Match(tx Tx) bool {
+	if criteria.IsEmpty() {
+		return true
+	}
+
+	txTime := time.Unix(tx.Timestamp, 0)
+	if !criteria.From.IsZero() && txTime.Before(criteria.From) {
+		return false
+	}
+	if !criteria.To.IsZero() && !txTime.Before(criteria.To) {
+		return false
+	}
+
+	if criteria.MinAmount > 0 && tx.Amount < criteria.MinAmount {
+		return false
+	}
+	if criteria.MaxAmount > 0 && tx.Amount > criteria.MaxAmount {
+		return false
+	}
+
+	if criteria.HashPrefix != "" && !strings.HasPrefix(strings.ToLower(tx.Hash), strings.ToLower(criteria.HashPrefix)) {
+		return false
+	}
+
+	if criteria.Address != "" && !containsString(tx.Addresses, criteria.Address, false) {
+		return false
+	}
+
+	if criteria.Account != "" && !containsString(tx.Accounts, criteria.Account, true) {
+		return false
+	}
+
+	return true
+}
+
+func containsString(values []string, value string, ignoreCase bool) bool {
+	for _, v := range values {
+		if v == value || (ignoreCase && strings.EqualFold(v, value)) {
+			return true
+		}
+	}
+	return false
+}
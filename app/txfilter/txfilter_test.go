@@ -0,0 +1,147 @@
+package txfilter
+
+import (
+	"testing"
+	"time"
+)
+
+func date(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(DateFormat, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestParseCriteria(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   CriteriaInput
+		want    Criteria
+		wantErr bool
+	}{
+		{
+			name:  "empty input",
+			input: CriteriaInput{},
+			want:  Criteria{},
+		},
+		{
+			name: "all fields",
+			input: CriteriaInput{
+				From: " 2019-01-01", To: "2019-01-31 ", MinAmount: "0.5", MaxAmount: " 1.00000001 ",
+				Address: " TsAddress ", Account: "Savings", HashPrefix: "ab12",
+			},
+			want: Criteria{
+				From: date(t, "2019-01-01"), To: date(t, "2019-02-01"), MinAmount: 0.5e8, MaxAmount: 100000001,
+				Address: "TsAddress", Account: "Savings", HashPrefix: "ab12",
+			},
+		},
+		{
+			name:  "same from and to date",
+			input: CriteriaInput{From: "2019-05-05", To: "2019-05-05"},
+			want:  Criteria{From: date(t, "2019-05-05"), To: date(t, "2019-05-06")},
+		},
+		{
+			name:    "invalid date",
+			input:   CriteriaInput{From: "01/02/2019"},
+			wantErr: true,
+		},
+		{
+			name:    "from after to",
+			input:   CriteriaInput{From: "2019-02-01", To: "2019-01-01"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid amount",
+			input:   CriteriaInput{MinAmount: "1dcr"},
+			wantErr: true,
+		},
+		{
+			name:    "not a number amount",
+			input:   CriteriaInput{MaxAmount: "NaN"},
+			wantErr: true,
+		},
+		{
+			name:    "negative amount",
+			input:   CriteriaInput{MinAmount: "-1"},
+			wantErr: true,
+		},
+		{
+			name:    "min amount above max amount",
+			input:   CriteriaInput{MinAmount: "2", MaxAmount: "1"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := ParseCriteria(test.input)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: got %+v, want an error", test.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err.Error())
+			continue
+		}
+		if *got != test.want {
+			t.Errorf("%s: got %+v, want %+v", test.name, *got, test.want)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tx := Tx{
+		Hash:      "AB12cd",
+		Timestamp: time.Date(2019, 3, 10, 15, 0, 0, 0, time.UTC).Unix(),
+		Amount:    2e8,
+		Addresses: []string{"TsFirst", "TsSecond"},
+		Accounts:  []string{"default", "Savings"},
+	}
+
+	tests := []struct {
+		name     string
+		criteria *Criteria
+		want     bool
+	}{
+		{"nil criteria", nil, true},
+		{"empty criteria", &Criteria{}, true},
+		{"within date range", &Criteria{From: date(t, "2019-03-10"), To: date(t, "2019-03-11")}, true},
+		{"before from date", &Criteria{From: date(t, "2019-03-11")}, false},
+		{"to date is exclusive", &Criteria{To: date(t, "2019-03-10")}, false},
+		{"min amount is inclusive", &Criteria{MinAmount: 2e8}, true},
+		{"below min amount", &Criteria{MinAmount: 2e8 + 1}, false},
+		{"max amount is inclusive", &Criteria{MaxAmount: 2e8}, true},
+		{"above max amount", &Criteria{MaxAmount: 2e8 - 1}, false},
+		{"hash prefix ignores case", &Criteria{HashPrefix: "ab12CD"}, true},
+		{"other hash prefix", &Criteria{HashPrefix: "ab13"}, false},
+		{"paid to address", &Criteria{Address: "TsSecond"}, true},
+		{"address is case sensitive", &Criteria{Address: "tssecond"}, false},
+		{"account ignores case", &Criteria{Account: "savings"}, true},
+		{"other account", &Criteria{Account: "imported"}, false},
+		{"all criteria must match", &Criteria{Account: "default", MinAmount: 3e8}, false},
+	}
+
+	for _, test := range tests {
+		if got := test.criteria.Match(tx); got != test.want {
+			t.Errorf("%s: got %t, want %t", test.name, got, test.want)
+		}
+	}
+}
+
+func TestIsBeforeRange(t *testing.T) {
+	timestamp := date(t, "2019-03-10").Unix()
+
+	var nilCriteria *Criteria
+	if nilCriteria.IsBeforeRange(timestamp) || (&Criteria{}).IsBeforeRange(timestamp) {
+		t.Error("tx is before the range of criteria without a from date")
+	}
+	if (&Criteria{From: date(t, "2019-03-10")}).IsBeforeRange(timestamp) {
+		t.Error("tx on the from date is before the range")
+	}
+	if !(&Criteria{From: date(t, "2019-03-11")}).IsBeforeRange(timestamp) {
+		t.Error("tx before the from date is not before the range")
+	}
+}
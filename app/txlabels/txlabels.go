@@ -0,0 +1,102 @@
+package txlabels
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/raedahgroup/godcr/app/jsonfile"
+)
+
+// FileName is the name of the file that transaction labels are saved to, in the same directory as the tx index database.
+const FileName = "txlabels.json"
+
+// Store keeps free-text labels that the user has attached to transactions, keyed by transaction hash.
+// Labels are only stored locally, they are not part of the transactions.
+type Store struct {
+	filePath string
+
+	mu     sync.RWMutex
+	labels map[string]string
+}
+
+// Load reads the transaction labels previously saved to `filePath`.
+// If `filePath` is empty, labels are only kept in memory.
+func Load(filePath string) (*Store, error) {
+	store := &Store{
+		filePath: filePath,
+		labels:   make(map[string]string),
+	}
+	if filePath == "" {
+		return store, nil
+	}
+
+	fileContent, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return store, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading transaction labels file: %s", err.Error())
+	}
+
+	if err = json.Unmarshal(fileContent, &store.labels); err != nil {
+		return nil, fmt.Errorf("error reading transaction labels file: %s", err.Error())
+	}
+	return store, nil
+}
+
+// SetLabel attaches `label` to the transaction with hash `txHash`, replacing any previous label.
+// An empty label removes the transaction's label.
+func (store *Store) SetLabel(txHash, label string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	label = strings.TrimSpace(label)
+	if label == "" {
+		delete(store.labels, txHash)
+	} else {
+		store.labels[txHash] = label
+	}
+
+	return store.save()
+}
+
+// Label returns the label attached to the transaction with hash `txHash` or an empty string if it has none.
+func (store *Store) Label(txHash string) string {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return store.labels[txHash]
+}
+
+// Search returns the hashes of the transactions with labels that contain `query`, ignoring case.
+// The hashes are sorted so that results are returned in a consistent order.
+func (store *Store) Search(query string) []string {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	query = strings.ToLower(strings.TrimSpace(query))
+
+	var txHashes []string
+	for txHash, label := range store.labels {
+		if strings.Contains(strings.ToLower(label), query) {
+			txHashes = append(txHashes, txHash)
+		}
+	}
+	sort.Strings(txHashes)
+	return txHashes
+}
+
+// save writes the labels to file, the caller must hold the write lock.
+func (store *Store) save() error {
+	if store.filePath == "" {
+		return nil
+	}
+
+	if err := jsonfile.Save(store.filePath, store.labels); err != nil {
+		return fmt.Errorf("error saving transaction labels: %s", err.Error())
+	}
+	return nil
+}
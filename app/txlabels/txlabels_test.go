@@ -0,0 +1,51 @@
+package txlabels
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "godcr-txlabels")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, FileName)
+	store, err := Load(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	labels := map[string]string{
+		"tx1": "Rent",
+		"tx2": " rent deposit ",
+		"tx3": "groceries",
+	}
+	for txHash, label := range labels {
+		if err = store.SetLabel(txHash, label); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// an empty label removes the label
+	if err = store.SetLabel("tx3", "  "); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := Load(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if label := reloaded.Label("tx2"); label != "rent deposit" {
+		t.Fatalf("reloaded label %q, want %q", label, "rent deposit")
+	}
+	if label := reloaded.Label("tx3"); label != "" {
+		t.Fatalf("reloaded removed label %q", label)
+	}
+	if txHashes := reloaded.Search("RENT"); !reflect.DeepEqual(txHashes, []string{"tx1", "tx2"}) {
+		t.Fatalf("search found %v, want [tx1 tx2]", txHashes)
+	}
+}
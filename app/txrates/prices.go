@@ -0,0 +1,68 @@
+package txrates
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateFormat is the format of the dates in historical prices files.
+const DateFormat = "2006-01-02"
+
+// Prices holds the daily price of 1 DCR in a fiat currency, keyed by UTC date in DateFormat.
+type Prices map[string]float64
+
+// ReadPricesCSV reads daily prices from csv data with the columns date,price.
+// Dates may include a time after the date, which is ignored. A header row is skipped if present.
+func ReadPricesCSV(reader io.Reader) (Prices, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	prices := make(Prices)
+	for line := 1; ; line++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading prices csv: %s", err.Error())
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: expected the columns date,price", line)
+		}
+
+		dateText := strings.TrimSpace(record[0])
+		if len(dateText) > len(DateFormat) {
+			dateText = dateText[:len(DateFormat)]
+		}
+		date, dateErr := time.Parse(DateFormat, dateText)
+		price, priceErr := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if line == 1 && (dateErr != nil || priceErr != nil) {
+			// header row
+			continue
+		}
+		if dateErr != nil {
+			return nil, fmt.Errorf("line %d: invalid date %s, use the format YYYY-MM-DD", line, record[0])
+		}
+		if priceErr != nil || price < 0 {
+			return nil, fmt.Errorf("line %d: invalid price %s", line, record[1])
+		}
+
+		prices[date.Format(DateFormat)] = price
+	}
+
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("no prices found in csv")
+	}
+	return prices, nil
+}
+
+// PriceOn returns the price on the UTC date of `t`.
+func (prices Prices) PriceOn(t time.Time) (float64, bool) {
+	price, ok := prices[t.UTC().Format(DateFormat)]
+	return price, ok
+}
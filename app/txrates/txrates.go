@@ -0,0 +1,106 @@
+package txrates
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/raedahgroup/godcr/app/jsonfile"
+)
+
+// FileName is the name of the file in the app data directory that transaction rates are saved to.
+const FileName = "txrates.json"
+
+// Rate is the value of 1 DCR in a fiat currency at the time of a transaction.
+type Rate struct {
+	Value float64 `json:"value"`
+
+	// Source is the exchange rate source or prices file that the rate was taken from.
+	Source string `json:"source"`
+
+	// RecordedAt is the unix time that the rate was saved.
+	RecordedAt int64 `json:"recorded_at"`
+}
+
+// Store keeps the fiat rates of transactions, keyed by transaction hash and then by currency code,
+// so that the value of past transactions can be reported in fiat.
+type Store struct {
+	filePath string
+
+	mu    sync.RWMutex
+	rates map[string]map[string]Rate
+}
+
+// Load reads the transaction rates previously saved in `appDataDir`.
+// If `appDataDir` is empty, rates are only kept in memory.
+func Load(appDataDir string) (*Store, error) {
+	store := &Store{
+		rates: make(map[string]map[string]Rate),
+	}
+	if appDataDir == "" {
+		return store, nil
+	}
+
+	store.filePath = filepath.Join(appDataDir, FileName)
+	fileContent, err := ioutil.ReadFile(store.filePath)
+	if os.IsNotExist(err) {
+		return store, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading transaction rates file: %s", err.Error())
+	}
+
+	if err = json.Unmarshal(fileContent, &store.rates); err != nil {
+		return nil, fmt.Errorf("error reading transaction rates file: %s", err.Error())
+	}
+	return store, nil
+}
+
+// Rate returns the rate saved for the transaction with hash `txHash` in `currency`.
+func (store *Store) Rate(txHash, currency string) (Rate, bool) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	rate, ok := store.rates[txHash][normalizeCurrency(currency)]
+	return rate, ok
+}
+
+// SetRates saves `rates`, keyed by transaction hash, as the rates of the transactions in `currency`,
+// replacing any rates previously saved for those transactions in that currency.
+func (store *Store) SetRates(currency string, rates map[string]Rate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	currency = normalizeCurrency(currency)
+	for txHash, rate := range rates {
+		if store.rates[txHash] == nil {
+			store.rates[txHash] = make(map[string]Rate)
+		}
+		store.rates[txHash][currency] = rate
+	}
+
+	return store.save()
+}
+
+// save writes the rates to file, the caller must hold the write lock.
+func (store *Store) save() error {
+	if store.filePath == "" {
+		return nil
+	}
+
+	if err := jsonfile.Save(store.filePath, store.rates); err != nil {
+		return fmt.Errorf("error saving transaction rates: %s", err.Error())
+	}
+	return nil
+}
+
+func normalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
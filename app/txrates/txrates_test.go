@@ -0,0 +1,57 @@
+package txrates
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "godcr-txrates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.SetRates("usd", map[string]Rate{
+		"tx1": {Value: 20, Source: "coingecko"},
+		"tx2": {Value: 21, Source: "coingecko"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// rates in another currency are kept separately, rates in the same currency are replaced
+	if err = store.SetRates("EUR", map[string]Rate{"tx1": {Value: 18, Source: "file"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.SetRates(" USD ", map[string]Rate{"tx2": {Value: 22, Source: "file"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		txHash   string
+		currency string
+		want     float64
+	}{
+		{"tx1", "USD", 20},
+		{"tx1", "eur", 18},
+		{"tx2", "usd", 22},
+	}
+	for _, test := range tests {
+		rate, ok := reloaded.Rate(test.txHash, test.currency)
+		if !ok || rate.Value != test.want {
+			t.Fatalf("reloaded %s rate of %s %v, want %v", test.currency, test.txHash, rate.Value, test.want)
+		}
+	}
+	if _, ok := reloaded.Rate("tx2", "EUR"); ok {
+		t.Fatal("found a rate that was not saved")
+	}
+}
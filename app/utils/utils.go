@@ -99,13 +99,14 @@ func FetchAccounts(requiredConfirmations int32, settings *config.Settings, walle
 	return accounts, nil
 }
 
-func EstimateFee(numberOfInputs int, destinations []txhelper.TransactionDestination) (dcrutil.Amount, error) {
+// EstimateFee returns the fee for a transaction spending `numberOfInputs` p2pkh outputs to `destinations`
+// at `feeRate` atoms/kB.
+func EstimateFee(numberOfInputs int, destinations []txhelper.TransactionDestination, feeRate int64) (dcrutil.Amount, error) {
 	maxSignedSize, err := EstimateSerializeSize(numberOfInputs, destinations)
 	if err != nil {
 		return 0, err
 	}
-	relayFeePerKb := txrules.DefaultRelayFeePerKb
-	maxRequiredFee := txrules.FeeForSerializeSize(relayFeePerKb, maxSignedSize)
+	maxRequiredFee := txrules.FeeForSerializeSize(dcrutil.Amount(feeRate), maxSignedSize)
 
 	return maxRequiredFee, err
 }
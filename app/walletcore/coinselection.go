@@ -0,0 +1,287 @@
+package walletcore
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet/txrules"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+)
+
+// Coin selection strategies that can be used to automatically select the inputs for a transaction.
+const (
+	LargestFirstCoinSelection   = "largest-first"
+	SmallestFirstCoinSelection  = "smallest-first"
+	BranchAndBoundCoinSelection = "branch-and-bound"
+	OldestFirstCoinSelection    = "oldest-first"
+	PrivacyCoinSelection        = "privacy"
+
+	DefaultCoinSelection = LargestFirstCoinSelection
+)
+
+// CoinSelectionStrategies lists all supported coin selection strategies in the order they should be presented to users.
+var CoinSelectionStrategies = []string{
+	LargestFirstCoinSelection,
+	SmallestFirstCoinSelection,
+	BranchAndBoundCoinSelection,
+	OldestFirstCoinSelection,
+	PrivacyCoinSelection,
+}
+
+// p2pkhPkScriptSize is the size of a change output script paying to a p2pkh address.
+// It is calculated as: OP_DUP OP_HASH160 OP_DATA_20 <20 bytes pubkey hash> OP_EQUALVERIFY OP_CHECKSIG
+const p2pkhPkScriptSize = 1 + 1 + 1 + 20 + 1 + 1
+
+// maxBranchAndBoundTries limits the number of utxo combinations the branch-and-bound strategy checks for an exact match.
+const maxBranchAndBoundTries = 100000
+
+// CoinSelector selects the unspent outputs to use as inputs for a transaction.
+type CoinSelector interface {
+	// SelectCoins returns the utxos to spend to pay for `target`.
+	// The utxos slice may be reordered by the selector.
+	SelectCoins(utxos []*UnspentOutput, target *CoinSelectionTarget) ([]*UnspentOutput, error)
+}
+
+// CoinSelectionTarget is the amount that selected utxos must pay, including the fee for the resulting transaction.
+type CoinSelectionTarget struct {
+	SendAmount int64
+	outputs    []*wire.TxOut
+	feeRate    int64
+}
+
+// RequiredAmount returns the amount that `numberOfInputs` inputs must provide to pay the send amount
+// and the fee for a tx that also has a change output.
+func (target *CoinSelectionTarget) RequiredAmount(numberOfInputs int) int64 {
+	return target.SendAmount + EstimateFee(numberOfInputs, target.outputs, p2pkhPkScriptSize, target.feeRate)
+}
+
+// requiredAmountWithoutChange returns the amount that `numberOfInputs` inputs must provide to pay the send amount
+// and the fee for a tx without a change output. Any amount above this that is dust is added to the tx fee.
+func (target *CoinSelectionTarget) requiredAmountWithoutChange(numberOfInputs int) int64 {
+	return target.SendAmount + EstimateFee(numberOfInputs, target.outputs, 0, target.feeRate)
+}
+
+// isDustChange returns true if `changeAmount` is too small to be sent to a change output.
+// Such change is added to the tx fee instead, so no change output is created.
+func (target *CoinSelectionTarget) isDustChange(changeAmount int64) bool {
+	return txrules.IsDustAmount(dcrutil.Amount(changeAmount), p2pkhPkScriptSize, dcrutil.Amount(target.feeRate))
+}
+
+// NewCoinSelector returns the CoinSelector for the coin selection `strategy`.
+func NewCoinSelector(strategy string) (CoinSelector, error) {
+	switch strategy {
+	case LargestFirstCoinSelection:
+		return largestFirstSelector{}, nil
+	case SmallestFirstCoinSelection:
+		return smallestFirstSelector{}, nil
+	case BranchAndBoundCoinSelection:
+		return branchAndBoundSelector{}, nil
+	case OldestFirstCoinSelection:
+		return oldestFirstSelector{}, nil
+	case PrivacyCoinSelection:
+		return privacySelector{}, nil
+	default:
+		return nil, fmt.Errorf("unknown coin selection strategy: %s", strategy)
+	}
+}
+
+// SelectCoins uses the coin selection `strategy` to pick utxos from `utxos` that can pay the send destinations
+// and the tx fee at `feeRate` atoms/kB. All utxos are selected if any destination is set to receive max amount.
+// Locked utxos are never selected.
+func SelectCoins(strategy string, utxos []*UnspentOutput, sendDestinations []txhelper.TransactionDestination,
+	feeRate int64) (selectedUtxos []*UnspentOutput, totalInputAmount dcrutil.Amount, err error) {
+
+	coinSelector, err := NewCoinSelector(strategy)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	outputs, totalSendAmount, maxAmountRecipientAddress, err := txhelper.TxOutputsExtractMaxDestinationAddress(sendDestinations)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// unlockedOutputs returns a new slice, so the caller's slice is not reordered by the coin selector
+	candidates := unlockedOutputs(utxos)
+
+	if maxAmountRecipientAddress != "" {
+		selectedUtxos = candidates
+	} else {
+		target := &CoinSelectionTarget{
+			SendAmount: totalSendAmount,
+			outputs:    outputs,
+			feeRate:    feeRate,
+		}
+		selectedUtxos, err = coinSelector.SelectCoins(candidates, target)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	for _, utxo := range selectedUtxos {
+		totalInputAmount += utxo.Amount
+	}
+	return
+}
+
+// accumulateCoins selects utxos in the order provided until they can pay for `target`.
+func accumulateCoins(utxos []*UnspentOutput, target *CoinSelectionTarget) ([]*UnspentOutput, error) {
+	var totalInputAmount int64
+	for i, utxo := range utxos {
+		totalInputAmount += int64(utxo.Amount)
+		if totalInputAmount >= target.RequiredAmount(i+1) {
+			return utxos[:i+1], nil
+		}
+	}
+	return nil, insufficientFundsError(totalInputAmount, target.RequiredAmount(len(utxos)))
+}
+
+func insufficientFundsError(availableAmount, requiredAmount int64) error {
+	return fmt.Errorf("insufficient funds: %s available, %s required to pay the send amount and tx fee",
+		dcrutil.Amount(availableAmount).String(), dcrutil.Amount(requiredAmount).String())
+}
+
+// largestFirstSelector spends the largest utxos first, producing transactions with few inputs and low fees.
+type largestFirstSelector struct{}
+
+func (largestFirstSelector) SelectCoins(utxos []*UnspentOutput, target *CoinSelectionTarget) ([]*UnspentOutput, error) {
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].Amount > utxos[j].Amount
+	})
+	return accumulateCoins(utxos, target)
+}
+
+// smallestFirstSelector spends the smallest utxos first, consolidating small outputs at the cost of higher fees.
+type smallestFirstSelector struct{}
+
+func (smallestFirstSelector) SelectCoins(utxos []*UnspentOutput, target *CoinSelectionTarget) ([]*UnspentOutput, error) {
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].Amount < utxos[j].Amount
+	})
+	return accumulateCoins(utxos, target)
+}
+
+// oldestFirstSelector spends the utxos that were received earliest first.
+type oldestFirstSelector struct{}
+
+func (oldestFirstSelector) SelectCoins(utxos []*UnspentOutput, target *CoinSelectionTarget) ([]*UnspentOutput, error) {
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].ReceiveTime < utxos[j].ReceiveTime
+	})
+	return accumulateCoins(utxos, target)
+}
+
+// branchAndBoundSelector searches for a combination of utxos that pays for the target exactly,
+// i.e. with a change amount too small for a change output. Avoiding change outputs reduces fees
+// and does not reveal which output of the tx is change. If no exact match is found,
+// the largest utxos are spent first.
+type branchAndBoundSelector struct{}
+
+func (branchAndBoundSelector) SelectCoins(utxos []*UnspentOutput, target *CoinSelectionTarget) ([]*UnspentOutput, error) {
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].Amount > utxos[j].Amount
+	})
+
+	// remainingAmounts[i] is the total amount of utxos[i:], used to stop searching branches that cannot reach the target
+	remainingAmounts := make([]int64, len(utxos)+1)
+	for i := len(utxos) - 1; i >= 0; i-- {
+		remainingAmounts[i] = remainingAmounts[i+1] + int64(utxos[i].Amount)
+	}
+
+	var tries int
+	var selection []*UnspentOutput
+	var search func(index int, totalInputAmount int64) bool
+	search = func(index int, totalInputAmount int64) bool {
+		tries++
+		if tries > maxBranchAndBoundTries {
+			return false
+		}
+
+		if len(selection) > 0 {
+			// an exact match has no change output, so its fee is lower than the fee for a tx with change
+			requiredAmount := target.requiredAmountWithoutChange(len(selection))
+			if totalInputAmount >= requiredAmount {
+				// adding more inputs will only increase the change amount
+				return target.isDustChange(totalInputAmount - requiredAmount)
+			}
+		}
+
+		if index == len(utxos) || totalInputAmount+remainingAmounts[index] < target.SendAmount {
+			return false
+		}
+
+		// try including utxos[index], then try without it
+		selection = append(selection, utxos[index])
+		if search(index+1, totalInputAmount+int64(utxos[index].Amount)) {
+			return true
+		}
+		selection = selection[:len(selection)-1]
+		return search(index+1, totalInputAmount)
+	}
+
+	if search(0, 0) {
+		return selection, nil
+	}
+	return accumulateCoins(utxos, target)
+}
+
+// privacySelector avoids merging utxos received at different addresses in one transaction, since doing so
+// reveals that those addresses belong to the same wallet. All utxos of a selected address are spent together.
+// If no single address can pay for the target, addresses with the largest balances are combined.
+type privacySelector struct{}
+
+func (privacySelector) SelectCoins(utxos []*UnspentOutput, target *CoinSelectionTarget) ([]*UnspentOutput, error) {
+	type addressUtxos struct {
+		utxos       []*UnspentOutput
+		totalAmount int64
+	}
+
+	var addressGroups []*addressUtxos
+	groupsByAddress := make(map[string]*addressUtxos)
+	for _, utxo := range utxos {
+		group, ok := groupsByAddress[utxo.Address]
+		if !ok {
+			group = &addressUtxos{}
+			groupsByAddress[utxo.Address] = group
+			addressGroups = append(addressGroups, group)
+		}
+		group.utxos = append(group.utxos, utxo)
+		group.totalAmount += int64(utxo.Amount)
+	}
+
+	sort.SliceStable(addressGroups, func(i, j int) bool {
+		return addressGroups[i].totalAmount < addressGroups[j].totalAmount
+	})
+
+	// spend from the address with the smallest balance that can pay for the target on its own
+	for _, group := range addressGroups {
+		if group.totalAmount >= target.RequiredAmount(len(group.utxos)) {
+			return group.utxos, nil
+		}
+	}
+
+	// merge as few addresses as possible
+	var selection []*UnspentOutput
+	var totalInputAmount int64
+	for i := len(addressGroups) - 1; i >= 0; i-- {
+		selection = append(selection, addressGroups[i].utxos...)
+		totalInputAmount += addressGroups[i].totalAmount
+		if totalInputAmount >= target.RequiredAmount(len(selection)) {
+			return selection, nil
+		}
+	}
+	return nil, insufficientFundsError(totalInputAmount, target.RequiredAmount(len(selection)))
+}
+
+// unlockedOutputs returns the utxos that are not locked.
+func unlockedOutputs(utxos []*UnspentOutput) []*UnspentOutput {
+	unlocked := make([]*UnspentOutput, 0, len(utxos))
+	for _, utxo := range utxos {
+		if !utxo.Locked {
+			unlocked = append(unlocked, utxo)
+		}
+	}
+	return unlocked
+}
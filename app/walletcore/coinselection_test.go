@@ -0,0 +1,199 @@
+package walletcore
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/wire"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+)
+
+type testUtxo struct {
+	amount       int64
+	addressIndex uint32
+	receiveTime  int64
+	locked       bool
+}
+
+func testUnspentOutputs(t *testing.T, specs ...testUtxo) []*UnspentOutput {
+	utxos := make([]*UnspentOutput, len(specs))
+	for i, spec := range specs {
+		utxos[i] = &UnspentOutput{
+			OutputKey:       fmt.Sprintf("%064x:%d", i, 0),
+			TransactionHash: fmt.Sprintf("%064x", i),
+			ReceiveTime:     spec.receiveTime,
+			Amount:          dcrutil.Amount(spec.amount),
+			Address:         testAddress(t, spec.addressIndex),
+			Locked:          spec.locked,
+		}
+	}
+	return utxos
+}
+
+func sortedAmounts(utxos []*UnspentOutput) []int64 {
+	amounts := make([]int64, len(utxos))
+	for i, utxo := range utxos {
+		amounts[i] = int64(utxo.Amount)
+	}
+	sort.Slice(amounts, func(i, j int) bool {
+		return amounts[i] < amounts[j]
+	})
+	return amounts
+}
+
+func TestSelectCoins(t *testing.T) {
+	recipient := testAddress(t, 100)
+	sendTo := func(amount int64) []txhelper.TransactionDestination {
+		return []txhelper.TransactionDestination{{Address: recipient, Amount: dcrutil.Amount(amount).ToCoin()}}
+	}
+
+	// 3 DCR and 2 DCR pay exactly for this send amount and the fee of a tx without change
+	sendOutput := []*wire.TxOut{wire.NewTxOut(0, make([]byte, p2pkhPkScriptSize))}
+	exactMatchAmount := 5e8 - EstimateFee(2, sendOutput, 0, DefaultFeeRate)
+
+	tests := []struct {
+		name         string
+		strategy     string
+		utxos        []testUtxo
+		destinations []txhelper.TransactionDestination
+		want         []int64
+		wantErr      bool
+	}{
+		{
+			name:         "largest first",
+			strategy:     LargestFirstCoinSelection,
+			utxos:        []testUtxo{{amount: 1e8}, {amount: 5e8}, {amount: 3e8}},
+			destinations: sendTo(4e8),
+			want:         []int64{5e8},
+		},
+		{
+			name:         "smallest first",
+			strategy:     SmallestFirstCoinSelection,
+			utxos:        []testUtxo{{amount: 5e8}, {amount: 1e8}, {amount: 3e8}},
+			destinations: sendTo(3.5e8),
+			want:         []int64{1e8, 3e8},
+		},
+		{
+			name:     "oldest first",
+			strategy: OldestFirstCoinSelection,
+			utxos: []testUtxo{
+				{amount: 5e8, receiveTime: 300},
+				{amount: 1e8, receiveTime: 100},
+				{amount: 3e8, receiveTime: 200},
+			},
+			destinations: sendTo(3.5e8),
+			want:         []int64{1e8, 3e8},
+		},
+		{
+			name:         "branch and bound exact match without change",
+			strategy:     BranchAndBoundCoinSelection,
+			utxos:        []testUtxo{{amount: 6e8}, {amount: 3e8}, {amount: 2e8}, {amount: 1.5e8}},
+			destinations: sendTo(exactMatchAmount),
+			want:         []int64{2e8, 3e8},
+		},
+		{
+			name:         "branch and bound falls back to largest first",
+			strategy:     BranchAndBoundCoinSelection,
+			utxos:        []testUtxo{{amount: 3e8}, {amount: 6e8}},
+			destinations: sendTo(1e8),
+			want:         []int64{6e8},
+		},
+		{
+			name:     "privacy spends from a single address",
+			strategy: PrivacyCoinSelection,
+			utxos: []testUtxo{
+				{amount: 1e8, addressIndex: 1},
+				{amount: 4e8, addressIndex: 2},
+				{amount: 1e8, addressIndex: 1},
+			},
+			destinations: sendTo(1.5e8),
+			want:         []int64{1e8, 1e8},
+		},
+		{
+			name:     "privacy merges addresses with the largest balances",
+			strategy: PrivacyCoinSelection,
+			utxos: []testUtxo{
+				{amount: 1e8, addressIndex: 1},
+				{amount: 4e8, addressIndex: 2},
+				{amount: 2e8, addressIndex: 3},
+			},
+			destinations: sendTo(5e8),
+			want:         []int64{2e8, 4e8},
+		},
+		{
+			name:         "locked utxos are not selected",
+			strategy:     LargestFirstCoinSelection,
+			utxos:        []testUtxo{{amount: 5e8, locked: true}, {amount: 3e8}},
+			destinations: sendTo(2e8),
+			want:         []int64{3e8},
+		},
+		{
+			name:         "insufficient funds without locked utxos",
+			strategy:     LargestFirstCoinSelection,
+			utxos:        []testUtxo{{amount: 5e8, locked: true}, {amount: 3e8}},
+			destinations: sendTo(4e8),
+			wantErr:      true,
+		},
+		{
+			name:         "send max selects all unlocked utxos",
+			strategy:     SmallestFirstCoinSelection,
+			utxos:        []testUtxo{{amount: 5e8}, {amount: 1e8, locked: true}, {amount: 3e8}},
+			destinations: []txhelper.TransactionDestination{{Address: recipient, SendMax: true}},
+			want:         []int64{3e8, 5e8},
+		},
+		{
+			name:         "unknown strategy",
+			strategy:     "random",
+			utxos:        []testUtxo{{amount: 5e8}},
+			destinations: sendTo(1e8),
+			wantErr:      true,
+		},
+	}
+
+	// every strategy fails if the utxos cannot pay the send amount and fee
+	for _, strategy := range CoinSelectionStrategies {
+		tests = append(tests, struct {
+			name         string
+			strategy     string
+			utxos        []testUtxo
+			destinations []txhelper.TransactionDestination
+			want         []int64
+			wantErr      bool
+		}{
+			name:         fmt.Sprintf("%s insufficient funds", strategy),
+			strategy:     strategy,
+			utxos:        []testUtxo{{amount: 1e8, addressIndex: 1}, {amount: 2e8, addressIndex: 2}},
+			destinations: sendTo(3e8),
+			wantErr:      true,
+		})
+	}
+
+	for _, test := range tests {
+		utxos := testUnspentOutputs(t, test.utxos...)
+		selected, totalInputAmount, err := SelectCoins(test.strategy, utxos, test.destinations, DefaultFeeRate)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: selected %v, want an error", test.name, sortedAmounts(selected))
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err.Error())
+			continue
+		}
+
+		if got := sortedAmounts(selected); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: selected %v, want %v", test.name, got, test.want)
+		}
+		var wantTotal int64
+		for _, amount := range test.want {
+			wantTotal += amount
+		}
+		if int64(totalInputAmount) != wantTotal {
+			t.Errorf("%s: total input amount is %d, want %d", test.name, totalInputAmount, wantTotal)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package walletcore
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strconv"
 
 	"github.com/decred/dcrd/dcrutil"
@@ -10,6 +12,7 @@ import (
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
 	"github.com/raedahgroup/dcrlibwallet/txindex"
 	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/godcr/app/txlabels"
 )
 
 const (
@@ -32,8 +35,22 @@ const (
 	TransactionFilterYourself = "Yourself"
 	TransactionFilterStaking  = "Staking"
 	TransactionFilterCoinbase = "Coinbase"
+
+	TicketStatusUnknown  = "UNKNOWN"
+	TicketStatusUnmined  = "UNMINED"
+	TicketStatusImmature = "IMMATURE"
+	TicketStatusLive     = "LIVE"
+	TicketStatusVoted    = "VOTED"
+	TicketStatusMissed   = "MISSED"
+	TicketStatusExpired  = "EXPIRED"
+	TicketStatusRevoked  = "REVOKED"
 )
 
+// ErrWatchingOnlyWallet is returned by wallet operations that require the wallet's private keys,
+// such as sending funds, purchasing tickets or changing the private passphrase,
+// if the wallet was created from an extended public key.
+var ErrWatchingOnlyWallet = errors.New("this operation is not supported by watching-only wallets")
+
 var TransactionFilters = []string{
 	TransactionFilterAll,
 	TransactionFilterSent,
@@ -43,6 +60,16 @@ var TransactionFilters = []string{
 	TransactionFilterCoinbase,
 }
 
+var TicketStatuses = []string{
+	TicketStatusUnmined,
+	TicketStatusImmature,
+	TicketStatusLive,
+	TicketStatusVoted,
+	TicketStatusMissed,
+	TicketStatusExpired,
+	TicketStatusRevoked,
+}
+
 func BuildTransactionFilter(filters ...string) *txindex.ReadFilter {
 	var (
 		txFilter       = txindex.Filter()
@@ -94,8 +121,9 @@ func WalletBalance(accounts []*Account) string {
 }
 
 // GetChangeDestinationsWithRandomAmounts generates change destination(s) based on the number of change addresses the user wants.
+// The change amount is what remains after paying the tx fee at `feeRate` atoms/kB.
 func GetChangeDestinationsWithRandomAmounts(wallet Wallet, nChangeOutputs int, amountInAtom int64, sourceAccount uint32,
-	nUtxoSelection int, sendDestinations []txhelper.TransactionDestination) (changeOutputDestinations []txhelper.TransactionDestination, err error) {
+	nUtxoSelection int, sendDestinations []txhelper.TransactionDestination, feeRate int64) (changeOutputDestinations []txhelper.TransactionDestination, err error) {
 
 	var changeAddresses []string
 	for i := 0; i < nChangeOutputs; i++ {
@@ -106,7 +134,7 @@ func GetChangeDestinationsWithRandomAmounts(wallet Wallet, nChangeOutputs int, a
 		changeAddresses = append(changeAddresses, address)
 	}
 
-	changeAmount, err := txhelper.EstimateChange(nUtxoSelection, amountInAtom, sendDestinations, changeAddresses)
+	changeAmount, err := EstimateChange(nUtxoSelection, amountInAtom, sendDestinations, changeAddresses, feeRate)
 	if err != nil {
 		return nil, fmt.Errorf("error in getting change amount: %s", err.Error())
 	}
@@ -217,3 +245,32 @@ func TxDetails(tx *txhelper.Transaction, confirmations int32) *Transaction {
 		Status:        txhelper.TxStatus(confirmations),
 	}
 }
+
+// AddTransactionLabels sets the `Label` field of each of `txs` to the label saved for the tx in `labels`.
+func AddTransactionLabels(labels *txlabels.Store, txs ...*Transaction) {
+	for _, tx := range txs {
+		tx.Label = labels.Label(tx.Hash)
+	}
+}
+
+// SearchLabeledTransactions returns the transactions with labels in `labels` that contain `query`, most recent first.
+// The transactions are fetched with `getTransaction`, which should set their labels.
+// It is used by the wallet mediums to implement `Wallet.SearchTransactions`.
+func SearchLabeledTransactions(labels *txlabels.Store, query string,
+	getTransaction func(transactionHash string) (*Transaction, error)) ([]*Transaction, error) {
+
+	txHashes := labels.Search(query)
+	txs := make([]*Transaction, len(txHashes))
+	for i, txHash := range txHashes {
+		tx, err := getTransaction(txHash)
+		if err != nil {
+			return nil, fmt.Errorf("error reading labeled transaction %s: %s", txHash, err.Error())
+		}
+		txs[i] = tx
+	}
+
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].Timestamp > txs[j].Timestamp
+	})
+	return txs, nil
+}
@@ -0,0 +1,212 @@
+package walletcore
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/godcr/app/txfilter"
+)
+
+// Formats that transaction history can be exported to.
+const (
+	HistoryExportFormatCSV  = "csv"
+	HistoryExportFormatJSON = "json"
+	HistoryExportFormatOFX  = "ofx"
+)
+
+// HistoryExportFormats lists all supported history export formats.
+var HistoryExportFormats = []string{
+	HistoryExportFormatCSV,
+	HistoryExportFormatJSON,
+	HistoryExportFormatOFX,
+}
+
+// ExportedTransaction is a tx as written to history exports, with amounts in DCR.
+type ExportedTransaction struct {
+	Date          string  `json:"date"`
+	Hash          string  `json:"hash"`
+	Type          string  `json:"type"`
+	Direction     string  `json:"direction"`
+	Amount        float64 `json:"amount"`
+	Fee           float64 `json:"fee"`
+	NetAmount     float64 `json:"net_amount"`
+	Status        string  `json:"status"`
+	Confirmations int32   `json:"confirmations"`
+	BlockHeight   int32   `json:"block_height"`
+	Account       string  `json:"account"`
+	Label         string  `json:"label"`
+
+	timestamp int64
+}
+
+var historyExportCSVHeader = []string{"Date (UTC)", "Hash", "Type", "Direction", "Amount (DCR)", "Fee (DCR)",
+	"Net Amount (DCR)", "Status", "Confirmations", "Block Height", "Account", "Label"}
+
+// ExportTransactionHistory writes the wallet's txs that match the tx filter named `filterName` and `criteria`
+// to `writer` in `format`. Returns the number of txs exported.
+func ExportTransactionHistory(wallet Wallet, writer io.Writer, format, filterName string, criteria *txfilter.Criteria) (int, error) {
+	var writeTransactions func(io.Writer, []*ExportedTransaction, string, *txfilter.Criteria) error
+	switch format {
+	case HistoryExportFormatCSV:
+		writeTransactions = writeHistoryCSV
+	case HistoryExportFormatJSON:
+		writeTransactions = writeHistoryJSON
+	case HistoryExportFormatOFX:
+		writeTransactions = writeHistoryOFX
+	default:
+		return 0, fmt.Errorf("unsupported export format: %s", format)
+	}
+
+	txs, err := FilterTransactionHistory(wallet, filterName, criteria)
+	if err != nil {
+		return 0, err
+	}
+
+	transactions := make([]*ExportedTransaction, len(txs))
+	for i, tx := range txs {
+		transactions[i] = exportedTransaction(tx)
+	}
+
+	if err = writeTransactions(writer, transactions, wallet.NetType(), criteria); err != nil {
+		return 0, fmt.Errorf("error writing %s export: %s", format, err.Error())
+	}
+	return len(transactions), nil
+}
+
+func exportedTransaction(tx *Transaction) *ExportedTransaction {
+	return &ExportedTransaction{
+		Date:          time.Unix(tx.Timestamp, 0).UTC().Format("2006-01-02 15:04:05"),
+		Hash:          tx.Hash,
+		Type:          tx.Type,
+		Direction:     tx.Direction.String(),
+		Amount:        dcrutil.Amount(tx.Amount).ToCoin(),
+		Fee:           dcrutil.Amount(tx.Fee).ToCoin(),
+		NetAmount:     dcrutil.Amount(netTransactionAmount(tx)).ToCoin(),
+		Status:        tx.Status,
+		Confirmations: tx.Confirmations,
+		BlockHeight:   tx.BlockHeight,
+		Account:       tx.WalletAccountForTx(),
+		Label:         tx.Label,
+		timestamp:     tx.Timestamp,
+	}
+}
+
+// netTransactionAmount returns the change in wallet balance caused by `tx`, negative for txs that spend funds.
+func netTransactionAmount(tx *Transaction) int64 {
+	switch tx.Direction {
+	case txhelper.TransactionDirectionSent:
+		return -(tx.Amount + tx.Fee)
+	case txhelper.TransactionDirectionYourself:
+		return -tx.Fee
+	default:
+		return tx.Amount
+	}
+}
+
+func formatExportAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 8, 64)
+}
+
+func writeHistoryCSV(writer io.Writer, transactions []*ExportedTransaction, _ string, _ *txfilter.Criteria) error {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(historyExportCSVHeader); err != nil {
+		return err
+	}
+	for _, tx := range transactions {
+		err := csvWriter.Write([]string{
+			tx.Date,
+			tx.Hash,
+			tx.Type,
+			tx.Direction,
+			formatExportAmount(tx.Amount),
+			formatExportAmount(tx.Fee),
+			formatExportAmount(tx.NetAmount),
+			tx.Status,
+			strconv.Itoa(int(tx.Confirmations)),
+			strconv.Itoa(int(tx.BlockHeight)),
+			tx.Account,
+			tx.Label,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func writeHistoryJSON(writer io.Writer, transactions []*ExportedTransaction, _ string, _ *txfilter.Criteria) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(transactions)
+}
+
+// writeHistoryOFX writes the txs as an OFX 2 bank statement that accounting software can import.
+// The statement covers the date range of `criteria` or the time range of the txs if the dates are not set.
+func writeHistoryOFX(writer io.Writer, transactions []*ExportedTransaction, netType string, criteria *txfilter.Criteria) error {
+	const ofxTimeFormat = "20060102150405"
+
+	var fromTime, toTime time.Time
+	if criteria != nil {
+		fromTime, toTime = criteria.From, criteria.To
+	}
+	// transactions are sorted newest first
+	if fromTime.IsZero() && len(transactions) > 0 {
+		fromTime = time.Unix(transactions[len(transactions)-1].timestamp, 0)
+	}
+	if toTime.IsZero() {
+		toTime = time.Now()
+	}
+
+	var ofx strings.Builder
+	ofx.WriteString(xml.Header)
+	ofx.WriteString(`<?OFX OFXHEADER="200" VERSION="211" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n")
+	ofx.WriteString("<OFX>\n")
+	ofx.WriteString("<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>")
+	fmt.Fprintf(&ofx, "<DTSERVER>%s</DTSERVER><LANGUAGE>ENG</LANGUAGE></SONRS></SIGNONMSGSRSV1>\n", time.Now().UTC().Format(ofxTimeFormat))
+	ofx.WriteString("<BANKMSGSRSV1><STMTTRNRS><TRNUID>0</TRNUID><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n")
+	ofx.WriteString("<STMTRS><CURDEF>DCR</CURDEF>\n")
+	fmt.Fprintf(&ofx, "<BANKACCTFROM><BANKID>godcr</BANKID><ACCTID>%s</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>\n",
+		escapeXML(netType))
+	fmt.Fprintf(&ofx, "<BANKTRANLIST><DTSTART>%s</DTSTART><DTEND>%s</DTEND>\n",
+		fromTime.UTC().Format(ofxTimeFormat), toTime.UTC().Format(ofxTimeFormat))
+
+	for _, tx := range transactions {
+		trnType := "CREDIT"
+		if tx.NetAmount < 0 {
+			trnType = "DEBIT"
+		}
+		memo := tx.Label
+		if memo == "" {
+			memo = fmt.Sprintf("%s %s", tx.Direction, tx.Type)
+		}
+
+		ofx.WriteString("<STMTTRN>")
+		fmt.Fprintf(&ofx, "<TRNTYPE>%s</TRNTYPE>", trnType)
+		fmt.Fprintf(&ofx, "<DTPOSTED>%s</DTPOSTED>", time.Unix(tx.timestamp, 0).UTC().Format(ofxTimeFormat))
+		fmt.Fprintf(&ofx, "<TRNAMT>%s</TRNAMT>", formatExportAmount(tx.NetAmount))
+		fmt.Fprintf(&ofx, "<FITID>%s</FITID>", tx.Hash)
+		fmt.Fprintf(&ofx, "<NAME>%s</NAME>", escapeXML(tx.Type))
+		fmt.Fprintf(&ofx, "<MEMO>%s</MEMO>", escapeXML(memo))
+		ofx.WriteString("</STMTTRN>\n")
+	}
+
+	ofx.WriteString("</BANKTRANLIST>\n</STMTRS></STMTTRNRS></BANKMSGSRSV1>\n</OFX>\n")
+
+	_, err := io.WriteString(writer, ofx.String())
+	return err
+}
+
+func escapeXML(text string) string {
+	var escaped strings.Builder
+	xml.EscapeText(&escaped, []byte(text))
+	return escaped.String()
+}
@@ -0,0 +1,196 @@
+package walletcore
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/dcrlibwallet/txindex"
+	"github.com/raedahgroup/godcr/app/txfilter"
+)
+
+// historyWallet returns a fixed transaction history, only the wallet functions used to export history are implemented.
+type historyWallet struct {
+	Wallet
+	txs []*Transaction
+}
+
+func (wallet *historyWallet) NetType() string {
+	return "testnet3"
+}
+
+func (wallet *historyWallet) TransactionHistory(offset, count int32, _ *txindex.ReadFilter) ([]*Transaction, error) {
+	if int(offset) >= len(wallet.txs) {
+		return nil, nil
+	}
+	end := int(offset + count)
+	if end > len(wallet.txs) {
+		end = len(wallet.txs)
+	}
+	return wallet.txs[offset:end], nil
+}
+
+func testHistoryWallet() *historyWallet {
+	return &historyWallet{
+		txs: []*Transaction{
+			{
+				Transaction: &txhelper.Transaction{
+					Hash:        "a1b2",
+					Type:        "Regular",
+					Timestamp:   time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC).Unix(),
+					BlockHeight: 300,
+					Amount:      2.5e8,
+					Fee:         10000,
+					Direction:   txhelper.TransactionDirectionSent,
+					Inputs:      []*txhelper.TxInput{{Amount: 3e8, AccountName: "default", AccountNumber: 0}},
+					Outputs: []*txhelper.TxOutput{
+						{Amount: 2.5e8, Address: "TsExternal", AccountNumber: -1},
+						{Amount: 0.4999e8, Address: "TsChange", AccountName: "default", AccountNumber: 0},
+					},
+				},
+				Status:        "Confirmed",
+				Confirmations: 10,
+				Label:         "rent, March",
+			},
+			{
+				Transaction: &txhelper.Transaction{
+					Hash:        "c3d4",
+					Type:        "Regular",
+					Timestamp:   time.Date(2019, 2, 1, 8, 30, 0, 0, time.UTC).Unix(),
+					BlockHeight: 200,
+					Amount:      1e8,
+					Direction:   txhelper.TransactionDirectionReceived,
+					Outputs:     []*txhelper.TxOutput{{Amount: 1e8, Address: "TsSavings", AccountName: "savings", AccountNumber: 1}},
+				},
+				Status:        "Confirmed",
+				Confirmations: 110,
+				Label:         `Bob's "gift" <3 & more`,
+			},
+		},
+	}
+}
+
+func TestExportTransactionHistoryCSV(t *testing.T) {
+	var buffer bytes.Buffer
+	count, err := ExportTransactionHistory(testHistoryWallet(), &buffer, HistoryExportFormatCSV, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("exported %d txs, want 2", count)
+	}
+
+	rows, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatalf("export is not valid csv: %s", err.Error())
+	}
+	want := [][]string{
+		historyExportCSVHeader,
+		{"2019-03-01 12:00:00", "a1b2", "Regular", "Sent", "2.50000000", "0.00010000", "-2.50010000",
+			"Confirmed", "10", "300", "default", "rent, March"},
+		{"2019-02-01 08:30:00", "c3d4", "Regular", "Received", "1.00000000", "0.00000000", "1.00000000",
+			"Confirmed", "110", "200", "savings", `Bob's "gift" <3 & more`},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("csv rows are\n%q\nwant\n%q", rows, want)
+	}
+}
+
+func TestExportTransactionHistoryJSON(t *testing.T) {
+	var buffer bytes.Buffer
+	if _, err := ExportTransactionHistory(testHistoryWallet(), &buffer, HistoryExportFormatJSON, "", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var transactions []ExportedTransaction
+	if err := json.Unmarshal(buffer.Bytes(), &transactions); err != nil {
+		t.Fatalf("export is not valid json: %s", err.Error())
+	}
+	want := []ExportedTransaction{
+		{
+			Date: "2019-03-01 12:00:00", Hash: "a1b2", Type: "Regular", Direction: "Sent", Amount: 2.5, Fee: 0.0001,
+			NetAmount: -2.5001, Status: "Confirmed", Confirmations: 10, BlockHeight: 300, Account: "default", Label: "rent, March",
+		},
+		{
+			Date: "2019-02-01 08:30:00", Hash: "c3d4", Type: "Regular", Direction: "Received", Amount: 1, Fee: 0,
+			NetAmount: 1, Status: "Confirmed", Confirmations: 110, BlockHeight: 200, Account: "savings", Label: `Bob's "gift" <3 & more`,
+		},
+	}
+	if !reflect.DeepEqual(transactions, want) {
+		t.Errorf("json txs are\n%+v\nwant\n%+v", transactions, want)
+	}
+}
+
+func TestExportTransactionHistoryOFX(t *testing.T) {
+	criteria := &txfilter.Criteria{
+		From: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	var buffer bytes.Buffer
+	if _, err := ExportTransactionHistory(testHistoryWallet(), &buffer, HistoryExportFormatOFX, "", criteria); err != nil {
+		t.Fatal(err)
+	}
+
+	type statementTx struct {
+		Type   string `xml:"TRNTYPE"`
+		Posted string `xml:"DTPOSTED"`
+		Amount string `xml:"TRNAMT"`
+		ID     string `xml:"FITID"`
+		Name   string `xml:"NAME"`
+		Memo   string `xml:"MEMO"`
+	}
+	var ofx struct {
+		Currency string        `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS>CURDEF"`
+		Account  string        `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS>BANKACCTFROM>ACCTID"`
+		Start    string        `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS>BANKTRANLIST>DTSTART"`
+		End      string        `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS>BANKTRANLIST>DTEND"`
+		Txs      []statementTx `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS>BANKTRANLIST>STMTTRN"`
+	}
+	if err := xml.Unmarshal(buffer.Bytes(), &ofx); err != nil {
+		t.Fatalf("export is not valid xml: %s", err.Error())
+	}
+
+	if ofx.Currency != "DCR" || ofx.Account != "testnet3" {
+		t.Errorf("statement is for account %s in %s, want testnet3 in DCR", ofx.Account, ofx.Currency)
+	}
+	if ofx.Start != "20190101000000" || ofx.End != "20190401000000" {
+		t.Errorf("statement covers %s to %s, want the criteria date range", ofx.Start, ofx.End)
+	}
+	want := []statementTx{
+		{Type: "DEBIT", Posted: "20190301120000", Amount: "-2.50010000", ID: "a1b2", Name: "Regular", Memo: "rent, March"},
+		{Type: "CREDIT", Posted: "20190201083000", Amount: "1.00000000", ID: "c3d4", Name: "Regular", Memo: `Bob's "gift" <3 & more`},
+	}
+	if !reflect.DeepEqual(ofx.Txs, want) {
+		t.Errorf("statement txs are\n%+v\nwant\n%+v", ofx.Txs, want)
+	}
+}
+
+func TestExportTransactionHistoryCriteria(t *testing.T) {
+	criteria := &txfilter.Criteria{Account: "Savings"}
+
+	var buffer bytes.Buffer
+	count, err := ExportTransactionHistory(testHistoryWallet(), &buffer, HistoryExportFormatJSON, "", criteria)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var transactions []ExportedTransaction
+	if err = json.Unmarshal(buffer.Bytes(), &transactions); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 || len(transactions) != 1 || transactions[0].Hash != "c3d4" {
+		t.Errorf("exported %d txs %+v, want only the tx received in the savings account", count, transactions)
+	}
+
+	if _, err = ExportTransactionHistory(testHistoryWallet(), &buffer, "qif", "", nil); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+	if _, err = ExportTransactionHistory(testHistoryWallet(), &buffer, HistoryExportFormatCSV, "Unknown", nil); err == nil {
+		t.Error("expected an error for an unknown tx filter")
+	}
+}
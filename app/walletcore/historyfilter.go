@@ -0,0 +1,83 @@
+package walletcore
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/txfilter"
+)
+
+// historyFilterPageSize is the number of txs read from the wallet at a time when filtering history.
+const historyFilterPageSize int32 = 100
+
+// IsValidTransactionFilter returns true if `filterName` is one of TransactionFilters.
+func IsValidTransactionFilter(filterName string) bool {
+	for _, name := range TransactionFilters {
+		if name == filterName {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterTransactionHistory returns all txs that match the tx filter named `filterName` and `criteria`, newest first.
+// The tx index can only filter by tx direction and type, so txs are read a page at a time and checked against `criteria`.
+// An empty `filterName` matches txs of all directions and types.
+func FilterTransactionHistory(wallet Wallet, filterName string, criteria *txfilter.Criteria) ([]*Transaction, error) {
+	if filterName == "" {
+		filterName = TransactionFilterAll
+	}
+	if !IsValidTransactionFilter(filterName) {
+		return nil, fmt.Errorf("unknown transaction filter: %s", filterName)
+	}
+	if criteria != nil {
+		if err := criteria.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	filter := BuildTransactionFilter(filterName)
+
+	var matchingTxs []*Transaction
+	var offset int32
+	for {
+		txs, err := wallet.TransactionHistory(offset, historyFilterPageSize, filter)
+		if err != nil {
+			return nil, fmt.Errorf("error reading transaction history: %s", err.Error())
+		}
+
+		for _, tx := range txs {
+			if criteria.IsBeforeRange(tx.Timestamp) {
+				// txs are read newest first, so the remaining txs are also before the range
+				return matchingTxs, nil
+			}
+			if criteria.Match(TxFilterFields(tx)) {
+				matchingTxs = append(matchingTxs, tx)
+			}
+		}
+
+		if int32(len(txs)) < historyFilterPageSize {
+			return matchingTxs, nil
+		}
+		offset += int32(len(txs))
+	}
+}
+
+// TxFilterFields returns the fields of `tx` that txfilter criteria are checked against.
+func TxFilterFields(tx *Transaction) txfilter.Tx {
+	fields := txfilter.Tx{
+		Hash:      tx.Hash,
+		Timestamp: tx.Timestamp,
+		Amount:    tx.Amount,
+	}
+	for _, input := range tx.Inputs {
+		if input.AccountNumber != -1 {
+			fields.Accounts = append(fields.Accounts, input.AccountName)
+		}
+	}
+	for _, output := range tx.Outputs {
+		fields.Addresses = append(fields.Addresses, output.Address)
+		if output.AccountNumber != -1 {
+			fields.Accounts = append(fields.Accounts, output.AccountName)
+		}
+	}
+	return fields
+}
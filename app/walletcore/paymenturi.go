@@ -0,0 +1,25 @@
+package walletcore
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/paymenturi"
+)
+
+// ParsePaymentURI reads a payment request from a `decred:` uri and checks that its address is valid for the wallet's network.
+func ParsePaymentURI(wallet Wallet, uri string) (*paymenturi.PaymentRequest, error) {
+	request, err := paymenturi.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	isValid, err := wallet.ValidateAddress(request.Address)
+	if err != nil {
+		return nil, fmt.Errorf("error validating address: %s", err.Error())
+	}
+	if !isValid {
+		return nil, fmt.Errorf("the payment uri address is not valid for %s", wallet.NetType())
+	}
+
+	return request, nil
+}
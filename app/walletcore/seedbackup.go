@@ -0,0 +1,121 @@
+package walletcore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/chaincfg"
+	"github.com/decred/dcrd/hdkeychain"
+	"github.com/decred/dcrwallet/walletseed"
+	"github.com/raedahgroup/godcr/app/seedbackup"
+)
+
+// ErrSeedMismatch is returned when a seed entered to verify a seed backup is not the wallet's seed.
+var ErrSeedMismatch = errors.New("the seed entered is not the seed of this wallet")
+
+// VerifySeedBackup checks that `seed` is the seed of `wallet` and marks the seed backup verified in `store` if it is.
+// If the seed was recorded in `store` when the wallet was created, the seed is checked against that record.
+// Otherwise, the extended public key of the default account is derived from the seed and compared to that of the wallet,
+// or for wallets that cannot export account keys, the first addresses of the default account are derived from the seed
+// and checked to belong to the wallet. It is used by the wallet mediums to implement `Wallet.VerifySeedBackup`.
+func VerifySeedBackup(wallet Wallet, store *seedbackup.Store, netParams *chaincfg.Params, seed string) error {
+	if store.HasSeedHash() {
+		if !store.MatchesSeed(seed) {
+			return ErrSeedMismatch
+		}
+		return store.SetVerified()
+	}
+
+	seedBytes, err := walletseed.DecodeUserInput(seed)
+	if err != nil {
+		return err
+	}
+
+	masterKey, err := hdkeychain.NewMaster(seedBytes, netParams)
+	if err != nil {
+		return fmt.Errorf("error reading seed: %s", err.Error())
+	}
+	defer masterKey.Zero()
+
+	// wallets may have been created with either the SLIP0044 or the legacy coin type
+	coinTypes := []uint32{netParams.SLIP0044CoinType, netParams.LegacyCoinType}
+
+	walletXPub, err := wallet.AccountExtendedPubKey(0)
+	if err != nil {
+		// wallets that cannot export account keys are checked for the first addresses of the default account instead
+		for _, coinType := range coinTypes {
+			ownsAddresses, err := ownsDefaultAccountAddresses(wallet, masterKey, netParams, coinType)
+			if err != nil {
+				return err
+			}
+			if ownsAddresses {
+				return store.SetVerified()
+			}
+		}
+		return ErrSeedMismatch
+	}
+
+	for _, coinType := range coinTypes {
+		accountKey, err := defaultAccountKey(masterKey, coinType)
+		if err != nil {
+			return fmt.Errorf("error deriving account key from seed: %s", err.Error())
+		}
+		accountPubKey, err := accountKey.Neuter()
+		if err != nil {
+			return fmt.Errorf("error deriving account key from seed: %s", err.Error())
+		}
+		if accountPubKey.String() == walletXPub {
+			return store.SetVerified()
+		}
+	}
+
+	return ErrSeedMismatch
+}
+
+// ownsDefaultAccountAddresses derives the first external and internal addresses of account 0 from `masterKey`
+// and returns true if both belong to the default account of `wallet`.
+func ownsDefaultAccountAddresses(wallet Wallet, masterKey *hdkeychain.ExtendedKey, netParams *chaincfg.Params, coinType uint32) (bool, error) {
+	accountKey, err := defaultAccountKey(masterKey, coinType)
+	if err != nil {
+		return false, fmt.Errorf("error deriving account key from seed: %s", err.Error())
+	}
+
+	for _, branch := range []uint32{0, 1} {
+		branchKey, err := accountKey.Child(branch)
+		if err != nil {
+			return false, fmt.Errorf("error deriving address from seed: %s", err.Error())
+		}
+		addressKey, err := branchKey.Child(0)
+		if err != nil {
+			return false, fmt.Errorf("error deriving address from seed: %s", err.Error())
+		}
+		address, err := addressKey.Address(netParams)
+		if err != nil {
+			return false, fmt.Errorf("error deriving address from seed: %s", err.Error())
+		}
+
+		addressInfo, err := wallet.AddressInfo(address.EncodeAddress())
+		if err != nil {
+			return false, fmt.Errorf("error checking wallet address: %s", err.Error())
+		}
+		if !addressInfo.IsMine || addressInfo.AccountNumber != 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// defaultAccountKey derives the extended private key of account 0 from `masterKey`
+// following the BIP0044 path m/44'/<coinType>'/0'.
+func defaultAccountKey(masterKey *hdkeychain.ExtendedKey, coinType uint32) (*hdkeychain.ExtendedKey, error) {
+	purposeKey, err := masterKey.Child(44 + hdkeychain.HardenedKeyStart)
+	if err != nil {
+		return nil, err
+	}
+	coinTypeKey, err := purposeKey.Child(coinType + hdkeychain.HardenedKeyStart)
+	if err != nil {
+		return nil, err
+	}
+	return coinTypeKey.Child(hdkeychain.HardenedKeyStart)
+}
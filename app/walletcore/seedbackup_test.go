@@ -0,0 +1,74 @@
+package walletcore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/hdkeychain"
+	"github.com/decred/dcrwallet/walletseed"
+	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/seedbackup"
+)
+
+// addressWallet cannot export account keys and owns a fixed set of default account addresses.
+type addressWallet struct {
+	Wallet
+	addresses map[string]bool
+}
+
+func (wallet *addressWallet) AccountExtendedPubKey(uint32) (string, error) {
+	return "", errors.New("not supported")
+}
+
+func (wallet *addressWallet) AddressInfo(address string) (*dcrlibwallet.AddressInfo, error) {
+	return &dcrlibwallet.AddressInfo{Address: address, IsMine: wallet.addresses[address]}, nil
+}
+
+func TestVerifySeedBackupWithAddresses(t *testing.T) {
+	netParams := &chaincfg.TestNet3Params
+	seedBytes := chainhash.HashB([]byte("seed backup test"))
+	seed := walletseed.EncodeMnemonic(seedBytes)
+
+	masterKey, err := hdkeychain.NewMaster(seedBytes, netParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallet := &addressWallet{addresses: make(map[string]bool)}
+	accountKey, err := defaultAccountKey(masterKey, netParams.LegacyCoinType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, branch := range []uint32{0, 1} {
+		branchKey, err := accountKey.Child(branch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		addressKey, err := branchKey.Child(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		address, err := addressKey.Address(netParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wallet.addresses[address.EncodeAddress()] = true
+	}
+
+	otherSeed := walletseed.EncodeMnemonic(chainhash.HashB([]byte("another seed")))
+	store, _ := seedbackup.Load("")
+	if err = VerifySeedBackup(wallet, store, netParams, otherSeed); err != ErrSeedMismatch {
+		t.Errorf("verifying another seed returned %v, want ErrSeedMismatch", err)
+	}
+	if store.Verified() {
+		t.Error("seed backup is verified with another seed")
+	}
+
+	if err = VerifySeedBackup(wallet, store, netParams, seed); err != nil {
+		t.Fatal(err)
+	}
+	if !store.Verified() {
+		t.Error("seed backup is not verified with the wallet's seed")
+	}
+}
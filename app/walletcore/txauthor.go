@@ -0,0 +1,228 @@
+package walletcore
+
+import (
+	"fmt"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/txscript"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet/txrules"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+)
+
+// DefaultFeeRate is the fee rate in atoms/kB used for transactions if the user does not choose one.
+// It is the minimum fee rate at which transactions are relayed by the decred network.
+const DefaultFeeRate = int64(txrules.DefaultRelayFeePerKb)
+
+// FeeRateOption is a named fee rate in atoms/kB that users can choose for their transactions.
+type FeeRateOption struct {
+	Name    string
+	FeeRate int64
+}
+
+// FeeRateOptions are the fee rates offered to users, higher fee rates get transactions mined faster when the mempool is busy.
+var FeeRateOptions = []FeeRateOption{
+	{Name: "Default", FeeRate: DefaultFeeRate},
+	{Name: "Medium", FeeRate: 2 * DefaultFeeRate},
+	{Name: "High", FeeRate: 5 * DefaultFeeRate},
+}
+
+// ValidateFeeRate returns an error if transactions paying feeRate atoms/kB would not be relayed by the decred network.
+func ValidateFeeRate(feeRate int64) error {
+	if feeRate < DefaultFeeRate {
+		return fmt.Errorf("fee rate must be at least %d atoms/kB", DefaultFeeRate)
+	}
+	return nil
+}
+
+// EstimateFee returns the fee required by a signed transaction that spends `numberOfInputs` p2pkh outputs
+// to `outputs` and change outputs with a total script size of `totalChangeScriptSize` at `feeRate` atoms/kB.
+func EstimateFee(numberOfInputs int, outputs []*wire.TxOut, totalChangeScriptSize int, feeRate int64) int64 {
+	scriptSizes := make([]int, numberOfInputs)
+	for i := 0; i < numberOfInputs; i++ {
+		scriptSizes[i] = txhelper.RedeemP2PKHSigScriptSize
+	}
+
+	maxSignedSize := txhelper.EstimateSerializeSize(scriptSizes, outputs, totalChangeScriptSize)
+	return int64(txrules.FeeForSerializeSize(dcrutil.Amount(feeRate), maxSignedSize))
+}
+
+// EstimateChange is like txhelper.EstimateChange but pays the tx fee at `feeRate` atoms/kB.
+func EstimateChange(numberOfInputs int, totalInputAmount int64, destinations []txhelper.TransactionDestination,
+	changeAddresses []string, feeRate int64) (int64, error) {
+
+	for _, destination := range destinations {
+		if destination.SendMax {
+			return 0, fmt.Errorf("this tx will produce no change because one or more recipients are set to receive max amount")
+		}
+	}
+
+	outputs, totalSendAmount, _, err := txhelper.TxOutputsExtractMaxDestinationAddress(destinations)
+	if err != nil {
+		return 0, err
+	}
+
+	return estimateChangeWithOutputs(numberOfInputs, totalInputAmount, outputs, totalSendAmount, changeAddresses, feeRate)
+}
+
+// EstimateMaxSendAmount is like txhelper.EstimateMaxSendAmount but pays the tx fee at `feeRate` atoms/kB.
+func EstimateMaxSendAmount(numberOfInputs int, totalInputAmount int64, destinations []txhelper.TransactionDestination,
+	feeRate int64) (int64, error) {
+
+	outputs, totalSendAmount, maxAmountRecipientAddress, err := txhelper.TxOutputsExtractMaxDestinationAddress(destinations)
+	if err != nil {
+		return 0, err
+	}
+	if maxAmountRecipientAddress == "" {
+		return 0, fmt.Errorf("specify the destination address to send max amount to")
+	}
+
+	// use max recipient address as change address to get max amount
+	return estimateChangeWithOutputs(numberOfInputs, totalInputAmount, outputs, totalSendAmount,
+		[]string{maxAmountRecipientAddress}, feeRate)
+}
+
+// TxInputs returns tx inputs that spend `utxos`, for use with NewUnsignedTx.
+func TxInputs(utxos []*UnspentOutput) ([]*wire.TxIn, error) {
+	inputs := make([]*wire.TxIn, len(utxos))
+	for i, utxo := range utxos {
+		txHash, err := chainhash.NewHashFromStr(utxo.TransactionHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid utxo transaction hash: %s", err.Error())
+		}
+		outpoint := wire.NewOutPoint(txHash, utxo.OutputIndex, int8(utxo.Tree))
+		inputs[i] = wire.NewTxIn(outpoint, int64(utxo.Amount), nil)
+	}
+	return inputs, nil
+}
+
+// NewUnsignedTx is like txhelper.NewUnsignedTx but pays the tx fee at `feeRate` atoms/kB.
+// It uses the inputs to prepare a tx with outputs for the provided send destinations and change destinations.
+// If any of the send destinations is set to receive max amount, that destination address is used as single change destination.
+// If no change destinations are provided and no recipient is set to receive max amount,
+// a single change destination is created for an address gotten by calling `generateAccountAddress()`.
+func NewUnsignedTx(inputs []*wire.TxIn, sendDestinations, changeDestinations []txhelper.TransactionDestination,
+	generateAccountAddress txhelper.GenerateAddressFunc, feeRate int64) (*wire.MsgTx, error) {
+
+	var totalInputAmount int64
+	for _, txIn := range inputs {
+		totalInputAmount += txIn.ValueIn
+	}
+
+	outputs, totalSendAmount, maxAmountRecipientAddress, err := txhelper.TxOutputsExtractMaxDestinationAddress(sendDestinations)
+	if err != nil {
+		return nil, err
+	}
+
+	if totalSendAmount > totalInputAmount {
+		return nil, fmt.Errorf("total send amount (%s) is higher than the total input amount (%s)",
+			dcrutil.Amount(totalSendAmount).String(), dcrutil.Amount(totalInputAmount).String())
+	}
+
+	// the whole change amount goes to the max amount recipient if there is one,
+	// or to a new address of the account if no change destination is specified
+	var changeAddresses []string
+	if maxAmountRecipientAddress != "" {
+		changeAddresses = []string{maxAmountRecipientAddress}
+	} else if len(changeDestinations) == 0 {
+		changeAddress, err := generateAccountAddress()
+		if err != nil {
+			return nil, fmt.Errorf("error generating change address for tx: %s", err.Error())
+		}
+		changeAddresses = []string{changeAddress}
+	} else {
+		for _, changeDestination := range changeDestinations {
+			changeAddresses = append(changeAddresses, changeDestination.Address)
+		}
+	}
+
+	changeAmount, err := estimateChangeWithOutputs(len(inputs), totalInputAmount, outputs, totalSendAmount, changeAddresses, feeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	totalChangeScriptSize, err := changeScriptSize(changeAddresses)
+	if err != nil {
+		return nil, fmt.Errorf("error processing change outputs: %s", err.Error())
+	}
+
+	// dust change is left to the tx fee
+	if changeAmount != 0 && !txrules.IsDustAmount(dcrutil.Amount(changeAmount), totalChangeScriptSize, dcrutil.Amount(feeRate)) {
+		if maxAmountRecipientAddress != "" || len(changeDestinations) == 0 {
+			changeDestinations = []txhelper.TransactionDestination{{
+				Address: changeAddresses[0],
+				Amount:  dcrutil.Amount(changeAmount).ToCoin(),
+			}}
+		}
+
+		var totalChangeAmount int64
+		for _, changeDestination := range changeDestinations {
+			changeOutput, err := txhelper.MakeTxOutput(changeDestination)
+			if err != nil {
+				return nil, fmt.Errorf("error creating change outputs for tx: %s", err.Error())
+			}
+			outputs = append(outputs, changeOutput)
+			totalChangeAmount += changeOutput.Value
+		}
+
+		if totalChangeAmount > changeAmount {
+			return nil, fmt.Errorf("total amount allocated to change addresses (%s) is higher than actual change amount for transaction (%s)",
+				dcrutil.Amount(totalChangeAmount).String(), dcrutil.Amount(changeAmount).String())
+		}
+	}
+
+	return &wire.MsgTx{
+		SerType:  wire.TxSerializeFull,
+		Version:  wire.TxVersion,
+		TxIn:     inputs,
+		TxOut:    outputs,
+		LockTime: 0,
+		Expiry:   0,
+	}, nil
+}
+
+// estimateChangeWithOutputs returns the amount left for change outputs paying to `changeAddresses` after sending
+// `totalSendAmount` with `outputs` and paying the tx fee at `feeRate` atoms/kB, or an error if nothing is left.
+func estimateChangeWithOutputs(numberOfInputs int, totalInputAmount int64, outputs []*wire.TxOut, totalSendAmount int64,
+	changeAddresses []string, feeRate int64) (int64, error) {
+
+	if totalSendAmount >= totalInputAmount {
+		return 0, fmt.Errorf("total send amount (%s) is higher than or equal to the total input amount (%s)",
+			dcrutil.Amount(totalSendAmount).String(), dcrutil.Amount(totalInputAmount).String())
+	}
+
+	totalChangeScriptSize, err := changeScriptSize(changeAddresses)
+	if err != nil {
+		return 0, err
+	}
+
+	maxRequiredFee := EstimateFee(numberOfInputs, outputs, totalChangeScriptSize, feeRate)
+	changeAmount := totalInputAmount - totalSendAmount - maxRequiredFee
+	if changeAmount < 0 {
+		return 0, fmt.Errorf("total send amount plus tx fee is higher than the total input amount by %s",
+			dcrutil.Amount(-changeAmount).String())
+	}
+
+	// if change amount is valid, check if the script size exceeds maximum script size
+	if changeAmount > 0 && !txrules.IsDustAmount(dcrutil.Amount(changeAmount), totalChangeScriptSize, dcrutil.Amount(feeRate)) {
+		maxChangeScriptSize := len(changeAddresses) * txscript.MaxScriptElementSize
+		if totalChangeScriptSize > maxChangeScriptSize {
+			return 0, fmt.Errorf("script size exceed maximum bytes pushable to the stack")
+		}
+	}
+
+	return changeAmount, nil
+}
+
+func changeScriptSize(changeAddresses []string) (int, error) {
+	var totalChangeScriptSize int
+	for _, changeAddress := range changeAddresses {
+		changeSource, err := txhelper.MakeTxChangeSource(changeAddress)
+		if err != nil {
+			return 0, err
+		}
+		totalChangeScriptSize += changeSource.ScriptSize()
+	}
+	return totalChangeScriptSize, nil
+}
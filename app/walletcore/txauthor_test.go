@@ -0,0 +1,132 @@
+package walletcore
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/hdkeychain"
+	"github.com/decred/dcrd/wire"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+)
+
+func testAddress(t *testing.T, index uint32) string {
+	masterKey, err := hdkeychain.NewMaster(chainhash.HashB([]byte("txauthor test")), &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := masterKey.Child(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, err := key.Address(&chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return address.EncodeAddress()
+}
+
+func testInputs(amounts ...int64) []*wire.TxIn {
+	inputs := make([]*wire.TxIn, len(amounts))
+	for i, amount := range amounts {
+		inputs[i] = wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, uint32(i), 0), amount, nil)
+	}
+	return inputs
+}
+
+func txFee(inputs []*wire.TxIn, tx *wire.MsgTx) int64 {
+	fee := int64(0)
+	for _, input := range inputs {
+		fee += input.ValueIn
+	}
+	for _, output := range tx.TxOut {
+		fee -= output.Value
+	}
+	return fee
+}
+
+func TestNewUnsignedTxPaysFeeRate(t *testing.T) {
+	recipient := testAddress(t, 1)
+	changeAddress := testAddress(t, 2)
+	generateChangeAddress := func() (string, error) {
+		return changeAddress, nil
+	}
+
+	tests := []struct {
+		name         string
+		destinations []txhelper.TransactionDestination
+		inputs       []int64
+		feeRate      int64
+		wantOutputs  int
+	}{
+		{
+			name:         "change output at default fee rate",
+			destinations: []txhelper.TransactionDestination{{Address: recipient, Amount: 1}},
+			inputs:       []int64{2e8},
+			feeRate:      DefaultFeeRate,
+			wantOutputs:  2,
+		},
+		{
+			name:         "change output at higher fee rate",
+			destinations: []txhelper.TransactionDestination{{Address: recipient, Amount: 1}},
+			inputs:       []int64{1e8, 1e8},
+			feeRate:      5 * DefaultFeeRate,
+			wantOutputs:  2,
+		},
+		{
+			name:         "send max",
+			destinations: []txhelper.TransactionDestination{{Address: recipient, SendMax: true}},
+			inputs:       []int64{1e8, 1e8},
+			feeRate:      2 * DefaultFeeRate,
+			wantOutputs:  1,
+		},
+	}
+
+	for _, test := range tests {
+		inputs := testInputs(test.inputs...)
+		tx, err := NewUnsignedTx(inputs, test.destinations, nil, generateChangeAddress, test.feeRate)
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err.Error())
+			continue
+		}
+		if len(tx.TxOut) != test.wantOutputs {
+			t.Errorf("%s: tx has %d outputs, want %d", test.name, len(tx.TxOut), test.wantOutputs)
+		}
+
+		// the change or max amount output is included in the fee estimate
+		wantFee := EstimateFee(len(inputs), tx.TxOut, 0, test.feeRate)
+		if fee := txFee(inputs, tx); fee < wantFee || fee > wantFee+1 {
+			t.Errorf("%s: tx pays %d atoms fee, want %d", test.name, fee, wantFee)
+		}
+	}
+}
+
+func TestNewUnsignedTxLeavesDustChangeToFee(t *testing.T) {
+	recipient := testAddress(t, 1)
+	generateChangeAddress := func() (string, error) {
+		return testAddress(t, 2), nil
+	}
+
+	inputs := testInputs(1e8)
+	sendAmount := 1e8 - EstimateFee(1, []*wire.TxOut{wire.NewTxOut(0, make([]byte, 25))}, 25, DefaultFeeRate) - 100
+	destinations := []txhelper.TransactionDestination{{Address: recipient, Amount: dcrutil.Amount(sendAmount).ToCoin()}}
+
+	tx, err := NewUnsignedTx(inputs, destinations, nil, generateChangeAddress, DefaultFeeRate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.TxOut) != 1 {
+		t.Fatalf("tx has %d outputs, want only the send output", len(tx.TxOut))
+	}
+}
+
+func TestNewUnsignedTxInsufficientFunds(t *testing.T) {
+	destinations := []txhelper.TransactionDestination{{Address: testAddress(t, 1), Amount: 1}}
+	_, err := NewUnsignedTx(testInputs(1e8), destinations, nil, func() (string, error) {
+		return testAddress(t, 2), nil
+	}, DefaultFeeRate)
+	if err == nil {
+		t.Fatal("expected an error when the inputs cannot pay the send amount and fee")
+	}
+}
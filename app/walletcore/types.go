@@ -46,6 +46,7 @@ type UnspentOutput struct {
 	Amount          dcrutil.Amount `json:"amount"`
 	Address         string         `json:"address"`
 	Confirmations   int32          `json:"confirmations"`
+	Locked          bool           `json:"locked"`
 }
 
 // StakeInfo holds ticket information summary related to the wallet.
@@ -66,12 +67,99 @@ type StakeInfo struct {
 	TotalSubsidy  string `json:"totalSubsidy"`
 }
 
+// Ticket holds information about a ticket purchased by the wallet
+// and the vote or revocation that spent it, if any.
+type Ticket struct {
+	Hash string `json:"hash"`
+	// PurchaseHeight is the height of the block that mined the ticket purchase, -1 if it is not yet mined.
+	PurchaseHeight int32          `json:"purchaseHeight"`
+	Price          dcrutil.Amount `json:"price"`
+	Status         string         `json:"status"`
+	// SpenderHash is the hash of the vote or revocation that spent the ticket, empty if the ticket is unspent.
+	SpenderHash string `json:"spenderHash"`
+	// Reward is the amount gained from voting with the ticket, or lost to fees for a revocation.
+	Reward dcrutil.Amount `json:"reward"`
+}
+
+// TicketFilter specifies the tickets that should be returned by `Wallet.Tickets`.
+type TicketFilter struct {
+	// Statuses limits the returned tickets to those with any of the specified statuses.
+	// All tickets are returned if no status is specified.
+	Statuses []string
+}
+
+// Matches checks if a ticket with the provided status should be returned for this filter.
+func (filter *TicketFilter) Matches(status string) bool {
+	if filter == nil || len(filter.Statuses) == 0 {
+		return true
+	}
+	for _, filterStatus := range filter.Statuses {
+		if strings.EqualFold(filterStatus, status) {
+			return true
+		}
+	}
+	return false
+}
+
 // ConnectionInfo holds connection information for the wallet
 type ConnectionInfo struct {
-	NetworkType    string `json:"networkType"`
-	PeersConnected int32  `json:"peersConnected"`
-	TotalBalance   string `json:"totalBalance"`
-	LatestBlock    uint32 `json:"latestBlock"`
+	NetworkType     string          `json:"networkType"`
+	PeersConnected  int32           `json:"peersConnected"`
+	TotalBalance    string          `json:"totalBalance"`
+	LatestBlock     uint32          `json:"latestBlock"`
+	ConnectionState ConnectionState `json:"connectionState"`
+	SyncMode        SyncMode        `json:"syncMode"`
+	// SyncSource describes where the wallet gets blocks from, e.g. the spv peers or the dcrd node it syncs with.
+	SyncSource string `json:"syncSource"`
+}
+
+// ConnectionState describes the connection between godcr and the process that runs the wallet.
+type ConnectionState string
+
+const (
+	// ConnectionStateConnected is the state of wallets opened in-process and of healthy dcrwallet rpc connections.
+	ConnectionStateConnected ConnectionState = "connected"
+
+	// ConnectionStateReconnecting is the state of a dcrwallet rpc connection that was lost and is being re-established.
+	// Wallet operations fail until the state changes back to ConnectionStateConnected.
+	ConnectionStateReconnecting ConnectionState = "reconnecting"
+)
+
+// SyncMode describes how the wallet syncs with the Decred network.
+type SyncMode string
+
+const (
+	// SyncModeSPV is used by wallets that sync with peers on the network, either discovered automatically or set with spvconnect.
+	SyncModeSPV SyncMode = "spv"
+
+	// SyncModeRPC is used by wallets that sync with a trusted dcrd node over RPC.
+	// Connected peers are not reported in this mode.
+	SyncModeRPC SyncMode = "rpc"
+
+	// SyncModeDcrwallet is used by wallets run by dcrwallet, which syncs as configured in dcrwallet.
+	SyncModeDcrwallet SyncMode = "dcrwallet"
+)
+
+// RescanProgress reports how far a blockchain rescan has gone, see `WalletMiddleware.RescanBlockChain`.
+type RescanProgress struct {
+	CurrentHeight int32 `json:"currentHeight"`
+	TotalHeight   int32 `json:"totalHeight"`
+	Done          bool  `json:"done"`
+}
+
+// Percentage returns the portion of blocks rescanned, from 0 to 100.
+func (progress RescanProgress) Percentage() int32 {
+	if progress.Done {
+		return 100
+	}
+	if progress.TotalHeight <= 0 {
+		return 0
+	}
+	percentage := progress.CurrentHeight * 100 / progress.TotalHeight
+	if percentage > 100 {
+		return 100
+	}
+	return percentage
 }
 
 type Transaction struct {
@@ -82,6 +170,8 @@ type Transaction struct {
 	Confirmations int32  `json:"confirmations"`
 	ShortTime     string `json:"short_time"`
 	LongTime      string `json:"long_time"`
+	// Label is the free-text label that the user attached to this tx, it is only stored locally.
+	Label string `json:"label"`
 }
 
 func (tx *Transaction) WalletAccountForTx() string {
@@ -28,12 +28,25 @@ type Wallet interface {
 	// AccountNumber returns the name for an account  with the provided account number
 	AccountName(accountNumber uint32) (string, error)
 
+	// RenameAccount changes the name of the account with the provided account number
+	RenameAccount(accountNumber uint32, newName string) error
+
+	// AccountExtendedPubKey returns the extended public key of the account with the provided account number.
+	// The key can be used to create a watching-only wallet that tracks the account.
+	AccountExtendedPubKey(accountNumber uint32) (string, error)
+
 	// AddressInfo checks if an address belongs to the wallet to retrieve it's account name
 	AddressInfo(address string) (*dcrlibwallet.AddressInfo, error)
 
 	// ValidateAddress checks if an address is valid or not
 	ValidateAddress(address string) (bool, error)
 
+	// SignMessage signs a message with the private key of the specified wallet address and returns the base64-encoded signature
+	SignMessage(address, message, passphrase string) (string, error)
+
+	// VerifyMessage checks if a base64-encoded signature was created by signing message with the private key of address
+	VerifyMessage(address, message, signature string) (bool, error)
+
 	// ReceiveAddress checks if there's a previously generated address that hasn't been used to receive funds and returns it
 	// If no unused address exists, it generates a new address to receive funds into specified account
 	ReceiveAddress(account uint32) (string, error)
@@ -44,19 +57,47 @@ type Wallet interface {
 
 	// UnspentOutputs lists all unspent outputs in the specified account that sum up to `targetAmount`
 	// If `targetAmount` is 0, all unspent outputs in account are returned
+	// Locked outputs are included in the list with `Locked` set to true
 	UnspentOutputs(account uint32, targetAmount int64, requiredConfirmations int32) ([]*UnspentOutput, error)
 
+	// LockUnspent locks or unlocks the unspent outputs identified by `outputKeys`.
+	// Locked outputs are never automatically selected as inputs for transactions.
+	// The set of locked outputs is saved in the app data directory so it is kept across restarts.
+	LockUnspent(outputKeys []string, lock bool) error
+
+	// LockedOutputs returns the keys of all locked unspent outputs
+	LockedOutputs() ([]string, error)
+
 	// SendFromAccount sends funds to 1 or more destination addresses, each with a specified amount.
-	// The inputs to the transaction are automatically selected from any combination of unspent outputs in the account.
+	// The inputs to the transaction are automatically selected from the unlocked unspent outputs in the account
+	// using the `coinSelection` strategy, use `DefaultCoinSelection` if the user did not choose a strategy.
+	// The transaction fee is paid at `feeRate` atoms/kB, use `DefaultFeeRate` if the user did not choose a fee rate.
 	// Returns the transaction hash as string if successful.
-	SendFromAccount(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination, passphrase string) (string, error)
+	SendFromAccount(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination, feeRate int64,
+		coinSelection string, passphrase string) (string, error)
 
 	// SendFromUTXOs sends funds to 1 or more destination addresses, each with a specified amount.
 	// The inputs to the transaction are unspent outputs in the account, matching the keys sent in []utxoKeys.
 	// Also supports specifying how and where to send any change amount that arises from the transaction.
 	// If no change destinations are provided, one is automatically created using an address generated from the account.
+	// The transaction fee is paid at `feeRate` atoms/kB.
 	// Returns the transaction hash as string if successful
-	SendFromUTXOs(sourceAccount uint32, requiredConfirmations int32, utxoKeys []string, txDestinations []txhelper.TransactionDestination, changeDestinations []txhelper.TransactionDestination, passphrase string) (string, error)
+	SendFromUTXOs(sourceAccount uint32, requiredConfirmations int32, utxoKeys []string, txDestinations []txhelper.TransactionDestination, changeDestinations []txhelper.TransactionDestination, feeRate int64, passphrase string) (string, error)
+
+	// ConstructTransaction creates an unsigned transaction that sends funds to 1 or more destination addresses,
+	// each with a specified amount. The inputs to the transaction are automatically selected from the unlocked outputs in the account
+	// using the `coinSelection` strategy. The transaction fee is paid at `feeRate` atoms/kB.
+	// Returns the serialized unsigned transaction which can be signed using `SignRawTransaction`.
+	ConstructTransaction(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination, feeRate int64,
+		coinSelection string) ([]byte, error)
+
+	// SignRawTransaction signs the inputs of a serialized transaction using the private keys in the wallet.
+	// Returns the serialized signed transaction which can be broadcast using `PublishRawTransaction`.
+	SignRawTransaction(serializedTx []byte, passphrase string) ([]byte, error)
+
+	// PublishRawTransaction broadcasts a serialized signed transaction to the decred network.
+	// Returns the transaction hash as string if successful.
+	PublishRawTransaction(serializedTx []byte) (string, error)
 
 	// TransactionCount returns the number of transactions in the tx index database.
 	// If `filter` is set to `nil`, all transactions are counted.
@@ -68,7 +109,7 @@ type Wallet interface {
 	TransactionCount(filter *txindex.ReadFilter) (int, error)
 
 	// TransactionHistory fetches the specified count of transactions from a tx index database,
-	// beginning at the specified offset.
+	// beginning at the specified offset. The `Label` field of each transaction is set.
 	// If `filter` is set to `nil`, all transactions are returned.
 	// Otherwise, only transactions matching the provided filter are returned.
 	// A `filter` can be created using `txIndex.Filter()`.
@@ -77,22 +118,47 @@ type Wallet interface {
 	// Can combine both filters using `filter.WithTxTypes(...tx types to return).ForDirections(...tx directions to return)`.
 	TransactionHistory(offset, count int32, filter *txindex.ReadFilter) ([]*Transaction, error)
 
-	// GetTransaction returns information about the transaction with the given hash.
+	// GetTransaction returns information about the transaction with the given hash, including its label.
 	// An error is returned if the no transaction with the given hash is found.
 	GetTransaction(transactionHash string) (*Transaction, error)
 
+	// SetTransactionLabel attaches a free-text label to the transaction with the given hash, replacing any previous label.
+	// An empty label removes the transaction's label. Labels are saved locally next to the tx index database.
+	SetTransactionLabel(transactionHash, label string) error
+
+	// SearchTransactions returns the transactions with labels that contain `query`, ignoring case.
+	// The transactions are sorted with the most recent first.
+	SearchTransactions(query string) ([]*Transaction, error)
+
 	// StakeInfo returns information about wallet stakes, tickets and their statuses.
 	StakeInfo(ctx context.Context) (*StakeInfo, error)
 
+	// Tickets returns the tickets purchased by the wallet with their current statuses.
+	// If `filter` is nil, all tickets are returned.
+	// Otherwise, only tickets with the statuses specified in the filter are returned.
+	Tickets(ctx context.Context, filter *TicketFilter) ([]*Ticket, error)
+
 	// PurchaseTicket is used to purchase tickets.
 	PurchaseTicket(ctx context.Context, request dcrlibwallet.PurchaseTicketsRequest) (ticketHashes []string, err error)
 
+	// RevokeTickets creates and publishes revocations for all missed and expired tickets in the wallet,
+	// returning the locked funds to the wallet.
+	RevokeTickets(ctx context.Context, passphrase string) error
+
 	// TicketPrice returns the current ticket price
 	TicketPrice(ctx context.Context) (ticketPrice int64, err error)
 
 	// ChangePrivatePassphrase changes the private passphrase from the oldPass to the provided newPass
 	ChangePrivatePassphrase(ctx context.Context, oldPass, newPass string) error
 
+	// SeedBackupVerified returns true if the user has confirmed having a backup of the wallet's seed.
+	// Watching-only wallets have no seed and are always considered verified.
+	SeedBackupVerified() bool
+
+	// VerifySeedBackup checks that `seed` is the wallet's seed and records the seed backup as verified if it is.
+	// An error is returned if the seed is not the wallet's seed.
+	VerifySeedBackup(seed string) error
+
 	// NetType returns the network type of this wallet
 	NetType() string
 }
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -55,6 +57,14 @@ func DecredWalletDbDirectories() (directories []WalletDbDir) {
 	return
 }
 
+// WalletDataDir returns the directory in `appDataDir` where godcr keeps its own data about a wallet, such as locked outputs.
+// `walletKey` identifies the wallet and must be the same every time the wallet is opened. The directory is in
+// `walletsDir`, named after the wallet's network and a short hash of `walletKey`.
+func WalletDataDir(appDataDir, walletsDir, network, walletKey string) string {
+	walletKeyHash := sha256.Sum256([]byte(walletKey))
+	return filepath.Join(appDataDir, walletsDir, network, hex.EncodeToString(walletKeyHash[:8]))
+}
+
 // decreditionAppDirectory returns the appdata dir used by decredition on different operating systems
 // following the pattern in the decredition source code
 // see https://github.com/decred/decrediton/blob/master/app/main_dev/paths.js#L10-L18
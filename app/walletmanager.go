@@ -0,0 +1,183 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/taxreport"
+)
+
+// DefaultWalletName is the name given to the wallet in use when no named wallets are configured.
+const DefaultWalletName = "default"
+
+// WalletManager holds the wallets opened by an interface, each identified by a unique name,
+// and keeps track of the wallet currently in use.
+type WalletManager struct {
+	mu      sync.RWMutex
+	names   []string
+	wallets map[string]WalletMiddleware
+	current string
+
+	// rateRecording is set once RecordTransactionRates is called, wallets added after that are also recorded.
+	rateRecording *rateRecording
+	// unsubscribes stops the event subscriptions of the managed wallets.
+	unsubscribes []func()
+}
+
+type rateRecording struct {
+	ctx      context.Context
+	recorder *taxreport.Recorder
+	onError  func(walletName string, err error)
+}
+
+// NewWalletManager returns an empty WalletManager, use Add to add opened wallets.
+func NewWalletManager() *WalletManager {
+	return &WalletManager{
+		wallets: make(map[string]WalletMiddleware),
+	}
+}
+
+// Add adds an opened wallet to the manager under `name`.
+// The first wallet added becomes the current wallet.
+func (manager *WalletManager) Add(name string, wallet WalletMiddleware) error {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	if _, exists := manager.find(name); exists {
+		return fmt.Errorf("a wallet named %s has already been opened", name)
+	}
+
+	manager.names = append(manager.names, name)
+	manager.wallets[name] = wallet
+	if manager.current == "" {
+		manager.current = name
+	}
+	if manager.rateRecording != nil {
+		manager.recordRates(name, wallet)
+	}
+	return nil
+}
+
+// RecordTransactionRates uses `recorder` to save the exchange rate of each new transaction of the managed wallets,
+// including wallets added later, so that the tax report has the fiat value of each transaction when it was made.
+// The rates of recent transactions received while godcr was not running are recorded first.
+// Recording stops when `ctx` is cancelled or the wallets are closed. `onError` is called if a rate cannot be recorded.
+func (manager *WalletManager) RecordTransactionRates(ctx context.Context, recorder *taxreport.Recorder,
+	onError func(walletName string, err error)) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	if manager.rateRecording != nil {
+		return
+	}
+
+	manager.rateRecording = &rateRecording{
+		ctx:      ctx,
+		recorder: recorder,
+		onError:  onError,
+	}
+	for _, name := range manager.names {
+		manager.recordRates(name, manager.wallets[name])
+	}
+}
+
+// recordRates records the rates of the recent transactions of `wallet` and subscribes to its new transactions.
+// The caller must hold manager.mu.
+func (manager *WalletManager) recordRates(name string, wallet WalletMiddleware) {
+	recording := manager.rateRecording
+	record := func() {
+		if recording.ctx.Err() != nil {
+			return
+		}
+		if _, err := recording.recorder.Record(recording.ctx, wallet); err != nil && recording.ctx.Err() == nil {
+			recording.onError(name, err)
+		}
+	}
+
+	go record()
+
+	// each subscription delivers events on its own goroutine, so fetching the rate does not delay other subscribers
+	unsubscribe := wallet.Events().Subscribe(func(_ events.Event) {
+		record()
+	}, events.TxReceived, events.TxConfirmed)
+	manager.unsubscribes = append(manager.unsubscribes, unsubscribe)
+}
+
+// Names returns the names of the managed wallets in the order they were added.
+func (manager *WalletManager) Names() []string {
+	manager.mu.RLock()
+	defer manager.mu.RUnlock()
+
+	names := make([]string, len(manager.names))
+	copy(names, manager.names)
+	return names
+}
+
+// Wallet returns the wallet named `name`, names are matched ignoring case.
+func (manager *WalletManager) Wallet(name string) (WalletMiddleware, error) {
+	manager.mu.RLock()
+	defer manager.mu.RUnlock()
+
+	name, exists := manager.find(name)
+	if !exists {
+		return nil, fmt.Errorf("no wallet named %s, available wallets: %s", name, strings.Join(manager.names, ", "))
+	}
+	return manager.wallets[name], nil
+}
+
+// Current returns the wallet currently in use, nil if no wallet has been added.
+func (manager *WalletManager) Current() WalletMiddleware {
+	manager.mu.RLock()
+	defer manager.mu.RUnlock()
+	return manager.wallets[manager.current]
+}
+
+// CurrentName returns the name of the wallet currently in use.
+func (manager *WalletManager) CurrentName() string {
+	manager.mu.RLock()
+	defer manager.mu.RUnlock()
+	return manager.current
+}
+
+// Switch makes the wallet named `name` the current wallet and returns it.
+func (manager *WalletManager) Switch(name string) (WalletMiddleware, error) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	name, exists := manager.find(name)
+	if !exists {
+		return nil, fmt.Errorf("no wallet named %s, available wallets: %s", name, strings.Join(manager.names, ", "))
+	}
+
+	manager.current = name
+	return manager.wallets[name], nil
+}
+
+// CloseWallets closes all managed wallets, it is meant to be added to the shutdown operations of an interface.
+func (manager *WalletManager) CloseWallets() {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	for _, unsubscribe := range manager.unsubscribes {
+		unsubscribe()
+	}
+	manager.unsubscribes = nil
+
+	for _, name := range manager.names {
+		manager.wallets[name].CloseWallet()
+	}
+}
+
+// find returns the name of the managed wallet matching `name` ignoring case, or `name` if there's no such wallet.
+// The caller must hold manager.mu.
+func (manager *WalletManager) find(name string) (string, bool) {
+	for _, walletName := range manager.names {
+		if strings.EqualFold(walletName, name) {
+			return walletName, true
+		}
+	}
+	return name, false
+}
@@ -3,24 +3,43 @@ package dcrlibwallet
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/decred/dcrwallet/netparams"
 	"github.com/raedahgroup/dcrlibwallet"
 	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/lockedoutputs"
+	"github.com/raedahgroup/godcr/app/seedbackup"
+	"github.com/raedahgroup/godcr/app/txlabels"
+	"github.com/raedahgroup/godcr/app/watchingonly"
 )
 
 // DcrWalletLib implements `WalletMiddleware` using `dcrlibwallet.LibWallet` as medium for connecting to a decred wallet
 // Functions relating to operations that can be performed on a wallet are defined in `walletfunctions.go`
 // Other wallet-related functions are defined in `walletloader.go`
 type DcrWalletLib struct {
-	WalletDbDir string
-	walletLib   *dcrlibwallet.LibWallet
-	activeNet   *netparams.Params
+	WalletDbDir   string
+	walletLib     *dcrlibwallet.LibWallet
+	activeNet     *netparams.Params
+	watchingOnly  bool
+	lockedOutputs *lockedoutputs.Store
+	txLabels      *txlabels.Store
+	seedBackup    *seedbackup.Store
+	events        *events.Bus
+	syncOptions   SyncOptions
+
+	rescanMu   sync.Mutex
+	rescanning bool
 }
 
-// Connect opens connection to the wallet database via dcrlibwallet and returns an instance of DcrWalletLib
-func Connect(ctx context.Context, walletDbDir, networkType string) (*DcrWalletLib, error) {
+// Connect opens connection to the wallet database via dcrlibwallet and returns an instance of DcrWalletLib.
+// `appDataDir` is godcr's data directory, where data about the wallet that dcrlibwallet does not keep is saved.
+// `syncOptions` set how the wallet syncs with the network when `SyncBlockChain` is called.
+func Connect(ctx context.Context, appDataDir, walletDbDir, networkType string, syncOptions SyncOptions) (*DcrWalletLib, error) {
 	activeNet := utils.NetParams(networkType)
 	if activeNet == nil {
 		return nil, fmt.Errorf("unsupported wallet: %s", networkType)
@@ -37,13 +56,54 @@ func Connect(ctx context.Context, walletDbDir, networkType string) (*DcrWalletLi
 		return nil, err
 	}
 
+	walletDataDir, err := walletDataDir(appDataDir, walletDbDir, activeNet)
+	if err != nil {
+		return nil, err
+	}
+
+	// dcrlibwallet does not persist locked outputs across restarts, so godcr keeps them in its data dir
+	lockedOutputs, err := lockedoutputs.Load(filepath.Join(walletDataDir, lockedoutputs.FileName))
+	if err != nil {
+		return nil, err
+	}
+
+	txLabels, err := txlabels.Load(filepath.Join(walletDbDir, txlabels.FileName))
+	if err != nil {
+		return nil, err
+	}
+
+	seedBackup, err := seedbackup.Load(filepath.Join(walletDbDir, seedbackup.FileName))
+	if err != nil {
+		return nil, err
+	}
+
+	walletEvents := events.NewBus()
+	lw.RegisterTxNotificationListener(&txNotificationPublisher{events: walletEvents})
+
 	return &DcrWalletLib{
-		WalletDbDir: walletDbDir,
-		walletLib:   lw,
-		activeNet:   activeNet,
+		WalletDbDir:   walletDbDir,
+		walletLib:     lw,
+		activeNet:     activeNet,
+		watchingOnly:  lw.WalletOpened() && watchingonly.Load(filepath.Join(walletDbDir, watchingonly.FileName)),
+		lockedOutputs: lockedOutputs,
+		txLabels:      txLabels,
+		seedBackup:    seedBackup,
+		events:        walletEvents,
+		syncOptions:   syncOptions,
 	}, nil
 }
 
+// walletDataDir returns the directory in `appDataDir` where godcr keeps its own data about the wallet in `walletDbDir`.
+// The directory is named after the network of the wallet and a hash of the absolute path to the wallet database directory.
+func walletDataDir(appDataDir, walletDbDir string, activeNet *netparams.Params) (string, error) {
+	absWalletDbDir, err := filepath.Abs(walletDbDir)
+	if err != nil {
+		return "", fmt.Errorf("error reading wallet directory path: %s", err.Error())
+	}
+
+	return app.WalletDataDir(appDataDir, "wallets", activeNet.Name, absWalletDbDir), nil
+}
+
 // This method may stall if the wallet database is in use by some other process,
 // hence the need for ctx, so user can cancel the operation if it's taking too long
 // additionally, let's notify the user if we sense a delay in opening the wallet
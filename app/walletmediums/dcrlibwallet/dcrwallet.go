@@ -0,0 +1,38 @@
+package dcrlibwallet
+
+import (
+	"errors"
+	"reflect"
+	"time"
+	"unsafe"
+
+	"github.com/decred/dcrwallet/wallet"
+)
+
+// loadedWallet returns the dcrwallet wallet opened by dcrlibwallet, it is used for features that dcrlibwallet does not implement.
+// The dcrlibwallet version used by godcr keeps the wallet in an unexported field without a getter, so the field is read with reflection.
+// The field's type is checked first, if a different dcrlibwallet version changes the field an error is returned instead.
+func (lib *DcrWalletLib) loadedWallet() (*wallet.Wallet, error) {
+	walletField := reflect.ValueOf(lib.walletLib).Elem().FieldByName("wallet")
+	if !walletField.IsValid() || walletField.Type() != reflect.TypeOf((*wallet.Wallet)(nil)) {
+		return nil, errors.New("the wallet opened by dcrlibwallet cannot be accessed")
+	}
+
+	loadedWallet := *(**wallet.Wallet)(unsafe.Pointer(walletField.UnsafeAddr()))
+	if loadedWallet == nil {
+		return nil, errors.New("wallet is not open")
+	}
+	return loadedWallet, nil
+}
+
+// unlockWallet unlocks `loadedWallet` with the private passphrase until the returned `lock` function is called.
+func unlockWallet(loadedWallet *wallet.Wallet, passphrase string) (lock func(), err error) {
+	lockWallet := make(chan time.Time, 1)
+	if err = loadedWallet.Unlock([]byte(passphrase), lockWallet); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		lockWallet <- time.Time{}
+	}, nil
+}
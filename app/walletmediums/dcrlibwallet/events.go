@@ -0,0 +1,72 @@
+package dcrlibwallet
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/godcr/app/events"
+)
+
+// txNotificationPublisher receives the tx notifications of dcrlibwallet and publishes them on the wallet's events bus.
+// dcrlibwallet keeps a single tx notification listener, it is registered when the wallet is connected.
+type txNotificationPublisher struct {
+	events *events.Bus
+}
+
+func (publisher *txNotificationPublisher) OnTransaction(transaction string) {
+	var tx txhelper.Transaction
+	if err := json.Unmarshal([]byte(transaction), &tx); err != nil {
+		// todo use logger, similar logging should be done across dcrwalletrpc and dcrlibwallet functions
+		fmt.Printf("error reading tx notification: %s\n", err.Error())
+		return
+	}
+
+	publisher.events.Publish(events.Event{
+		Type: events.TxReceived,
+		Data: events.Tx{
+			Hash:        tx.Hash,
+			Amount:      tx.Amount,
+			Fee:         tx.Fee,
+			Direction:   int32(tx.Direction),
+			BlockHeight: tx.BlockHeight,
+		},
+	})
+	publisher.events.Publish(events.Event{Type: events.BalanceChanged})
+}
+
+// OnTransactionConfirmed publishes only the hash and block height of the confirmed transaction
+// because dcrlibwallet does not pass the rest of the transaction to tx notification listeners.
+func (publisher *txNotificationPublisher) OnTransactionConfirmed(hash string, height int32) {
+	publisher.events.Publish(events.Event{
+		Type: events.TxConfirmed,
+		Data: events.Tx{Hash: hash, BlockHeight: height},
+	})
+	publisher.events.Publish(events.Event{Type: events.BalanceChanged})
+}
+
+// OnBlockAttached converts the block timestamp from the nanoseconds reported by dcrlibwallet to seconds.
+func (publisher *txNotificationPublisher) OnBlockAttached(height int32, timestamp int64) {
+	publisher.events.Publish(events.Event{
+		Type: events.BlockAttached,
+		Data: events.Block{Height: height, Timestamp: timestamp / 1e9},
+	})
+}
+
+func (lib *DcrWalletLib) Events() *events.Bus {
+	return lib.events
+}
+
+func syncProgressEvent(progressReport *defaultsynclistener.ProgressReport) events.Event {
+	report := progressReport.Read()
+	return events.Event{
+		Type: events.SyncProgress,
+		Data: events.SyncProgressData{
+			Percentage:     report.TotalSyncProgress,
+			ConnectedPeers: report.ConnectedPeers,
+			Done:           report.Done,
+			Error:          report.Error,
+		},
+	}
+}
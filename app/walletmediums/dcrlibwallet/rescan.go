@@ -0,0 +1,69 @@
+package dcrlibwallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrwallet/wallet"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// RescanBlockChain rescans the blockchain from the genesis block with the wallet opened by dcrlibwallet.
+// `LibWallet.RescanBlocks` is not used because it cannot be stopped and does not report rescan errors,
+// here the rescan is stopped when `ctx` is canceled and an error is returned if the rescan fails.
+func (lib *DcrWalletLib) RescanBlockChain(ctx context.Context, rescanProgressUpdated func(walletcore.RescanProgress)) error {
+	loadedWallet, err := lib.loadedWallet()
+	if err != nil {
+		return err
+	}
+
+	netBackend, err := loadedWallet.NetworkBackend()
+	if err != nil {
+		return errors.New("cannot rescan blockchain: wallet is not connected to the decred network")
+	}
+
+	lib.rescanMu.Lock()
+	if lib.rescanning {
+		lib.rescanMu.Unlock()
+		return errors.New("a blockchain rescan is already in progress")
+	}
+	lib.rescanning = true
+	lib.rescanMu.Unlock()
+
+	defer func() {
+		lib.rescanMu.Lock()
+		lib.rescanning = false
+		lib.rescanMu.Unlock()
+	}()
+
+	_, bestBlockHeight := loadedWallet.MainChainTip()
+	progress := walletcore.RescanProgress{TotalHeight: bestBlockHeight}
+	rescanProgressUpdated(progress)
+
+	// the progress channel is closed by dcrwallet when the rescan ends, an error is the last update sent before that.
+	// Updates are read until then so that dcrwallet is not blocked sending progress after `ctx` is canceled.
+	rescanProgress := make(chan wallet.RescanProgress, 1)
+	go loadedWallet.RescanProgressFromHeight(ctx, netBackend, 0, rescanProgress)
+
+	for update := range rescanProgress {
+		if update.Err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if update.Err != nil {
+			return fmt.Errorf("rescan failed: %s", update.Err.Error())
+		}
+
+		progress.CurrentHeight = update.ScannedThrough
+		rescanProgressUpdated(progress)
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	progress.CurrentHeight = progress.TotalHeight
+	progress.Done = true
+	rescanProgressUpdated(progress)
+	return nil
+}
@@ -0,0 +1,83 @@
+package dcrlibwallet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// SyncOptions sets how the wallet syncs with the network.
+// The zero value syncs in spv mode with automatically discovered peers.
+type SyncOptions struct {
+	Mode walletcore.SyncMode
+
+	// SPVPeers are the only peers connected to in spv mode, if set.
+	SPVPeers []string
+
+	// DcrdRPCServer is the address of the dcrd node connected to in rpc mode.
+	// The certificate is not read if NoDcrdRPCTLS is set.
+	DcrdRPCServer string
+	DcrdRPCUser   string
+	DcrdRPCPass   string
+	DcrdRPCCert   string
+	NoDcrdRPCTLS  bool
+}
+
+// SyncOptionsFromConfig returns the sync options set in the config file.
+func SyncOptionsFromConfig(cfg config.ConfFileOptions) SyncOptions {
+	return SyncOptions{
+		Mode:          walletcore.SyncMode(cfg.SyncMode),
+		SPVPeers:      cfg.SPVConnect,
+		DcrdRPCServer: cfg.DcrdRPCServer,
+		DcrdRPCUser:   cfg.DcrdRPCUser,
+		DcrdRPCPass:   cfg.DcrdRPCPass,
+		DcrdRPCCert:   cfg.DcrdRPCCert,
+		NoDcrdRPCTLS:  cfg.NoDcrdRPCTLS,
+	}
+}
+
+func (options SyncOptions) syncMode() walletcore.SyncMode {
+	if options.Mode == walletcore.SyncModeRPC {
+		return walletcore.SyncModeRPC
+	}
+	return walletcore.SyncModeSPV
+}
+
+// source describes the peers or dcrd node that the wallet syncs with, for display in `WalletConnectionInfo`.
+func (options SyncOptions) source() string {
+	if options.syncMode() == walletcore.SyncModeRPC {
+		return fmt.Sprintf("dcrd at %s", options.DcrdRPCServer)
+	}
+	if len(options.SPVPeers) > 0 {
+		return fmt.Sprintf("peers %s", strings.Join(options.SPVPeers, ", "))
+	}
+	return "automatically discovered peers"
+}
+
+// startSync starts syncing `walletLib` in the mode set by these options.
+// Errors that occur after the sync has started are reported to the wallet's sync progress listeners.
+func (options SyncOptions) startSync(walletLib *dcrlibwallet.LibWallet) error {
+	if options.syncMode() == walletcore.SyncModeSPV {
+		// dcrlibwallet takes the peer addresses as a single ";"-separated string
+		return walletLib.SpvSync(strings.Join(options.SPVPeers, ";"))
+	}
+
+	if options.DcrdRPCServer == "" {
+		return fmt.Errorf("dcrdrpcserver must be set in %s to sync in rpc mode", config.AppConfigFilePath)
+	}
+
+	var cert []byte
+	if !options.NoDcrdRPCTLS {
+		var err error
+		cert, err = ioutil.ReadFile(options.DcrdRPCCert)
+		if err != nil {
+			return fmt.Errorf("error reading dcrd certificate file: %s", err.Error())
+		}
+	}
+
+	return walletLib.RpcSync(options.DcrdRPCServer, options.DcrdRPCUser, options.DcrdRPCPass, cert)
+}
@@ -1,13 +1,19 @@
 package dcrlibwallet
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/txscript"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/chain"
+	"github.com/decred/dcrwallet/wallet"
 	"github.com/raedahgroup/dcrlibwallet"
 	"github.com/raedahgroup/dcrlibwallet/addresshelper"
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
@@ -78,6 +84,25 @@ func (lib *DcrWalletLib) AccountName(accountNumber uint32) (string, error) {
 	return lib.walletLib.AccountName(accountNumber), nil
 }
 
+func (lib *DcrWalletLib) RenameAccount(accountNumber uint32, newName string) error {
+	return lib.walletLib.RenameAccount(int32(accountNumber), newName)
+}
+
+// AccountExtendedPubKey returns the extended public key of the account as dcrwallet derives it from the wallet's account key.
+func (lib *DcrWalletLib) AccountExtendedPubKey(accountNumber uint32) (string, error) {
+	loadedWallet, err := lib.loadedWallet()
+	if err != nil {
+		return "", err
+	}
+
+	extendedPubKey, err := loadedWallet.MasterPubKey(accountNumber)
+	if err != nil {
+		return "", fmt.Errorf("error reading account extended public key: %s", err.Error())
+	}
+
+	return extendedPubKey.String(), nil
+}
+
 func (lib *DcrWalletLib) AddressInfo(address string) (*dcrlibwallet.AddressInfo, error) {
 	return lib.walletLib.AddressInfo(address)
 }
@@ -86,6 +111,21 @@ func (lib *DcrWalletLib) ValidateAddress(address string) (bool, error) {
 	return lib.walletLib.IsAddressValid(address), nil
 }
 
+func (lib *DcrWalletLib) SignMessage(address, message, passphrase string) (string, error) {
+	if lib.watchingOnly {
+		return "", walletcore.ErrWatchingOnlyWallet
+	}
+	signature, err := lib.walletLib.SignMessage([]byte(passphrase), address, message)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+func (lib *DcrWalletLib) VerifyMessage(address, message, signature string) (bool, error) {
+	return lib.walletLib.VerifyMessage(address, message, signature)
+}
+
 func (lib *DcrWalletLib) ReceiveAddress(account uint32) (string, error) {
 	return lib.walletLib.CurrentAddress(int32(account))
 }
@@ -131,11 +171,93 @@ func (lib *DcrWalletLib) UnspentOutputs(account uint32, targetAmount int64, requ
 		}
 	}
 
+	lib.lockedOutputs.MarkLocked(unspentOutputs)
 	return unspentOutputs, nil
 }
 
-func (lib *DcrWalletLib) SendFromAccount(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination, passphrase string) (string, error) {
-	txHash, err := lib.walletLib.BulkSendTransaction([]byte(passphrase), destinations, int32(sourceAccount), requiredConfirmations)
+func (lib *DcrWalletLib) LockUnspent(outputKeys []string, lock bool) error {
+	return lib.lockedOutputs.Lock(outputKeys, lock)
+}
+
+func (lib *DcrWalletLib) LockedOutputs() ([]string, error) {
+	return lib.lockedOutputs.OutputKeys(), nil
+}
+
+func (lib *DcrWalletLib) SendFromAccount(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination,
+	feeRate int64, coinSelection string, passphrase string) (string, error) {
+
+	if lib.watchingOnly {
+		return "", walletcore.ErrWatchingOnlyWallet
+	}
+
+	// dcrlibwallet's BulkSendTransaction always pays the default relay fee, construct the tx here to use the provided fee rate
+	unsignedTx, err := lib.ConstructTransaction(sourceAccount, requiredConfirmations, destinations, feeRate, coinSelection)
+	if err != nil {
+		return "", err
+	}
+
+	return lib.signAndPublishTransaction(unsignedTx, passphrase)
+}
+
+func (lib *DcrWalletLib) SendFromUTXOs(sourceAccount uint32, requiredConfirmations int32, utxoKeys []string,
+	txDestinations []txhelper.TransactionDestination, changeDestinations []txhelper.TransactionDestination, feeRate int64, passphrase string) (string, error) {
+
+	if lib.watchingOnly {
+		return "", walletcore.ErrWatchingOnlyWallet
+	}
+
+	// fetch all utxos in account to extract details for the utxos selected by user
+	// use targetAmount = 0 to fetch ALL utxos in account
+	utxos, err := lib.walletLib.UnspentOutputs(sourceAccount, requiredConfirmations, 0)
+	if err != nil {
+		return "", err
+	}
+
+	inputs := make([]*wire.TxIn, 0, len(utxoKeys))
+	for _, utxo := range utxos {
+		useUtxo := false
+		for _, key := range utxoKeys {
+			if utxo.OutputKey == key {
+				useUtxo = true
+			}
+		}
+		if !useUtxo {
+			continue
+		}
+
+		txHash, err := chainhash.NewHash(utxo.TransactionHash)
+		if err != nil {
+			return "", fmt.Errorf("invalid utxo transaction hash: %s", err.Error())
+		}
+		outpoint := wire.NewOutPoint(txHash, utxo.OutputIndex, int8(utxo.Tree))
+		inputs = append(inputs, wire.NewTxIn(outpoint, utxo.Amount, nil))
+
+		if len(inputs) == len(utxoKeys) {
+			break
+		}
+	}
+	if len(inputs) != len(utxoKeys) {
+		return "", fmt.Errorf("%d of the selected unspent outputs were not found in account, are already spent or do not have %d confirmations",
+			len(utxoKeys)-len(inputs), requiredConfirmations)
+	}
+
+	unsignedTx, err := walletcore.NewUnsignedTx(inputs, txDestinations, changeDestinations, func() (address string, err error) {
+		return lib.walletLib.NextAddress(int32(sourceAccount))
+	}, feeRate)
+	if err != nil {
+		return "", err
+	}
+
+	serializedTx, err := unsignedTx.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return lib.signAndPublishTransaction(serializedTx, passphrase)
+}
+
+func (lib *DcrWalletLib) signAndPublishTransaction(serializedTx []byte, passphrase string) (string, error) {
+	txHash, err := lib.walletLib.SignAndPublishTransaction(serializedTx, []byte(passphrase))
 	if err != nil {
 		return "", err
 	}
@@ -148,11 +270,97 @@ func (lib *DcrWalletLib) SendFromAccount(sourceAccount uint32, requiredConfirmat
 	return transactionHash.String(), nil
 }
 
-func (lib *DcrWalletLib) SendFromUTXOs(sourceAccount uint32, requiredConfirmations int32, utxoKeys []string,
-	txDestinations []txhelper.TransactionDestination, changeDestinations []txhelper.TransactionDestination, passphrase string) (string, error) {
+// ConstructTransaction selects the inputs for the tx from the unlocked unspent outputs in the account
+// using the `coinSelection` strategy. If a destination is set to receive max amount, all unlocked unspent outputs are used.
+func (lib *DcrWalletLib) ConstructTransaction(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination,
+	feeRate int64, coinSelection string) ([]byte, error) {
+	utxos, err := lib.UnspentOutputs(sourceAccount, 0, requiredConfirmations)
+	if err != nil {
+		return nil, err
+	}
+
+	selectedUtxos, _, err := walletcore.SelectCoins(coinSelection, utxos, destinations, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	if len(selectedUtxos) == 0 {
+		return nil, errors.New("no spendable outputs in account")
+	}
+
+	inputs, err := walletcore.TxInputs(selectedUtxos)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTx, err := walletcore.NewUnsignedTx(inputs, destinations, nil, func() (address string, err error) {
+		return lib.walletLib.NextAddress(int32(sourceAccount))
+	}, feeRate)
+	if err != nil {
+		return nil, err
+	}
 
-	return lib.walletLib.SendFromCustomInputs(sourceAccount, requiredConfirmations, utxoKeys, txDestinations,
-		changeDestinations, []byte(passphrase))
+	return unsignedTx.Bytes()
+}
+
+func (lib *DcrWalletLib) SignRawTransaction(serializedTx []byte, passphrase string) ([]byte, error) {
+	if lib.watchingOnly {
+		return nil, walletcore.ErrWatchingOnlyWallet
+	}
+
+	loadedWallet, err := lib.loadedWallet()
+	if err != nil {
+		return nil, err
+	}
+
+	var tx wire.MsgTx
+	if err = tx.Deserialize(bytes.NewReader(serializedTx)); err != nil {
+		return nil, fmt.Errorf("error decoding transaction: %s", err.Error())
+	}
+
+	lock, err := unlockWallet(loadedWallet, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("error signing transaction: %s", err.Error())
+	}
+	defer lock()
+
+	signatureErrors, err := loadedWallet.SignTransaction(&tx, txscript.SigHashAll, nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error signing transaction: %s", err.Error())
+	}
+
+	if len(signatureErrors) > 0 {
+		unsignedInputIndexes := make([]uint32, len(signatureErrors))
+		for i, signatureError := range signatureErrors {
+			unsignedInputIndexes[i] = signatureError.InputIndex
+		}
+		return nil, fmt.Errorf("error signing transaction: wallet could not sign inputs %v", unsignedInputIndexes)
+	}
+
+	return tx.Bytes()
+}
+
+func (lib *DcrWalletLib) PublishRawTransaction(serializedTx []byte) (string, error) {
+	loadedWallet, err := lib.loadedWallet()
+	if err != nil {
+		return "", err
+	}
+
+	netBackend, err := loadedWallet.NetworkBackend()
+	if err != nil {
+		return "", errors.New("error publishing transaction: wallet is not connected to the decred network")
+	}
+
+	var tx wire.MsgTx
+	if err = tx.Deserialize(bytes.NewReader(serializedTx)); err != nil {
+		return "", fmt.Errorf("error decoding transaction: %s", err.Error())
+	}
+
+	transactionHash, err := loadedWallet.PublishTransaction(&tx, serializedTx, netBackend)
+	if err != nil {
+		return "", fmt.Errorf("error publishing transaction: %s", err.Error())
+	}
+
+	return transactionHash.String(), nil
 }
 
 func (lib *DcrWalletLib) TransactionCount(filter *txindex.ReadFilter) (int, error) {
@@ -170,6 +378,7 @@ func (lib *DcrWalletLib) TransactionHistory(offset, count int32, filter *txindex
 		confirmations := txhelper.TxConfirmations(tx.BlockHeight, lib.walletLib.GetBestBlock())
 		processedTxs[i] = walletcore.TxDetails(tx, confirmations)
 	}
+	walletcore.AddTransactionLabels(lib.txLabels, processedTxs...)
 	return processedTxs, nil
 }
 
@@ -185,7 +394,21 @@ func (lib *DcrWalletLib) GetTransaction(transactionHash string) (*walletcore.Tra
 	}
 
 	confirmations := txhelper.TxConfirmations(tx.BlockHeight, lib.walletLib.GetBestBlock())
-	return walletcore.TxDetails(tx, confirmations), nil
+	txDetails := walletcore.TxDetails(tx, confirmations)
+	walletcore.AddTransactionLabels(lib.txLabels, txDetails)
+	return txDetails, nil
+}
+
+func (lib *DcrWalletLib) SetTransactionLabel(transactionHash, label string) error {
+	tx, err := lib.GetTransaction(transactionHash)
+	if err != nil {
+		return err
+	}
+	return lib.txLabels.SetLabel(tx.Hash, label)
+}
+
+func (lib *DcrWalletLib) SearchTransactions(query string) ([]*walletcore.Transaction, error) {
+	return walletcore.SearchLabeledTransactions(lib.txLabels, query, lib.GetTransaction)
 }
 
 func (lib *DcrWalletLib) StakeInfo(ctx context.Context) (*walletcore.StakeInfo, error) {
@@ -211,6 +434,103 @@ func (lib *DcrWalletLib) StakeInfo(ctx context.Context) (*walletcore.StakeInfo,
 	return stakeInfo, nil
 }
 
+func (lib *DcrWalletLib) Tickets(ctx context.Context, filter *walletcore.TicketFilter) ([]*walletcore.Ticket, error) {
+	// dcrlibwallet fails to handle unmined tickets (which are not associated with any block),
+	// so limit the search to tickets mined up to the current best block.
+	bestBlock := lib.walletLib.GetBestBlock()
+	if bestBlock <= 0 {
+		return nil, nil
+	}
+	req := &dcrlibwallet.GetTicketsRequest{
+		EndingBlockHeight: bestBlock,
+	}
+
+	ticketsChan, errChan, err := lib.walletLib.GetTickets(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tickets: %s", err.Error())
+	}
+
+	var tickets []*walletcore.Ticket
+	for {
+		select {
+		case ticketInfo := <-ticketsChan:
+			status := ticketInfo.TicketStatus.String()
+			if !filter.Matches(status) {
+				continue
+			}
+			tickets = append(tickets, ticketFromSummary(ticketInfo.Ticket, int32(ticketInfo.BlockHeight), status))
+
+		case err = <-errChan:
+			if err != nil {
+				return nil, fmt.Errorf("error getting tickets: %s", err.Error())
+			}
+			return tickets, nil
+		}
+	}
+}
+
+func ticketFromSummary(summary *wallet.TicketSummary, purchaseHeight int32, status string) *walletcore.Ticket {
+	ticket := &walletcore.Ticket{
+		Hash:           summary.Ticket.Hash.String(),
+		PurchaseHeight: purchaseHeight,
+		Status:         status,
+	}
+
+	// the first output of a ticket purchase is the stake submission, its amount is the ticket price
+	for _, output := range summary.Ticket.MyOutputs {
+		if output.Index == 0 {
+			ticket.Price = output.Amount
+		}
+	}
+
+	if summary.Spender != nil {
+		ticket.SpenderHash = summary.Spender.Hash.String()
+		for _, output := range summary.Spender.MyOutputs {
+			ticket.Reward += output.Amount
+		}
+		for _, input := range summary.Spender.MyInputs {
+			ticket.Reward -= input.PreviousAmount
+		}
+	}
+
+	return ticket
+}
+
+// RevokeTickets revokes missed and expired tickets if the wallet is synced with a dcrd rpc connection.
+// Missed tickets are only known to dcrd, when the wallet is synced over spv only expired tickets are revoked.
+func (lib *DcrWalletLib) RevokeTickets(ctx context.Context, passphrase string) error {
+	if lib.watchingOnly {
+		return walletcore.ErrWatchingOnlyWallet
+	}
+
+	loadedWallet, err := lib.loadedWallet()
+	if err != nil {
+		return err
+	}
+
+	netBackend, err := loadedWallet.NetworkBackend()
+	if err != nil {
+		return errors.New("error revoking tickets: wallet is not connected to the decred network")
+	}
+
+	lock, err := unlockWallet(loadedWallet, passphrase)
+	if err != nil {
+		return fmt.Errorf("error revoking tickets: %s", err.Error())
+	}
+	defer lock()
+
+	if chainClient, err := chain.RPCClientFromBackend(netBackend); err == nil {
+		err = loadedWallet.RevokeTickets(chainClient)
+	} else {
+		err = loadedWallet.RevokeExpiredTickets(ctx, netBackend)
+	}
+	if err != nil {
+		return fmt.Errorf("error revoking tickets: %s", err.Error())
+	}
+
+	return nil
+}
+
 func (lib *DcrWalletLib) TicketPrice(ctx context.Context) (int64, error) {
 	ticketPrice, err := lib.walletLib.TicketPrice(ctx)
 	if err != nil {
@@ -221,6 +541,9 @@ func (lib *DcrWalletLib) TicketPrice(ctx context.Context) (int64, error) {
 }
 
 func (lib *DcrWalletLib) PurchaseTicket(ctx context.Context, request dcrlibwallet.PurchaseTicketsRequest) ([]string, error) {
+	if lib.watchingOnly {
+		return nil, walletcore.ErrWatchingOnlyWallet
+	}
 	balance, err := lib.AccountBalance(request.Account, int32(request.RequiredConfirmations))
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch account balance: %s", err.Error())
@@ -245,12 +568,26 @@ func (lib *DcrWalletLib) PurchaseTicket(ctx context.Context, request dcrlibwalle
 }
 
 func (lib *DcrWalletLib) ChangePrivatePassphrase(_ context.Context, oldPass, newPass string) error {
+	if lib.watchingOnly {
+		return walletcore.ErrWatchingOnlyWallet
+	}
 	if oldPass == "" || newPass == "" {
 		return errors.New("Passphrase cannot be empty")
 	}
 	return lib.walletLib.ChangePrivatePassphrase([]byte(oldPass), []byte(newPass))
 }
 
+func (lib *DcrWalletLib) SeedBackupVerified() bool {
+	return lib.watchingOnly || lib.seedBackup.Verified()
+}
+
+func (lib *DcrWalletLib) VerifySeedBackup(seed string) error {
+	if lib.watchingOnly {
+		return walletcore.ErrWatchingOnlyWallet
+	}
+	return walletcore.VerifySeedBackup(lib, lib.seedBackup, lib.activeNet.Params, seed)
+}
+
 func (lib *DcrWalletLib) NetType() string {
 	return lib.activeNet.Params.Name
 }
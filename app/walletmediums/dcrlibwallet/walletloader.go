@@ -3,12 +3,16 @@ package dcrlibwallet
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrwallet/wallet"
+	"github.com/decred/dcrwallet/wallet/txrules"
 	"github.com/raedahgroup/dcrlibwallet"
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
 	"github.com/raedahgroup/dcrlibwallet/utils"
 	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/app/watchingonly"
 )
 
 var numberOfPeers int32
@@ -22,20 +26,58 @@ func (lib *DcrWalletLib) WalletExists() (bool, error) {
 }
 
 func (lib *DcrWalletLib) CreateWallet(passphrase, seed string) error {
-	return lib.walletLib.CreateWallet(passphrase, seed)
+	err := lib.walletLib.CreateWallet(passphrase, seed)
+	if err != nil {
+		return err
+	}
+	return lib.seedBackup.RecordSeed(seed)
+}
+
+// CreateWatchingOnlyWallet uses a separate wallet loader to create the wallet database
+// because `dcrlibwallet.LibWallet` does not expose its loader's `CreateWatchingOnlyWallet` method.
+// The loader is unloaded afterwards so that the new wallet can be opened by `dcrlibwallet.LibWallet`.
+func (lib *DcrWalletLib) CreateWatchingOnlyWallet(extendedPublicKey string) error {
+	stakeOptions := &dcrlibwallet.StakeOptions{TicketFee: txrules.DefaultRelayFeePerKb.ToCoin()}
+	walletLoader := dcrlibwallet.NewLoader(lib.activeNet.Params, lib.WalletDbDir, stakeOptions, 20, false,
+		txrules.DefaultRelayFeePerKb.ToCoin(), wallet.DefaultAccountGapLimit)
+
+	_, err := walletLoader.CreateWatchingOnlyWallet(extendedPublicKey, []byte(wallet.InsecurePubPassphrase))
+	if err != nil {
+		return err
+	}
+
+	err = walletLoader.UnloadWallet()
+	if err != nil {
+		return fmt.Errorf("error closing new wallet database: %s", err.Error())
+	}
+
+	err = lib.walletLib.OpenWallet([]byte(wallet.InsecurePubPassphrase))
+	if err != nil {
+		return fmt.Errorf("error opening new wallet: %s", err.Error())
+	}
+
+	// dcrlibwallet does not report whether the wallet it opens is watching-only, so this is recorded for the next Connect
+	lib.watchingOnly = true
+	return watchingonly.Save(filepath.Join(lib.WalletDbDir, watchingonly.FileName), true)
 }
 
 func (lib *DcrWalletLib) IsWalletOpen() bool {
 	return lib.walletLib.WalletOpened()
 }
 
+func (lib *DcrWalletLib) IsWatchingOnlyWallet() bool {
+	return lib.watchingOnly
+}
+
 func (lib *DcrWalletLib) SyncBlockChain(showLog bool, syncProgressUpdated func(*defaultsynclistener.ProgressReport)) {
 	// create wrapper around syncProgressUpdated to store updated peer count before calling main syncInfoUpdated fn
+	// and to publish the progress to subscribers of the wallet's events
 	syncInfoUpdatedWrapper := func(progressReport *defaultsynclistener.ProgressReport, op defaultsynclistener.SyncOp) {
 		if op == defaultsynclistener.PeersCountUpdate {
 			numberOfPeers = progressReport.Read().ConnectedPeers
 		}
 		syncProgressUpdated(progressReport)
+		lib.events.Publish(syncProgressEvent(progressReport))
 	}
 
 	// syncListener listens for actual sync updates, calculates progress and updates the caller via syncInfoUpdated
@@ -43,16 +85,12 @@ func (lib *DcrWalletLib) SyncBlockChain(showLog bool, syncProgressUpdated func(*
 		lib.walletLib.GetBestBlock, lib.walletLib.GetBestBlockTimeStamp, syncInfoUpdatedWrapper)
 	lib.walletLib.AddSyncProgressListener(syncListener)
 
-	err := lib.walletLib.SpvSync("")
+	err := lib.syncOptions.startSync(lib.walletLib)
 	if err != nil {
 		syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
 	}
 }
 
-func (lib *DcrWalletLib) RescanBlockChain() error {
-	return lib.walletLib.RescanBlocks()
-}
-
 func (lib *DcrWalletLib) WalletConnectionInfo() (info walletcore.ConnectionInfo, err error) {
 	accounts, loadAccountErr := lib.AccountsOverview(walletcore.DefaultRequiredConfirmations)
 	if loadAccountErr != nil {
@@ -75,11 +113,22 @@ func (lib *DcrWalletLib) WalletConnectionInfo() (info walletcore.ConnectionInfo,
 
 	info.LatestBlock = bestBlock
 	info.NetworkType = lib.NetType()
-	info.PeersConnected = numberOfPeers
+	info.ConnectionState = walletcore.ConnectionStateConnected
+	info.SyncMode = lib.syncOptions.syncMode()
+	info.SyncSource = lib.syncOptions.source()
+	if info.SyncMode == walletcore.SyncModeSPV {
+		// peers are not used in rpc mode, dcrlibwallet sets the peer count to -1
+		info.PeersConnected = numberOfPeers
+	}
 
 	return
 }
 
+// ConnectionState always returns `walletcore.ConnectionStateConnected` as the wallet is opened in-process.
+func (lib *DcrWalletLib) ConnectionState() walletcore.ConnectionState {
+	return walletcore.ConnectionStateConnected
+}
+
 func (lib *DcrWalletLib) BestBlock() (uint32, error) {
 	return uint32(lib.walletLib.GetBestBlock()), nil
 }
@@ -0,0 +1,189 @@
+package dcrwalletrpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrwallet/rpc/walletrpc"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	healthCheckInterval = 10 * time.Second
+	healthCheckTimeout  = 5 * time.Second
+	minReconnectDelay   = time.Second
+	maxReconnectDelay   = time.Minute
+)
+
+// subscription is the context of a notification stream opened with dcrwallet.
+// Each stream gets its own child context so that it can be canceled before the stream is re-subscribed.
+type subscription struct {
+	cancel    context.CancelFunc
+	ended     chan struct{}
+	closeOnce sync.Once
+}
+
+// subscribe cancels the stream previously stored in `current` and stores a new subscription in its place.
+// The returned context is a child of `ctx` to be used for the new stream.
+func (c *WalletRPCClient) subscribe(ctx context.Context, current **subscription) (context.Context, *subscription) {
+	c.subscriptionsMu.Lock()
+	defer c.subscriptionsMu.Unlock()
+
+	if *current != nil {
+		(*current).cancel()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	*current = &subscription{cancel: cancel, ended: make(chan struct{})}
+	return ctx, *current
+}
+
+// hasEnded checks if the stream stored in `current` failed or could not be opened.
+func (c *WalletRPCClient) hasEnded(current **subscription) bool {
+	c.subscriptionsMu.Lock()
+	sub := *current
+	c.subscriptionsMu.Unlock()
+
+	if sub == nil {
+		return true
+	}
+	select {
+	case <-sub.ended:
+		return true
+	default:
+		return false
+	}
+}
+
+// end marks the stream as failed.
+func (sub *subscription) end() {
+	sub.closeOnce.Do(func() {
+		close(sub.ended)
+	})
+}
+
+func (c *WalletRPCClient) ConnectionState() walletcore.ConnectionState {
+	c.connectionMu.RLock()
+	defer c.connectionMu.RUnlock()
+	return c.connectionState
+}
+
+func (c *WalletRPCClient) setConnectionState(state walletcore.ConnectionState) {
+	c.connectionMu.Lock()
+	if c.connectionState == state {
+		c.connectionMu.Unlock()
+		return
+	}
+	c.connectionState = state
+	c.connectionMu.Unlock()
+
+	c.events.Publish(events.Event{
+		Type: events.ConnectionChanged,
+		Data: events.Connection{Connected: state == walletcore.ConnectionStateConnected},
+	})
+}
+
+// reportConnectionLost is called when a notification stream fails, it wakes the connection supervisor
+// to check the connection without waiting for the next health check.
+func (c *WalletRPCClient) reportConnectionLost() {
+	select {
+	case c.connectionLost <- struct{}{}:
+	default:
+		// the supervisor has already been notified
+	}
+}
+
+// superviseConnection pings dcrwallet every `healthCheckInterval` and whenever a notification stream fails.
+// If dcrwallet does not respond to two pings in a row, the connection state is set to reconnecting until dcrwallet responds again,
+// after which the wallet is re-opened and the notification streams are re-subscribed.
+// It runs until `ctx` is canceled.
+func (c *WalletRPCClient) superviseConnection(ctx context.Context) {
+	healthCheckTicker := time.NewTicker(healthCheckInterval)
+	defer healthCheckTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-healthCheckTicker.C:
+		case <-c.connectionLost:
+		}
+
+		// a single slow response is not a lost connection, dcrwallet is pinged again before reconnecting
+		if err := c.ping(ctx); err != nil && c.ping(ctx) != nil {
+			c.reconnect(ctx)
+		}
+	}
+}
+
+// reconnect waits for dcrwallet to respond, doubling the delay between attempts up to `maxReconnectDelay`.
+// The underlying grpc connection re-establishes the transport by itself, what is lost with it are
+// the notification streams and, if dcrwallet was restarted, the opened wallet and the spv sync.
+func (c *WalletRPCClient) reconnect(ctx context.Context) {
+	c.setConnectionState(walletcore.ConnectionStateReconnecting)
+
+	delay := minReconnectDelay
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		err := c.ping(ctx)
+		if err == nil {
+			err = c.restoreSession(ctx)
+		}
+		if err == nil {
+			break
+		}
+
+		// todo use logger, similar logging should be done across dcrwalletrpc and dcrlibwallet functions
+		fmt.Printf("dcrwallet rpc reconnect attempt failed: %s\n", err.Error())
+
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+
+	// drop failures reported by the old streams while reconnecting
+	select {
+	case <-c.connectionLost:
+	default:
+	}
+
+	c.setConnectionState(walletcore.ConnectionStateConnected)
+}
+
+func (c *WalletRPCClient) ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	_, err := c.walletService.Ping(ctx, &walletrpc.PingRequest{})
+	return err
+}
+
+// restoreSession re-opens the wallet if dcrwallet was restarted and re-subscribes the tx notification stream,
+// the previous tx notification stream is canceled so that txs are not reported twice.
+// The spv sync is only restarted if it was started before the connection was lost and its stream has ended,
+// a sync stream that outlived a failed health check is still syncing the wallet.
+func (c *WalletRPCClient) restoreSession(ctx context.Context) error {
+	_, err := c.walletLoader.OpenWallet(ctx, &walletrpc.OpenWalletRequest{})
+	if err != nil && !isRpcErrorCode(err, codes.AlreadyExists) {
+		return fmt.Errorf("error re-opening wallet: %s", err.Error())
+	}
+
+	if err = c.ListenForTxNotification(ctx); err != nil {
+		return err
+	}
+
+	if c.syncListener != nil && c.hasEnded(&c.spvSyncUpdates) {
+		return c.spvSync(ctx, c.syncShowLog)
+	}
+	return nil
+}
@@ -1,9 +1,10 @@
 package dcrwalletrpc
 
 import (
+	"context"
+	"errors"
 	"path/filepath"
 
-	"context"
 	"github.com/jessevdk/go-flags"
 	"github.com/raedahgroup/godcr/app/config"
 )
@@ -17,6 +18,7 @@ type walletConfig struct {
 	GRPCListeners    []string        `long:"grpclisten" description:"Listen for gRPC connections on this interface/port"`
 	DisableServerTLS bool            `long:"noservertls" description:"Disable TLS for the RPC servers -- NOTE: This is only allowed if the RPC server is bound to localhost"`
 	RPCCert          *ExplicitString `long:"rpccert" description:"File containing the certificate file"`
+	ClientCAFile     string          `long:"clientcafile" description:"File containing Certificate Authorities to verify TLS client certificates"`
 
 	TBOpts struct{} `group:"Ticket Buyer Options" namespace:"ticketbuyer"`
 }
@@ -29,31 +31,36 @@ var (
 	walletConfigFilePath = filepath.Join(config.DefaultDcrwalletAppDataDir, defaultWalletConfigFilename)
 )
 
-func parseDcrWalletConfigAndConnect(ctx context.Context, useRpcCert string, disableTls bool) *WalletRPCClient {
+// parseDcrWalletConfigAndConnect connects to the grpc addresses set in the dcrwallet config file.
+// Returns an error if dcrwallet requires client certificates but godcr has none configured,
+// since dcrwallet would reject every connection attempt.
+func parseDcrWalletConfigAndConnect(ctx context.Context, tlsOptions rpcTLSOptions) (*WalletRPCClient, error) {
 	wConfig := walletConfig{}
 
 	parser := flags.NewParser(&wConfig, flags.IgnoreUnknown)
 	err := flags.NewIniParser(parser).ParseFile(walletConfigFilePath)
 	if err != nil {
-		return nil
+		return nil, nil
+	}
+
+	if tlsOptions.serverCert == "" && wConfig.RPCCert != nil {
+		tlsOptions.serverCert = wConfig.RPCCert.Value
 	}
 
-	var rpcCert string
-	if useRpcCert != "" {
-		rpcCert = useRpcCert
-	} else if wConfig.RPCCert != nil {
-		rpcCert = wConfig.RPCCert.Value
+	if wConfig.ClientCAFile != "" && !tlsOptions.disabled && !tlsOptions.useClientCert() {
+		return nil, errors.New("dcrwallet requires a client certificate (clientcafile is set in dcrwallet.conf), " +
+			"set walletrpcclientcert and walletrpcclientkey in config file to a certificate signed by that CA")
 	}
 
 	for _, address := range wConfig.GRPCListeners {
-		walletRPCClient, _ := createConnection(ctx, address, rpcCert, disableTls)
+		walletRPCClient, _ := createConnection(ctx, address, tlsOptions)
 		if walletRPCClient != nil {
 			config.UpdateConfigFile(func(config *config.ConfFileOptions) {
 				config.WalletRPCServer = address
 			})
-			return walletRPCClient
+			return walletRPCClient, nil
 		}
 	}
 
-	return nil
+	return nil, nil
 }
@@ -7,6 +7,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrwallet/netparams"
@@ -14,7 +15,14 @@ import (
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
 	"github.com/raedahgroup/dcrlibwallet/txindex"
 	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/godcr/app"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/lockedoutputs"
+	"github.com/raedahgroup/godcr/app/seedbackup"
+	"github.com/raedahgroup/godcr/app/txlabels"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/app/watchingonly"
 	"google.golang.org/grpc/codes"
 )
 
@@ -22,16 +30,33 @@ import (
 // Functions relating to operations that can be performed on a wallet are defined in `walletfunctions.go`
 // Other wallet-related functions are defined in `walletloader.go`
 type WalletRPCClient struct {
-	walletLoader  walletrpc.WalletLoaderServiceClient
-	walletService walletrpc.WalletServiceClient
-	walletOpen    bool
-	activeNet     *netparams.Params
+	walletLoader    walletrpc.WalletLoaderServiceClient
+	walletService   walletrpc.WalletServiceClient
+	messageVerifier walletrpc.MessageVerificationServiceClient
+	walletOpen      bool
+	watchingOnly    bool
+	activeNet       *netparams.Params
+	rpcAddress      string
+	appDataDir      string
 
 	numberOfPeers int32
 	syncListener  *defaultsynclistener.DefaultSyncListener
+	syncShowLog   bool
 
-	txIndexDB              *txindex.DB
-	txNotificationListener TransactionListener
+	connectionMu    sync.RWMutex
+	connectionState walletcore.ConnectionState
+	connectionLost  chan struct{}
+
+	subscriptionsMu sync.Mutex
+	txNotifications *subscription
+	spvSyncUpdates  *subscription
+
+	txIndexDB *txindex.DB
+	events    *events.Bus
+
+	lockedOutputs *lockedoutputs.Store
+	txLabels      *txlabels.Store
+	seedBackup    *seedbackup.Store
 }
 
 // Connect establishes gRPC connection to a running dcrwallet daemon at the specified address,
@@ -46,27 +71,29 @@ func Connect(ctx context.Context, cfg *config.Config) (walletRPCClient *WalletRP
 		}
 	}()
 
-	walletRPCClient, err = createConnection(ctx, cfg.WalletRPCServer, cfg.WalletRPCCert, cfg.NoWalletRPCTLS)
+	tlsOptions := tlsOptionsFromConfig(cfg)
+
+	walletRPCClient, err = createConnection(ctx, cfg.WalletRPCServer, tlsOptions)
 	if err == nil {
 		return
 	}
 
-	walletRPCClient = parseDcrWalletConfigAndConnect(ctx, cfg.WalletRPCCert, cfg.NoWalletRPCTLS)
-	if walletRPCClient != nil {
+	walletRPCClient, err = parseDcrWalletConfigAndConnect(ctx, tlsOptions)
+	if walletRPCClient != nil || err != nil {
 		return
 	}
 
-	walletRPCClient = connectToDefaultAddresses(ctx, cfg.WalletRPCCert, cfg.NoWalletRPCTLS)
+	walletRPCClient = connectToDefaultAddresses(ctx, tlsOptions)
 	return
 }
 
-func createConnection(ctx context.Context, rpcAddress, rpcCert string, noTLS bool) (*WalletRPCClient, error) {
-	if !noTLS && rpcCert == "" {
+func createConnection(ctx context.Context, rpcAddress string, tlsOptions rpcTLSOptions) (*WalletRPCClient, error) {
+	if !tlsOptions.disabled && tlsOptions.serverCert == "" {
 		return nil, errors.New("set dcrwallet rpc certificate path in config file or disable tls for dcrwallet connection")
 	}
 
 	// perform rpc connection in background, user might shutdown before connection is complete
-	go connectToRPC(rpcAddress, rpcCert, noTLS)
+	go connectToRPC(rpcAddress, tlsOptions)
 
 	select {
 	case <-ctx.Done():
@@ -78,16 +105,21 @@ func createConnection(ctx context.Context, rpcAddress, rpcCert string, noTLS boo
 		}
 
 		return &WalletRPCClient{
-			walletLoader:  walletrpc.NewWalletLoaderServiceClient(connectionResult.conn),
-			walletService: walletrpc.NewWalletServiceClient(connectionResult.conn),
+			walletLoader:    walletrpc.NewWalletLoaderServiceClient(connectionResult.conn),
+			walletService:   walletrpc.NewWalletServiceClient(connectionResult.conn),
+			messageVerifier: walletrpc.NewMessageVerificationServiceClient(connectionResult.conn),
+			rpcAddress:      rpcAddress,
+			connectionState: walletcore.ConnectionStateConnected,
+			connectionLost:  make(chan struct{}, 1),
+			events:          events.NewBus(),
 		}, nil
 	}
 }
 
-func connectToDefaultAddresses(ctx context.Context, rpcCert string, noTLS bool) (walletRPCClient *WalletRPCClient) {
+func connectToDefaultAddresses(ctx context.Context, tlsOptions rpcTLSOptions) (walletRPCClient *WalletRPCClient) {
 	// try connecting with default testnet3 params
 	testnetAddress := net.JoinHostPort("localhost", netparams.TestNet3Params.GRPCServerPort)
-	walletRPCClient, _ = createConnection(ctx, testnetAddress, rpcCert, noTLS)
+	walletRPCClient, _ = createConnection(ctx, testnetAddress, tlsOptions)
 	if walletRPCClient != nil {
 		config.UpdateConfigFile(func(config *config.ConfFileOptions) {
 			config.WalletRPCServer = testnetAddress
@@ -97,7 +129,7 @@ func connectToDefaultAddresses(ctx context.Context, rpcCert string, noTLS bool)
 
 	// try connecting with default mainnet params
 	mainnetAddress := net.JoinHostPort("localhost", netparams.MainNetParams.GRPCServerPort)
-	walletRPCClient, _ = createConnection(ctx, mainnetAddress, rpcCert, noTLS)
+	walletRPCClient, _ = createConnection(ctx, mainnetAddress, tlsOptions)
 	if walletRPCClient != nil {
 		config.UpdateConfigFile(func(config *config.ConfFileOptions) {
 			config.WalletRPCServer = mainnetAddress
@@ -107,10 +139,21 @@ func connectToDefaultAddresses(ctx context.Context, rpcCert string, noTLS bool)
 	return
 }
 
-func openWalletIfExist(ctx context.Context, c *WalletRPCClient, appDataDir string) error {
+func openWalletIfExist(ctx context.Context, c *WalletRPCClient, appDataDir string) (err error) {
 	c.walletOpen = false
+	c.appDataDir = appDataDir
+
+	// the seed backup status is only kept in memory until a wallet is opened and its data directory is known
+	c.seedBackup, err = seedbackup.Load("")
+	if err != nil {
+		return err
+	}
+
 	loadWalletDone := make(chan error)
 
+	// openWalletResponse remains nil if the wallet was already open, it is only read after loadWalletDone receives
+	var openWalletResponse *walletrpc.OpenWalletResponse
+
 	go func() {
 		var openWalletError error
 		defer func() {
@@ -122,7 +165,7 @@ func openWalletIfExist(ctx context.Context, c *WalletRPCClient, appDataDir strin
 			return
 		}
 
-		_, openWalletError = c.walletLoader.OpenWallet(context.Background(), &walletrpc.OpenWalletRequest{})
+		openWalletResponse, openWalletError = c.walletLoader.OpenWallet(context.Background(), &walletrpc.OpenWalletRequest{})
 
 		// ignore wallet already open errors, it could be that dcrwallet loaded the wallet when it was launched by the user
 		// or godcr opened the wallet without closing it
@@ -135,7 +178,7 @@ func openWalletIfExist(ctx context.Context, c *WalletRPCClient, appDataDir strin
 	case err := <-loadWalletDone:
 		// if err is nil, then wallet was opened
 		if err == nil {
-			err = finalizeWalletSetup(ctx, c, appDataDir)
+			err = finalizeWalletSetup(ctx, c, appDataDir, openWalletResponse)
 		} else {
 			c.walletOpen = false
 		}
@@ -146,12 +189,31 @@ func openWalletIfExist(ctx context.Context, c *WalletRPCClient, appDataDir strin
 	}
 }
 
-func finalizeWalletSetup(ctx context.Context, c *WalletRPCClient, appDataDir string) error {
+// finalizeWalletSetup prepares the wallet opened by dcrwallet for use.
+// `openWalletResponse` is nil if the wallet was already open, e.g. because dcrwallet opened it on launch.
+func finalizeWalletSetup(ctx context.Context, c *WalletRPCClient, appDataDir string, openWalletResponse *walletrpc.OpenWalletResponse) error {
 	c.walletOpen = true
 
 	// wallet is open, best time to detect network type for dcrwallet rpc connection
 	c.activeNet, _ = getNetParam(c.walletService)
 
+	walletDataDir, err := c.walletDataDir()
+	if err != nil {
+		return err
+	}
+
+	// dcrwallet only reports whether a wallet is watching-only when opening it,
+	// the status is saved so that it is known when connecting to dcrwallet after it has opened the wallet itself
+	watchingOnlyPath := filepath.Join(walletDataDir, watchingonly.FileName)
+	if openWalletResponse != nil {
+		c.watchingOnly = openWalletResponse.WatchingOnly
+		if err = watchingonly.Save(watchingOnlyPath, c.watchingOnly); err != nil {
+			return err
+		}
+	} else {
+		c.watchingOnly = watchingonly.Load(watchingOnlyPath)
+	}
+
 	// set database for indexing transactions for faster loading
 	// important to do it at this point before wallet operations
 	// such as sync and transaction notification are triggered
@@ -176,13 +238,53 @@ func finalizeWalletSetup(ctx context.Context, c *WalletRPCClient, appDataDir str
 	}
 	c.txIndexDB = txIndexDB
 
+	c.txLabels, err = txlabels.Load(filepath.Join(filepath.Dir(txIndexDbPath), txlabels.FileName))
+	if err != nil {
+		return err
+	}
+
+	if err = c.loadWalletStores(walletDataDir); err != nil {
+		return err
+	}
+
 	// start tx notification listener now,
 	// so we can index txs as the wallet is notified of new/updated txs
 	c.ListenForTxNotification(ctx)
 
+	// keep watching the connection to dcrwallet, streams are re-subscribed if the connection drops
+	go c.superviseConnection(ctx)
+
 	return nil
 }
 
+// loadWalletStores loads the data godcr keeps about the wallet opened by dcrwallet from `walletDataDir`.
+func (c *WalletRPCClient) loadWalletStores(walletDataDir string) (err error) {
+	// dcrwallet does not persist locked outputs across restarts, so godcr keeps them with its data about the wallet
+	c.lockedOutputs, err = lockedoutputs.Load(filepath.Join(walletDataDir, lockedoutputs.FileName))
+	if err != nil {
+		return err
+	}
+
+	c.seedBackup, err = seedbackup.Load(filepath.Join(walletDataDir, seedbackup.FileName))
+	return err
+}
+
+// walletDataDir returns the directory where godcr keeps its own data about the wallet opened by dcrwallet.
+// dcrwallet does not name its wallets, so the directory is named after the network of the wallet
+// and a hash of the extended public key of its default account, which is the same every time the wallet is opened.
+func (c *WalletRPCClient) walletDataDir() (string, error) {
+	if c.activeNet == nil {
+		return "", errors.New("cannot identify the wallet opened by dcrwallet, its network is unknown")
+	}
+
+	extendedPubKey, err := c.AccountExtendedPubKey(0)
+	if err != nil {
+		return "", fmt.Errorf("cannot identify the wallet opened by dcrwallet: %s", err.Error())
+	}
+
+	return app.WalletDataDir(c.appDataDir, "rpc-wallets", c.activeNet.Name, extendedPubKey), nil
+}
+
 func getNetParam(walletService walletrpc.WalletServiceClient) (param *netparams.Params, err error) {
 	req := &walletrpc.NetworkRequest{}
 	res, err := walletService.Network(context.Background(), req)
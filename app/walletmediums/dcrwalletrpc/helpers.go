@@ -23,25 +23,38 @@ func (c *WalletRPCClient) unspentOutputStream(account uint32, targetAmount int64
 }
 
 func (c *WalletRPCClient) signAndPublishTransaction(serializedTx []byte, passphrase string) (string, error) {
-	ctx := context.Background()
+	signedTx, err := c.signTransaction(serializedTx, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return c.publishTransaction(signedTx)
+}
 
-	// sign transaction
+func (c *WalletRPCClient) signTransaction(serializedTx []byte, passphrase string) ([]byte, error) {
 	signRequest := &walletrpc.SignTransactionRequest{
 		Passphrase:            []byte(passphrase),
 		SerializedTransaction: serializedTx,
 	}
 
-	signResponse, err := c.walletService.SignTransaction(ctx, signRequest)
+	signResponse, err := c.walletService.SignTransaction(context.Background(), signRequest)
 	if err != nil {
-		return "", fmt.Errorf("error signing transaction: %s", err.Error())
+		return nil, fmt.Errorf("error signing transaction: %s", err.Error())
 	}
 
-	// publish transaction
+	if len(signResponse.UnsignedInputIndexes) > 0 {
+		return nil, fmt.Errorf("error signing transaction: wallet could not sign inputs %v", signResponse.UnsignedInputIndexes)
+	}
+
+	return signResponse.Transaction, nil
+}
+
+func (c *WalletRPCClient) publishTransaction(signedTx []byte) (string, error) {
 	publishRequest := &walletrpc.PublishTransactionRequest{
-		SignedTransaction: signResponse.Transaction,
+		SignedTransaction: signedTx,
 	}
 
-	publishResponse, err := c.walletService.PublishTransaction(ctx, publishRequest)
+	publishResponse, err := c.walletService.PublishTransaction(context.Background(), publishRequest)
 	if err != nil {
 		return "", fmt.Errorf("error publishing transaction: %s", err.Error())
 	}
@@ -1,9 +1,18 @@
 package dcrwalletrpc
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/raedahgroup/godcr/app/config"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
@@ -18,12 +27,39 @@ var (
 	rpcConnectionTimeout = 5 * time.Second
 )
 
-func connectToRPC(rpcAddress, rpcCert string, noTLS bool) {
+// rpcTLSOptions holds the certificates used to secure the connection to dcrwallet.
+// The client certificate and key are only needed if dcrwallet is run with --clientcafile.
+type rpcTLSOptions struct {
+	disabled   bool
+	serverCert string
+	clientCert string
+	clientKey  string
+}
+
+func tlsOptionsFromConfig(cfg *config.Config) rpcTLSOptions {
+	return rpcTLSOptions{
+		disabled:   cfg.NoWalletRPCTLS,
+		serverCert: cfg.WalletRPCCert,
+		clientCert: cfg.WalletRPCClientCert,
+		clientKey:  cfg.WalletRPCClientKey,
+	}
+}
+
+func (options rpcTLSOptions) useClientCert() bool {
+	return options.clientCert != "" || options.clientKey != ""
+}
+
+func connectToRPC(rpcAddress string, tlsOptions rpcTLSOptions) {
 	var conn *grpc.ClientConn
 	var err error
 
+	// handshake errors are not returned by grpc.Dial, the dial attempt simply times out
+	var handshakeErrors *handshakeErrorRecorder
+
 	defer func() {
-		if conn == nil && err == nil {
+		if conn == nil && handshakeErrors != nil && handshakeErrors.lastError() != nil {
+			err = describeHandshakeError(rpcAddress, tlsOptions, handshakeErrors.lastError())
+		} else if conn == nil && (err == nil || err == context.DeadlineExceeded) {
 			// connection timeout
 			err = fmt.Errorf("cannot connect to the rpc address '%s', connection attempt timed out after %s", rpcAddress,
 				rpcConnectionTimeout)
@@ -42,16 +78,124 @@ func connectToRPC(rpcAddress, rpcCert string, noTLS bool) {
 		grpc.WithTimeout(rpcConnectionTimeout),
 	}
 
-	if noTLS {
+	if tlsOptions.disabled {
 		grpcConnectionOptions = append(grpcConnectionOptions, grpc.WithInsecure())
 		conn, err = grpc.Dial(rpcAddress, grpcConnectionOptions...)
-	} else {
-		creds, err := credentials.NewClientTLSFromFile(rpcCert, "")
+		return
+	}
+
+	tlsConfig, err := loadTLSConfig(tlsOptions)
+	if err != nil {
+		return
+	}
+
+	handshakeErrors = &handshakeErrorRecorder{TransportCredentials: credentials.NewTLS(tlsConfig)}
+	grpcConnectionOptions = append(grpcConnectionOptions, grpc.WithTransportCredentials(handshakeErrors))
+	conn, err = grpc.Dial(rpcAddress, grpcConnectionOptions...)
+}
+
+// loadTLSConfig reads the dcrwallet certificate and, if set, the client certificate and key presented to dcrwallet.
+func loadTLSConfig(tlsOptions rpcTLSOptions) (*tls.Config, error) {
+	serverCert, err := ioutil.ReadFile(tlsOptions.serverCert)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the dcrwallet rpc certificate (walletrpccert): %s", err.Error())
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(serverCert) {
+		return nil, fmt.Errorf("the dcrwallet rpc certificate (walletrpccert) %s is not a valid PEM certificate",
+			tlsOptions.serverCert)
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs: rootCAs,
+	}
+
+	if tlsOptions.useClientCert() {
+		if tlsOptions.clientCert == "" || tlsOptions.clientKey == "" {
+			return nil, errors.New("set both walletrpcclientcert and walletrpcclientkey in config file to use a client certificate")
+		}
+
+		clientCert, err := tls.LoadX509KeyPair(tlsOptions.clientCert, tlsOptions.clientKey)
 		if err != nil {
-			return
+			return nil, fmt.Errorf("cannot load the client certificate (walletrpcclientcert and walletrpcclientkey): %s",
+				err.Error())
 		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
 
-		grpcConnectionOptions = append(grpcConnectionOptions, grpc.WithTransportCredentials(creds))
-		conn, err = grpc.Dial(rpcAddress, grpcConnectionOptions...)
+		// dcrwallet rejects an untrusted client certificate with an alert after the handshake when using TLS 1.3,
+		// which grpc reports as a dropped connection. With TLS 1.2 the rejection fails the handshake and can be explained.
+		tlsConfig.MaxVersion = tls.VersionTLS12
 	}
+
+	return tlsConfig, nil
+}
+
+// describeHandshakeError explains why the tls handshake with dcrwallet failed and how to fix it.
+func describeHandshakeError(rpcAddress string, tlsOptions rpcTLSOptions, handshakeError error) error {
+	errorMessage := handshakeError.Error()
+
+	switch {
+	case strings.Contains(errorMessage, "first record does not look like a TLS handshake"):
+		return fmt.Errorf("dcrwallet at %s does not use TLS, set nowalletrpctls=1 in config file or enable TLS on dcrwallet",
+			rpcAddress)
+
+	case strings.Contains(errorMessage, "certificate signed by unknown authority"):
+		return fmt.Errorf("the certificate of dcrwallet at %s is not signed by walletrpccert (%s), "+
+			"set walletrpccert in config file to the rpc.cert file of that dcrwallet", rpcAddress, tlsOptions.serverCert)
+
+	case strings.Contains(errorMessage, "certificate is valid for"):
+		return fmt.Errorf("the certificate of dcrwallet does not include the host of %s, "+
+			"set walletrpcserver to a host name that the certificate was issued for: %s", rpcAddress, errorMessage)
+
+	// dcrwallet rejects missing client certificates with a handshake failure alert and untrusted ones with bad certificate
+	case strings.Contains(errorMessage, "remote error: tls: handshake failure"),
+		strings.Contains(errorMessage, "bad certificate"),
+		strings.Contains(errorMessage, "certificate required"),
+		strings.Contains(errorMessage, "unknown certificate authority"):
+		if !tlsOptions.useClientCert() {
+			return fmt.Errorf("dcrwallet at %s requires a client certificate, "+
+				"set walletrpcclientcert and walletrpcclientkey in config file to a certificate signed by dcrwallet's clientcafile",
+				rpcAddress)
+		}
+		return fmt.Errorf("dcrwallet at %s rejected the client certificate (walletrpcclientcert) %s, "+
+			"make sure that it is signed by dcrwallet's clientcafile", rpcAddress, tlsOptions.clientCert)
+
+	case strings.Contains(errorMessage, "certificate has expired"):
+		return fmt.Errorf("a certificate used for the connection to dcrwallet at %s has expired: %s", rpcAddress, errorMessage)
+
+	default:
+		return fmt.Errorf("tls handshake with dcrwallet at %s failed: %s", rpcAddress, errorMessage)
+	}
+}
+
+// handshakeErrorRecorder keeps the last error returned by the tls handshake of a dcrwallet connection attempt.
+type handshakeErrorRecorder struct {
+	credentials.TransportCredentials
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+func (recorder *handshakeErrorRecorder) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn,
+	credentials.AuthInfo, error) {
+
+	conn, authInfo, err := recorder.TransportCredentials.ClientHandshake(ctx, authority, rawConn)
+
+	recorder.mu.Lock()
+	recorder.lastErr = err
+	recorder.mu.Unlock()
+
+	return conn, authInfo, err
+}
+
+// Clone returns the recorder itself so that handshake errors of connections made by clones are recorded too.
+func (recorder *handshakeErrorRecorder) Clone() credentials.TransportCredentials {
+	return recorder
+}
+
+func (recorder *handshakeErrorRecorder) lastError() error {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	return recorder.lastErr
 }
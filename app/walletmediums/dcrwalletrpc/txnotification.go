@@ -6,31 +6,32 @@ import (
 
 	"github.com/decred/dcrwallet/rpc/walletrpc"
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/godcr/app/events"
 )
 
-type TransactionListener interface {
-	OnTransaction(transaction *txhelper.Transaction)
-	OnTransactionConfirmed(hash string, height int32)
-	OnBlockAttached(height int32, timestamp int64)
-}
-
-func (c *WalletRPCClient) RegisterTxNotificationListener(listener TransactionListener) {
-	c.txNotificationListener = listener
+func (c *WalletRPCClient) Events() *events.Bus {
+	return c.events
 }
 
+// ListenForTxNotification subscribes to the tx notifications of dcrwallet, a previous subscription is canceled.
 func (c *WalletRPCClient) ListenForTxNotification(ctx context.Context) error {
+	ctx, sub := c.subscribe(ctx, &c.txNotifications)
+
 	txNotificationStream, err := c.walletService.TransactionNotifications(ctx, &walletrpc.TransactionNotificationsRequest{})
 	if err != nil {
+		sub.end()
 		return fmt.Errorf("cannot start tx notification listener: %s", err.Error())
 	}
 
-	go c.startTxNotificationListener(ctx, txNotificationStream)
+	go c.startTxNotificationListener(ctx, sub, txNotificationStream)
 	return nil
 }
 
-func (c *WalletRPCClient) startTxNotificationListener(ctx context.Context,
+func (c *WalletRPCClient) startTxNotificationListener(ctx context.Context, sub *subscription,
 	txNotificationStream walletrpc.WalletService_TransactionNotificationsClient) {
 
+	defer sub.end()
+
 	for {
 		txNotification, err := txNotificationStream.Recv()
 
@@ -42,8 +43,14 @@ func (c *WalletRPCClient) startTxNotificationListener(ctx context.Context,
 		if err != nil {
 			// todo use logger, similar logging should be done across dcrwalletrpc and dcrlibwallet functions
 			fmt.Printf("error reading tx notification update: %s\n", err.Error())
+
+			// the stream is closed, the connection supervisor re-subscribes once dcrwallet is reachable
+			c.reportConnectionLost()
+			return
 		}
 
+		var balanceChanged bool
+
 		// process unmined tx gotten from notification
 		for _, txSummary := range txNotification.UnminedTransactions {
 			decodedTx, err := c.decodeTransactionWithTxSummary(ctx, txSummary, nil)
@@ -52,17 +59,18 @@ func (c *WalletRPCClient) startTxNotificationListener(ctx context.Context,
 			}
 
 			err = c.txIndexDB.SaveOrUpdate(decodedTx)
-			if err == nil && c.txNotificationListener != nil {
-				c.txNotificationListener.OnTransaction(decodedTx)
-				continue
+			if err == nil {
+				c.events.Publish(events.Event{Type: events.TxReceived, Data: txEventData(decodedTx)})
+				balanceChanged = true
 			}
 		}
 
 		// process mined tx gotten from notification
 		for _, block := range txNotification.AttachedBlocks {
-			if c.txNotificationListener != nil {
-				c.txNotificationListener.OnBlockAttached(block.Height, block.Timestamp)
-			}
+			c.events.Publish(events.Event{
+				Type: events.BlockAttached,
+				Data: events.Block{Height: block.Height, Timestamp: block.Timestamp},
+			})
 
 			blockHash := block.Hash
 			for _, txSummary := range block.Transactions {
@@ -76,10 +84,23 @@ func (c *WalletRPCClient) startTxNotificationListener(ctx context.Context,
 					continue
 				}
 
-				if c.txNotificationListener != nil {
-					c.txNotificationListener.OnTransactionConfirmed(decodedTx.Hash, block.Height)
-				}
+				c.events.Publish(events.Event{Type: events.TxConfirmed, Data: txEventData(decodedTx)})
+				balanceChanged = true
 			}
 		}
+
+		if balanceChanged {
+			c.events.Publish(events.Event{Type: events.BalanceChanged})
+		}
+	}
+}
+
+func txEventData(tx *txhelper.Transaction) events.Tx {
+	return events.Tx{
+		Hash:        tx.Hash,
+		Amount:      tx.Amount,
+		Fee:         tx.Fee,
+		Direction:   int32(tx.Direction),
+		BlockHeight: tx.BlockHeight,
 	}
 }
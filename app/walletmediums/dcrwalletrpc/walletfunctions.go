@@ -3,6 +3,7 @@ package dcrwalletrpc
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -115,6 +116,29 @@ func (c *WalletRPCClient) AccountName(accountNumber uint32) (string, error) {
 	return "", fmt.Errorf("Account not found")
 }
 
+func (c *WalletRPCClient) RenameAccount(accountNumber uint32, newName string) error {
+	req := &walletrpc.RenameAccountRequest{
+		AccountNumber: accountNumber,
+		NewName:       newName,
+	}
+
+	_, err := c.walletService.RenameAccount(context.Background(), req)
+	return err
+}
+
+func (c *WalletRPCClient) AccountExtendedPubKey(accountNumber uint32) (string, error) {
+	req := &walletrpc.GetAccountExtendedPubKeyRequest{
+		AccountNumber: accountNumber,
+	}
+
+	r, err := c.walletService.GetAccountExtendedPubKey(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+
+	return r.AccExtendedPubKey, nil
+}
+
 func (c *WalletRPCClient) AddressInfo(address string) (*dcrlibwallet.AddressInfo, error) {
 	req := &walletrpc.ValidateAddressRequest{
 		Address: address,
@@ -143,6 +167,44 @@ func (c *WalletRPCClient) ValidateAddress(address string) (bool, error) {
 	return err == nil, nil
 }
 
+func (c *WalletRPCClient) SignMessage(address, message, passphrase string) (string, error) {
+	if c.watchingOnly {
+		return "", walletcore.ErrWatchingOnlyWallet
+	}
+	req := &walletrpc.SignMessageRequest{
+		Address:    address,
+		Message:    message,
+		Passphrase: []byte(passphrase),
+	}
+
+	res, err := c.walletService.SignMessage(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(res.Signature), nil
+}
+
+func (c *WalletRPCClient) VerifyMessage(address, message, signature string) (bool, error) {
+	signatureBytes, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false, fmt.Errorf("error decoding signature: %s", err.Error())
+	}
+
+	req := &walletrpc.VerifyMessageRequest{
+		Address:   address,
+		Message:   message,
+		Signature: signatureBytes,
+	}
+
+	res, err := c.messageVerifier.VerifyMessage(context.Background(), req)
+	if err != nil {
+		return false, err
+	}
+
+	return res.Valid, nil
+}
+
 // ReceiveAddress uses GAP_POLICY_WRAP which returns previously generated unused addresses ONLY if the gap limit is exceeded
 // Ideally, ReceiveAddress should always return the last generated address that has not been used
 func (c *WalletRPCClient) ReceiveAddress(account uint32) (string, error) {
@@ -225,55 +287,77 @@ func (c *WalletRPCClient) UnspentOutputs(account uint32, targetAmount int64, req
 		unspentOutputs = append(unspentOutputs, unspentOutput)
 	}
 
+	c.lockedOutputs.MarkLocked(unspentOutputs)
 	return unspentOutputs, nil
 }
 
-func (c *WalletRPCClient) SendFromAccount(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination, passphrase string) (string, error) {
-	outputs, _, maxAmountRecipientAddress, err := txhelper.TxOutputsExtractMaxDestinationAddress(destinations)
+func (c *WalletRPCClient) LockUnspent(outputKeys []string, lock bool) error {
+	return c.lockedOutputs.Lock(outputKeys, lock)
+}
+
+func (c *WalletRPCClient) LockedOutputs() ([]string, error) {
+	return c.lockedOutputs.OutputKeys(), nil
+}
+
+func (c *WalletRPCClient) SendFromAccount(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination,
+	feeRate int64, coinSelection string, passphrase string) (string, error) {
+
+	if c.watchingOnly {
+		return "", walletcore.ErrWatchingOnlyWallet
+	}
+	unsignedTx, err := c.ConstructTransaction(sourceAccount, requiredConfirmations, destinations, feeRate, coinSelection)
 	if err != nil {
 		return "", err
 	}
 
-	// construct non-change outputs for all recipients, excluding destination for send max
-	walletrpcOutputs := make([]*walletrpc.ConstructTransactionRequest_Output, len(outputs))
-	for i, output := range outputs {
-		walletrpcOutputs[i] = &walletrpc.ConstructTransactionRequest_Output{
-			Destination: &walletrpc.ConstructTransactionRequest_OutputDestination{
-				Script:        output.PkScript,
-				ScriptVersion: uint32(output.Version),
-			},
-			Amount: output.Value,
-		}
+	return c.signAndPublishTransaction(unsignedTx, passphrase)
+}
+
+// ConstructTransaction selects the inputs for the tx here rather than with dcrwallet's ConstructTransaction rpc,
+// which does not know about the outputs locked in godcr and does not support godcr's coin selection strategies.
+func (c *WalletRPCClient) ConstructTransaction(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination,
+	feeRate int64, coinSelection string) ([]byte, error) {
+
+	utxos, err := c.UnspentOutputs(sourceAccount, 0, requiredConfirmations)
+	if err != nil {
+		return nil, err
 	}
 
-	// construct transaction
-	constructTxRequest := &walletrpc.ConstructTransactionRequest{
-		SourceAccount:         sourceAccount,
-		NonChangeOutputs:      walletrpcOutputs,
-		RequiredConfirmations: requiredConfirmations,
+	selectedUtxos, _, err := walletcore.SelectCoins(coinSelection, utxos, destinations, feeRate)
+	if err != nil {
+		return nil, err
 	}
 
-	// if no max amount recipient, use default utxo selection algorithm and nil change source
-	// so that a change source to the sending account is automatically created
-	// otherwise, create a change source for the max amount recipient so that the remaining change from the tx is sent to the max amount recipient
-	if maxAmountRecipientAddress != "" {
-		constructTxRequest.OutputSelectionAlgorithm = walletrpc.ConstructTransactionRequest_ALL
-		constructTxRequest.ChangeDestination = &walletrpc.ConstructTransactionRequest_OutputDestination{
-			Address: maxAmountRecipientAddress,
-		}
-	} else {
-		constructTxRequest.OutputSelectionAlgorithm = walletrpc.ConstructTransactionRequest_UNSPECIFIED
+	inputs, err := walletcore.TxInputs(selectedUtxos)
+	if err != nil {
+		return nil, err
 	}
 
-	constructResponse, err := c.walletService.ConstructTransaction(context.Background(), constructTxRequest)
+	unsignedTx, err := walletcore.NewUnsignedTx(inputs, destinations, nil, func() (address string, err error) {
+		return c.GenerateNewAddress(sourceAccount)
+	}, feeRate)
 	if err != nil {
-		return "", fmt.Errorf("error constructing transaction: %s", err.Error())
+		return nil, err
 	}
 
-	return c.signAndPublishTransaction(constructResponse.UnsignedTransaction, passphrase)
+	return unsignedTx.Bytes()
 }
 
-func (c *WalletRPCClient) SendFromUTXOs(sourceAccount uint32, requiredConfirmations int32, utxoKeys []string, txDestinations []txhelper.TransactionDestination, changeDestinations []txhelper.TransactionDestination, passphrase string) (string, error) {
+func (c *WalletRPCClient) SignRawTransaction(serializedTx []byte, passphrase string) ([]byte, error) {
+	if c.watchingOnly {
+		return nil, walletcore.ErrWatchingOnlyWallet
+	}
+	return c.signTransaction(serializedTx, passphrase)
+}
+
+func (c *WalletRPCClient) PublishRawTransaction(serializedTx []byte) (string, error) {
+	return c.publishTransaction(serializedTx)
+}
+
+func (c *WalletRPCClient) SendFromUTXOs(sourceAccount uint32, requiredConfirmations int32, utxoKeys []string, txDestinations []txhelper.TransactionDestination, changeDestinations []txhelper.TransactionDestination, feeRate int64, passphrase string) (string, error) {
+	if c.watchingOnly {
+		return "", walletcore.ErrWatchingOnlyWallet
+	}
 	// fetch all utxos in account to extract details for the utxos selected by user
 	// passing 0 as targetAmount to c.unspentOutputStream fetches ALL utxos in account
 	utxoStream, err := c.unspentOutputStream(sourceAccount, 0, requiredConfirmations)
@@ -316,10 +400,14 @@ func (c *WalletRPCClient) SendFromUTXOs(sourceAccount uint32, requiredConfirmati
 			break
 		}
 	}
+	if len(inputs) != len(utxoKeys) {
+		return "", fmt.Errorf("%d of the selected unspent outputs were not found in account, are already spent or do not have %d confirmations",
+			len(utxoKeys)-len(inputs), requiredConfirmations)
+	}
 
-	unsignedTx, err := txhelper.NewUnsignedTx(inputs, txDestinations, changeDestinations, func() (address string, err error) {
+	unsignedTx, err := walletcore.NewUnsignedTx(inputs, txDestinations, changeDestinations, func() (address string, err error) {
 		return c.GenerateNewAddress(sourceAccount)
-	})
+	}, feeRate)
 	if err != nil {
 		return "", err
 	}
@@ -362,6 +450,7 @@ func (c *WalletRPCClient) TransactionHistory(offset, count int32, filter *txinde
 		}
 		processedTxs[i] = walletcore.TxDetails(tx, confirmations)
 	}
+	walletcore.AddTransactionLabels(c.txLabels, processedTxs...)
 
 	return processedTxs, nil
 }
@@ -386,7 +475,21 @@ func (c *WalletRPCClient) GetTransaction(transactionHash string) (*walletcore.Tr
 		return nil, err
 	}
 
-	return walletcore.TxDetails(tx, getTxResponse.Confirmations), nil
+	txDetails := walletcore.TxDetails(tx, getTxResponse.Confirmations)
+	walletcore.AddTransactionLabels(c.txLabels, txDetails)
+	return txDetails, nil
+}
+
+func (c *WalletRPCClient) SetTransactionLabel(transactionHash, label string) error {
+	tx, err := c.GetTransaction(transactionHash)
+	if err != nil {
+		return err
+	}
+	return c.txLabels.SetLabel(tx.Hash, label)
+}
+
+func (c *WalletRPCClient) SearchTransactions(query string) ([]*walletcore.Transaction, error) {
+	return walletcore.SearchLabeledTransactions(c.txLabels, query, c.GetTransaction)
 }
 
 func (c *WalletRPCClient) StakeInfo(ctx context.Context) (*walletcore.StakeInfo, error) {
@@ -410,6 +513,93 @@ func (c *WalletRPCClient) StakeInfo(ctx context.Context) (*walletcore.StakeInfo,
 	}, nil
 }
 
+func (c *WalletRPCClient) Tickets(ctx context.Context, filter *walletcore.TicketFilter) ([]*walletcore.Ticket, error) {
+	ticketsStream, err := c.walletService.GetTickets(ctx, &walletrpc.GetTicketsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting tickets: %s", err.Error())
+	}
+
+	var tickets []*walletcore.Ticket
+	for {
+		ticketInfo, err := ticketsStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting tickets: %s", err.Error())
+		}
+
+		status := ticketInfo.Ticket.TicketStatus.String()
+		if !filter.Matches(status) {
+			continue
+		}
+
+		ticket, err := ticketFromDetails(ticketInfo, status)
+		if err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	return tickets, nil
+}
+
+func ticketFromDetails(ticketInfo *walletrpc.GetTicketsResponse, status string) (*walletcore.Ticket, error) {
+	ticketHash, err := chainhash.NewHash(ticketInfo.Ticket.Ticket.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket := &walletcore.Ticket{
+		Hash:           ticketHash.String(),
+		PurchaseHeight: -1,
+		Status:         status,
+	}
+	if ticketInfo.Block != nil {
+		ticket.PurchaseHeight = ticketInfo.Block.Height
+	}
+
+	// the first output of a ticket purchase is the stake submission, its amount is the ticket price
+	for _, credit := range ticketInfo.Ticket.Ticket.Credits {
+		if credit.Index == 0 {
+			ticket.Price = dcrutil.Amount(credit.Amount)
+		}
+	}
+
+	spender := ticketInfo.Ticket.Spender
+	if spender != nil {
+		spenderHash, err := chainhash.NewHash(spender.Hash)
+		if err != nil {
+			return nil, err
+		}
+		ticket.SpenderHash = spenderHash.String()
+
+		for _, credit := range spender.Credits {
+			ticket.Reward += dcrutil.Amount(credit.Amount)
+		}
+		for _, debit := range spender.Debits {
+			ticket.Reward -= dcrutil.Amount(debit.PreviousAmount)
+		}
+	}
+
+	return ticket, nil
+}
+
+func (c *WalletRPCClient) RevokeTickets(ctx context.Context, passphrase string) error {
+	if c.watchingOnly {
+		return walletcore.ErrWatchingOnlyWallet
+	}
+
+	_, err := c.walletService.RevokeTickets(ctx, &walletrpc.RevokeTicketsRequest{
+		Passphrase: []byte(passphrase),
+	})
+	if err != nil {
+		return fmt.Errorf("error revoking tickets: %s", err.Error())
+	}
+
+	return nil
+}
+
 func (c *WalletRPCClient) TicketPrice(ctx context.Context) (int64, error) {
 	ticketPrice, err := c.walletService.TicketPrice(ctx, &walletrpc.TicketPriceRequest{})
 	if err != nil {
@@ -420,6 +610,9 @@ func (c *WalletRPCClient) TicketPrice(ctx context.Context) (int64, error) {
 }
 
 func (c *WalletRPCClient) PurchaseTicket(ctx context.Context, request dcrlibwallet.PurchaseTicketsRequest) ([]string, error) {
+	if c.watchingOnly {
+		return nil, walletcore.ErrWatchingOnlyWallet
+	}
 	ticketPrice, err := c.TicketPrice(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not determine ticket price: %s", err.Error())
@@ -471,6 +664,9 @@ func (c *WalletRPCClient) PurchaseTicket(ctx context.Context, request dcrlibwall
 }
 
 func (c *WalletRPCClient) ChangePrivatePassphrase(ctx context.Context, oldPass, newPass string) error {
+	if c.watchingOnly {
+		return walletcore.ErrWatchingOnlyWallet
+	}
 	if oldPass == "" || newPass == "" {
 		return errors.New("Passphrase cannot be empty")
 	}
@@ -483,6 +679,17 @@ func (c *WalletRPCClient) ChangePrivatePassphrase(ctx context.Context, oldPass,
 	return err
 }
 
+func (c *WalletRPCClient) SeedBackupVerified() bool {
+	return c.watchingOnly || c.seedBackup.Verified()
+}
+
+func (c *WalletRPCClient) VerifySeedBackup(seed string) error {
+	if c.watchingOnly {
+		return walletcore.ErrWatchingOnlyWallet
+	}
+	return walletcore.VerifySeedBackup(c, c.seedBackup, c.activeNet.Params, seed)
+}
+
 func (c *WalletRPCClient) NetType() string {
 	return c.activeNet.Name
 }
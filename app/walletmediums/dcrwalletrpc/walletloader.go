@@ -4,13 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"path/filepath"
+
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/decred/dcrd/hdkeychain"
 	"github.com/decred/dcrwallet/rpc/walletrpc"
 	"github.com/decred/dcrwallet/walletseed"
 	"github.com/raedahgroup/dcrlibwallet"
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/godcr/app/events"
 	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/app/watchingonly"
 )
 
 func (c *WalletRPCClient) GenerateNewWalletSeed() (string, error) {
@@ -41,12 +46,43 @@ func (c *WalletRPCClient) CreateWallet(passphrase, seed string) error {
 		Seed:              seedBytes,
 	})
 
+	if err != nil {
+		return err
+	}
+
 	// wallet will be opened if the create operation was successful
-	if err == nil {
-		c.walletOpen = true
+	c.walletOpen = true
+	c.activeNet, _ = getNetParam(c.walletService)
+
+	walletDataDir, err := c.walletDataDir()
+	if err != nil {
+		return err
+	}
+	if err = c.loadWalletStores(walletDataDir); err != nil {
+		return err
+	}
+	return c.seedBackup.RecordSeed(seed)
+}
+
+func (c *WalletRPCClient) CreateWatchingOnlyWallet(extendedPublicKey string) error {
+	_, err := c.walletLoader.CreateWatchingOnlyWallet(context.Background(), &walletrpc.CreateWatchingOnlyWalletRequest{
+		ExtendedPubKey: extendedPublicKey,
+	})
+
+	if err != nil {
+		return err
 	}
 
-	return err
+	// wallet will be opened if the create operation was successful
+	c.walletOpen = true
+	c.watchingOnly = true
+	c.activeNet, _ = getNetParam(c.walletService)
+
+	walletDataDir, err := c.walletDataDir()
+	if err != nil {
+		return err
+	}
+	return watchingonly.Save(filepath.Join(walletDataDir, watchingonly.FileName), true)
 }
 
 func (c *WalletRPCClient) IsWalletOpen() bool {
@@ -55,6 +91,10 @@ func (c *WalletRPCClient) IsWalletOpen() bool {
 	return c.walletOpen
 }
 
+func (c *WalletRPCClient) IsWatchingOnlyWallet() bool {
+	return c.watchingOnly
+}
+
 func (c *WalletRPCClient) SyncBlockChain(showLog bool, syncProgressUpdated func(*defaultsynclistener.ProgressReport)) {
 	ctx := context.Background() // todo use a cancelable ctx
 
@@ -77,26 +117,45 @@ func (c *WalletRPCClient) SyncBlockChain(showLog bool, syncProgressUpdated func(
 	// c.syncListener listens for reported sync updates, calculates progress and updates the caller via syncProgressUpdated
 	if c.syncListener == nil {
 		// use syncProgressUpdatedWrapper to suppress op parameter that's not needed by callers
+		// and to publish the progress to subscribers of the wallet's events
 		syncProgressUpdatedWrapper := func(progressReport *defaultsynclistener.ProgressReport, _ defaultsynclistener.SyncOp) {
 			syncProgressUpdated(progressReport)
+			c.events.Publish(syncProgressEvent(progressReport))
 		}
 		c.syncListener = defaultsynclistener.DefaultSyncProgressListener(c.NetType(), showLog, getBestBlock, getBestBlockTimestamp,
 			syncProgressUpdatedWrapper)
 	}
 
+	c.syncShowLog = showLog
+	if err := c.spvSync(ctx, showLog); err != nil {
+		c.syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
+	}
+}
+
+// spvSync starts spv sync on dcrwallet and reports sync updates to c.syncListener.
+// It is also used to restart sync after the connection to dcrwallet is restored, a previous sync stream is canceled.
+func (c *WalletRPCClient) spvSync(ctx context.Context, showLog bool) error {
+	ctx, sub := c.subscribe(ctx, &c.spvSyncUpdates)
+
 	syncStream, err := c.walletLoader.SpvSync(ctx, &walletrpc.SpvSyncRequest{})
 	if err != nil {
-		c.syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
-		return
+		sub.end()
+		return err
 	}
 
 	// read sync updates from syncStream in go routine and trigger c.syncListener methods to calculate progress and update caller
 	go func() {
 		for {
 			syncUpdate, err := syncStream.Recv()
+			if ctx.Err() != nil {
+				// the sync stream was canceled, it is replaced by a new stream or the app is shutting down
+				return
+			}
 			if err != nil {
+				sub.end()
 				c.syncListener.OnSyncError(dcrlibwallet.ErrorCodeUnexpectedError, err)
 				c.syncListener.OnSynced(false)
+				c.reportConnectionLost()
 				return
 			}
 			if syncUpdate.Synced {
@@ -142,41 +201,51 @@ func (c *WalletRPCClient) SyncBlockChain(showLog bool, syncProgressUpdated func(
 			}
 		}
 	}()
+
+	return nil
 }
 
-func (c *WalletRPCClient) RescanBlockChain() error {
+func (c *WalletRPCClient) RescanBlockChain(ctx context.Context, rescanProgressUpdated func(walletcore.RescanProgress)) error {
 	if c.syncListener == nil {
 		return fmt.Errorf("blockchain has not been synced previously")
 	}
 
-	rescanStream, err := c.walletService.Rescan(context.Background(), &walletrpc.RescanRequest{BeginHeight: 0})
+	bestBlock, err := c.BestBlock()
 	if err != nil {
 		return err
 	}
 
-	// notify rescan start
-	c.syncListener.OnRescan(0, dcrlibwallet.SyncStateStart)
-
-	// read sync updates from rescanStream in goroutine and trigger c.syncListener methods to calculate progress and update caller
-	go func() {
-		for {
-			rescanResponse, err := rescanStream.Recv()
-			if err != nil {
-				c.syncListener.OnRescan(0, dcrlibwallet.SyncStateFinish)
-				return
-			}
+	// dcrwallet stops the rescan when the stream's context is canceled
+	rescanStream, err := c.walletService.Rescan(ctx, &walletrpc.RescanRequest{BeginHeight: 0})
+	if err != nil {
+		return err
+	}
 
-			// notify rescan progress
-			c.syncListener.OnRescan(rescanResponse.RescannedThrough, dcrlibwallet.SyncStateProgress)
+	progress := walletcore.RescanProgress{TotalHeight: int32(bestBlock)}
+	rescanProgressUpdated(progress)
 
-			bestBlock, err := c.BestBlock()
-			if err == nil && rescanResponse.RescannedThrough >= int32(bestBlock) {
-				c.syncListener.OnRescan(0, dcrlibwallet.SyncStateFinish)
-				return
+	for {
+		rescanResponse, err := rescanStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
 			}
+			return fmt.Errorf("rescan failed: %s", err.Error())
 		}
-	}()
 
+		progress.CurrentHeight = rescanResponse.RescannedThrough
+		if progress.CurrentHeight >= progress.TotalHeight {
+			break
+		}
+		rescanProgressUpdated(progress)
+	}
+
+	progress.CurrentHeight = progress.TotalHeight
+	progress.Done = true
+	rescanProgressUpdated(progress)
 	return nil
 }
 
@@ -203,6 +272,9 @@ func (c *WalletRPCClient) WalletConnectionInfo() (info walletcore.ConnectionInfo
 	info.LatestBlock = bestBlock
 	info.NetworkType = c.NetType()
 	info.PeersConnected = c.numberOfPeers
+	info.ConnectionState = c.ConnectionState()
+	info.SyncMode = walletcore.SyncModeDcrwallet
+	info.SyncSource = fmt.Sprintf("dcrwallet at %s", c.rpcAddress)
 
 	return
 }
@@ -233,3 +305,16 @@ func (c *WalletRPCClient) CloseWallet() {
 func (c *WalletRPCClient) DeleteWallet() error {
 	return errors.New("wallet cannot be deleted when connecting via dcrwallet rpc")
 }
+
+func syncProgressEvent(progressReport *defaultsynclistener.ProgressReport) events.Event {
+	report := progressReport.Read()
+	return events.Event{
+		Type: events.SyncProgress,
+		Data: events.SyncProgressData{
+			Percentage:     report.TotalSyncProgress,
+			ConnectedPeers: report.ConnectedPeers,
+			Done:           report.Done,
+			Error:          report.Error,
+		},
+	}
+}
@@ -0,0 +1,314 @@
+package mockwallet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/hdkeychain"
+	"github.com/decred/dcrwallet/netparams"
+	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/dcrlibwallet/txindex"
+	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/lockedoutputs"
+	"github.com/raedahgroup/godcr/app/seedbackup"
+	"github.com/raedahgroup/godcr/app/txlabels"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+const (
+	// PrivatePassphrase is the spending passphrase of the sample wallet loaded by Connect.
+	PrivatePassphrase = "mockwallet"
+
+	// default name given to account 0, same as in dcrwallet
+	defaultAccountName = "default"
+
+	// address branches, same as in dcrwallet
+	externalBranch uint32 = 0
+	internalBranch uint32 = 1
+)
+
+// MockWallet implements `WalletMiddleware` using an in-memory wallet as medium.
+// The wallet is pre-populated with sample accounts, transactions and tickets
+// so that godcr interfaces can be used without a wallet database or a connection to the decred network.
+// Functions relating to operations that can be performed on a wallet are defined in `walletfunctions.go`
+// Other wallet-related functions are defined in `walletloader.go`
+type MockWallet struct {
+	activeNet *netparams.Params
+
+	mu                sync.RWMutex
+	walletCreated     bool
+	walletOpen        bool
+	watchingOnly      bool
+	privatePassphrase string
+	coinTypeKey       *hdkeychain.ExtendedKey
+	accounts          []*account
+	addresses         map[string]*walletAddress
+	utxos             map[string]*unspentOutput
+	tickets           []*ticket
+	lockedOutputs     *lockedoutputs.Store
+	txLabels          *txlabels.Store
+	seedBackup        *seedbackup.Store
+	bestBlock         int32
+	bestBlockTime     int64
+	numberOfPeers     int32
+	synced            bool
+	syncListener      *defaultsynclistener.DefaultSyncListener
+	shutdown          chan struct{}
+	events            *events.Bus
+
+	externalKey          *hdkeychain.ExtendedKey
+	externalAddressIndex uint32
+
+	// transactions are saved to a tx index db in a temporary directory
+	// so that history can be read using txindex.ReadFilter, just like with the other mediums.
+	txIndexDir string
+	txIndexDB  *txindex.DB
+}
+
+type account struct {
+	name                string
+	number              uint32
+	key                 *hdkeychain.ExtendedKey
+	nextExternalIndex   uint32
+	nextInternalIndex   uint32
+	lastReturnedAddress string
+}
+
+type walletAddress struct {
+	address string
+	account uint32
+	branch  uint32
+	index   uint32
+	used    bool
+}
+
+// Connect creates an in-memory wallet for the specified network and loads it with sample data.
+func Connect(networkType string) (*MockWallet, error) {
+	activeNet := utils.NetParams(networkType)
+	if activeNet == nil {
+		return nil, fmt.Errorf("unsupported wallet: %s", networkType)
+	}
+
+	txIndexDir, err := ioutil.TempDir("", "godcr-mockwallet")
+	if err != nil {
+		return nil, fmt.Errorf("error creating mock wallet tx index directory: %s", err.Error())
+	}
+
+	// the mock wallet is not saved to disk, neither are its locked outputs, tx labels and seed backup status
+	lockedOutputs, _ := lockedoutputs.Load("")
+	txLabels, _ := txlabels.Load("")
+	seedBackup, _ := seedbackup.Load("")
+
+	mock := &MockWallet{
+		activeNet:     activeNet,
+		lockedOutputs: lockedOutputs,
+		txLabels:      txLabels,
+		seedBackup:    seedBackup,
+		txIndexDir:    txIndexDir,
+		events:        events.NewBus(),
+	}
+
+	// keys for external addresses are derived from a fixed seed so the sample data is the same on every run
+	mock.externalKey, err = hdkeychain.NewMaster(chainhash.HashB([]byte("godcr mockwallet external")), activeNet.Params)
+	if err != nil {
+		os.RemoveAll(txIndexDir)
+		return nil, fmt.Errorf("error creating mock wallet: %s", err.Error())
+	}
+
+	err = mock.initialize(chainhash.HashB([]byte("godcr mockwallet")), PrivatePassphrase)
+	if err == nil {
+		err = mock.createSampleData()
+	}
+	if err != nil {
+		mock.closeTxIndexDB()
+		os.RemoveAll(txIndexDir)
+		return nil, fmt.Errorf("error creating mock wallet: %s", err.Error())
+	}
+
+	return mock, nil
+}
+
+// initialize discards any previous wallet data and sets up an empty, open wallet
+// whose keys are derived from `seed`. Callers must hold mock.mu if the wallet is in use.
+func (mock *MockWallet) initialize(seed []byte, passphrase string) error {
+	masterKey, err := hdkeychain.NewMaster(seed, mock.activeNet.Params)
+	if err != nil {
+		return err
+	}
+
+	purposeKey, err := masterKey.Child(hdkeychain.HardenedKeyStart + 44)
+	if err != nil {
+		return err
+	}
+	mock.coinTypeKey, err = purposeKey.Child(hdkeychain.HardenedKeyStart + mock.activeNet.SLIP0044CoinType)
+	if err != nil {
+		return err
+	}
+	defaultAccountKey, err := mock.coinTypeKey.Child(hdkeychain.HardenedKeyStart)
+	if err != nil {
+		return fmt.Errorf("error deriving account key: %s", err.Error())
+	}
+
+	mock.watchingOnly = false
+	return mock.reset(defaultAccountKey, passphrase)
+}
+
+// initializeWatchingOnly discards any previous wallet data and sets up an empty, open wallet
+// that derives addresses from the public key of the default account. Callers must hold mock.mu if the wallet is in use.
+func (mock *MockWallet) initializeWatchingOnly(defaultAccountKey *hdkeychain.ExtendedKey) error {
+	mock.coinTypeKey = nil
+	mock.watchingOnly = true
+	return mock.reset(defaultAccountKey, "")
+}
+
+// reset clears all wallet data, leaving only the default account which derives addresses from `defaultAccountKey`.
+func (mock *MockWallet) reset(defaultAccountKey *hdkeychain.ExtendedKey, passphrase string) (err error) {
+	mock.closeTxIndexDB()
+	txIndexDbPath := filepath.Join(mock.txIndexDir, fmt.Sprintf("%d", time.Now().UnixNano()), txindex.DbName)
+	os.MkdirAll(filepath.Dir(txIndexDbPath), os.ModePerm)
+
+	mock.accounts = []*account{{
+		name:   defaultAccountName,
+		number: 0,
+		key:    defaultAccountKey,
+	}}
+	mock.addresses = make(map[string]*walletAddress)
+	mock.utxos = make(map[string]*unspentOutput)
+	mock.tickets = nil
+	mock.privatePassphrase = passphrase
+	mock.walletCreated = true
+
+	// the shutdown channel of a closed wallet is closed, a wallet that is (re)opened needs a new one
+	if !mock.walletOpen {
+		mock.shutdown = make(chan struct{})
+	}
+	mock.walletOpen = true
+
+	generateWalletAddress := func() (string, error) {
+		return mock.deriveAddress(mock.accounts[0], externalBranch)
+	}
+	addressMatchesWallet := func(address string) (bool, error) {
+		_, isMine := mock.addresses[address]
+		return isMine, nil
+	}
+
+	mock.txIndexDB, err = txindex.Initialize(txIndexDbPath, generateWalletAddress, addressMatchesWallet)
+	if err != nil {
+		return fmt.Errorf("tx index db initialization failed: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (mock *MockWallet) closeTxIndexDB() {
+	if mock.txIndexDB != nil {
+		mock.txIndexDB.Close()
+		mock.txIndexDB = nil
+	}
+}
+
+func (mock *MockWallet) addAccount(name string) (*account, error) {
+	accountNumber := uint32(len(mock.accounts))
+	accountKey, err := mock.coinTypeKey.Child(hdkeychain.HardenedKeyStart + accountNumber)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving account key: %s", err.Error())
+	}
+
+	acc := &account{
+		name:   name,
+		number: accountNumber,
+		key:    accountKey,
+	}
+	mock.accounts = append(mock.accounts, acc)
+	return acc, nil
+}
+
+func (mock *MockWallet) accountByNumber(accountNumber uint32) (*account, error) {
+	if accountNumber >= uint32(len(mock.accounts)) {
+		return nil, fmt.Errorf("account not found: %d", accountNumber)
+	}
+	return mock.accounts[accountNumber], nil
+}
+
+// deriveAddress derives the next address in the specified branch of an account and marks it as a wallet address.
+func (mock *MockWallet) deriveAddress(acc *account, branch uint32) (string, error) {
+	index := acc.nextExternalIndex
+	if branch == internalBranch {
+		index = acc.nextInternalIndex
+	}
+
+	addressKey, err := mock.addressKey(acc, branch, index)
+	if err != nil {
+		return "", err
+	}
+	address, err := addressKey.Address(mock.activeNet.Params)
+	if err != nil {
+		return "", fmt.Errorf("error generating address: %s", err.Error())
+	}
+
+	if branch == internalBranch {
+		acc.nextInternalIndex++
+	} else {
+		acc.nextExternalIndex++
+	}
+
+	encodedAddress := address.EncodeAddress()
+	mock.addresses[encodedAddress] = &walletAddress{
+		address: encodedAddress,
+		account: acc.number,
+		branch:  branch,
+		index:   index,
+	}
+	return encodedAddress, nil
+}
+
+func (mock *MockWallet) addressKey(acc *account, branch, index uint32) (*hdkeychain.ExtendedKey, error) {
+	branchKey, err := acc.key.Child(branch)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving address key: %s", err.Error())
+	}
+	addressKey, err := branchKey.Child(index)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving address key: %s", err.Error())
+	}
+	return addressKey, nil
+}
+
+// externalAddress returns an address that does not belong to this wallet.
+func (mock *MockWallet) externalAddress() (string, error) {
+	addressKey, err := mock.externalKey.Child(mock.externalAddressIndex)
+	if err != nil {
+		return "", err
+	}
+	mock.externalAddressIndex++
+
+	address, err := addressKey.Address(mock.activeNet.Params)
+	if err != nil {
+		return "", err
+	}
+	return address.EncodeAddress(), nil
+}
+
+// blockTimestamp estimates the time a block was mined using the network's target time per block.
+func (mock *MockWallet) blockTimestamp(blockHeight int32) int64 {
+	secondsPerBlock := int64(mock.activeNet.TargetTimePerBlock / time.Second)
+	return mock.bestBlockTime - int64(mock.bestBlock-blockHeight)*secondsPerBlock
+}
+
+// checkPassphrase is called before any operation that uses the wallet's private keys,
+// so it also rejects such operations for watching-only wallets.
+func (mock *MockWallet) checkPassphrase(passphrase string) error {
+	if mock.watchingOnly {
+		return walletcore.ErrWatchingOnlyWallet
+	}
+	if passphrase != mock.privatePassphrase {
+		return fmt.Errorf("invalid passphrase")
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package mockwallet
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// number of blocks the sample wallet is behind the simulated network until SyncBlockChain is called
+const unsyncedBlocks = 150
+
+type scheduledStakeTx struct {
+	blockHeight int32
+	ticket      *ticket
+	revoke      bool
+}
+
+// createSampleData adds accounts to the wallet and records transactions and tickets
+// spread over the blocks leading to the wallet's best block.
+// A fixed random source is used so that the same sample data is created on every run.
+func (mock *MockWallet) createSampleData() error {
+	// estimate the current height of the network from its genesis block time and target time per block
+	genesisTime := mock.activeNet.GenesisBlock.Header.Timestamp
+	networkHeight := int32(time.Since(genesisTime) / mock.activeNet.TargetTimePerBlock)
+	mock.bestBlock = networkHeight - unsyncedBlocks
+	mock.bestBlockTime = time.Now().Add(-unsyncedBlocks * mock.activeNet.TargetTimePerBlock).Unix()
+
+	defaultAccount := mock.accounts[0]
+	savingsAccount, err := mock.addAccount("savings")
+	if err != nil {
+		return err
+	}
+	spendingAccount, err := mock.addAccount("spending")
+	if err != nil {
+		return err
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	randomAmount := func(minCoins, maxCoins int64) int64 {
+		return (minCoins+rng.Int63n(maxCoins-minCoins))*dcrutil.AtomsPerCoin + rng.Int63n(dcrutil.AtomsPerCoin)
+	}
+
+	ticketMaturity := int32(mock.activeNet.TicketMaturity)
+	historyBlocks := 6*ticketMaturity + 300
+	blockHeight := mock.bestBlock - historyBlocks
+
+	// votes and revocations are recorded once the sample history gets to the block they're scheduled for
+	var scheduledStakeTxs []*scheduledStakeTx
+	advance := func(blocks int32) error {
+		blockHeight += blocks
+		sort.Slice(scheduledStakeTxs, func(i, j int) bool {
+			return scheduledStakeTxs[i].blockHeight < scheduledStakeTxs[j].blockHeight
+		})
+		for len(scheduledStakeTxs) > 0 && scheduledStakeTxs[0].blockHeight <= blockHeight {
+			stakeTx := scheduledStakeTxs[0]
+			scheduledStakeTxs = scheduledStakeTxs[1:]
+
+			var err error
+			if stakeTx.revoke {
+				err = mock.revokeTicket(stakeTx.ticket, stakeTx.blockHeight)
+			} else {
+				err = mock.voteOnTicket(stakeTx.ticket, stakeTx.blockHeight)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	buyTicket := func(acc *account, blocksToVote int32) error {
+		t, err := mock.purchaseTicket(acc, 0, walletcore.DefaultFeeRate, blockHeight, mock.blockTimestamp(blockHeight))
+		if err != nil {
+			return err
+		}
+		if blocksToVote > 0 {
+			scheduledStakeTxs = append(scheduledStakeTxs, &scheduledStakeTx{
+				blockHeight: blockHeight + ticketMaturity + blocksToVote,
+				ticket:      t,
+			})
+		}
+		return nil
+	}
+	receive := func(acc *account, amount int64) error {
+		_, err := mock.receiveFromExternalWallet(acc, amount, blockHeight, mock.blockTimestamp(blockHeight))
+		return err
+	}
+	send := func(acc *account, address string, amount int64) error {
+		_, err := mock.sendFromAccount(acc, 0, []txhelper.TransactionDestination{{
+			Address: address,
+			Amount:  dcrutil.Amount(amount).ToCoin(),
+		}}, walletcore.DefaultFeeRate, walletcore.DefaultCoinSelection, blockHeight, mock.blockTimestamp(blockHeight))
+		return err
+	}
+
+	// fund accounts
+	if err = receive(defaultAccount, randomAmount(150, 200)); err != nil {
+		return err
+	}
+	if err = receive(savingsAccount, randomAmount(320, 350)); err != nil {
+		return err
+	}
+	if err = advance(2); err != nil {
+		return err
+	}
+	if err = receive(spendingAccount, randomAmount(40, 60)); err != nil {
+		return err
+	}
+
+	// buy tickets that get voted on, and one that gets missed and is later revoked
+	if err = advance(5); err != nil {
+		return err
+	}
+	if err = buyTicket(savingsAccount, 1+rng.Int31n(ticketMaturity*2)); err != nil {
+		return err
+	}
+	if err = advance(3); err != nil {
+		return err
+	}
+	if err = buyTicket(savingsAccount, 1+rng.Int31n(ticketMaturity*2)); err != nil {
+		return err
+	}
+	if err = advance(4); err != nil {
+		return err
+	}
+	missedTicket, err := mock.purchaseTicket(defaultAccount, 0, walletcore.DefaultFeeRate, blockHeight, mock.blockTimestamp(blockHeight))
+	if err != nil {
+		return err
+	}
+	missedTicket.missed = true
+	scheduledStakeTxs = append(scheduledStakeTxs, &scheduledStakeTx{
+		blockHeight: blockHeight + ticketMaturity + ticketMaturity/2,
+		ticket:      missedTicket,
+		revoke:      true,
+	})
+
+	// random regular transactions, with the occasional ticket purchase
+	blocksPerEvent := historyBlocks / 40
+	for i := 0; i < 30; i++ {
+		if err = advance(1 + rng.Int31n(blocksPerEvent)); err != nil {
+			return err
+		}
+
+		externalAddress, err := mock.externalAddress()
+		if err != nil {
+			return err
+		}
+
+		switch event := rng.Intn(10); {
+		case event < 4:
+			err = receive(mock.accounts[rng.Intn(len(mock.accounts))], randomAmount(1, 40))
+		case event < 7:
+			sourceAccount := defaultAccount
+			if event == 6 {
+				sourceAccount = spendingAccount
+			}
+			err = send(sourceAccount, externalAddress, randomAmount(1, 12))
+		case event < 9:
+			var destinationAddress string
+			destinationAddress, err = mock.deriveAddress(mock.accounts[1+rng.Intn(2)], externalBranch)
+			if err == nil {
+				err = send(defaultAccount, destinationAddress, randomAmount(2, 15))
+			}
+		default:
+			err = buyTicket(defaultAccount, 1+rng.Int31n(ticketMaturity*2))
+		}
+
+		// random transactions may fail if the source account is low on funds, that's okay
+		if err != nil && !strings.HasPrefix(err.Error(), errInsufficientFunds.Error()) {
+			return err
+		}
+	}
+
+	// live tickets that have not been called to vote
+	if err = advance(mock.bestBlock - ticketMaturity - 20 - blockHeight); err != nil {
+		return err
+	}
+	if err = buyTicket(savingsAccount, 0); err != nil {
+		return err
+	}
+	if err = buyTicket(savingsAccount, 0); err != nil {
+		return err
+	}
+
+	// an immature ticket
+	if err = advance(mock.bestBlock - ticketMaturity/2 - blockHeight); err != nil {
+		return err
+	}
+	if err = buyTicket(defaultAccount, 0); err != nil {
+		return err
+	}
+
+	// a transaction in the best block and an unmined transaction
+	if err = advance(mock.bestBlock - blockHeight); err != nil {
+		return err
+	}
+	if err = receive(spendingAccount, randomAmount(5, 20)); err != nil {
+		return err
+	}
+	_, err = mock.receiveFromExternalWallet(defaultAccount, randomAmount(1, 10), -1, mock.bestBlockTime)
+	return err
+}
@@ -0,0 +1,727 @@
+package mockwallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/decred/dcrd/blockchain/stake"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/txscript"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet/txrules"
+	"github.com/raedahgroup/dcrlibwallet/addresshelper"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+const (
+	// price of tickets and reward for votes, these do not change on the simulated network
+	ticketPrice int64 = 95 * dcrutil.AtomsPerCoin
+	voteSubsidy int64 = 1.6 * dcrutil.AtomsPerCoin
+
+	// fee limits set on ticket commitment outputs, same value used by dcrwallet
+	ticketFeeLimits uint16 = 0x5800
+
+	// size of a p2pkh output script
+	p2pkhPkScriptSize = 25
+)
+
+var errInsufficientFunds = errors.New("insufficient funds")
+
+type unspentOutput struct {
+	txHash      string
+	index       uint32
+	tree        int8
+	account     uint32
+	amount      int64
+	address     string
+	pkScript    []byte
+	blockHeight int32
+	receiveTime int64
+}
+
+func (utxo *unspentOutput) key() string {
+	return fmt.Sprintf("%s:%d", utxo.txHash, utxo.index)
+}
+
+type ticket struct {
+	hash          string
+	account       uint32
+	price         int64
+	rewardAddress string
+	blockHeight   int32
+	missed        bool
+	spenderHash   string
+	spenderType   string
+	reward        int64
+}
+
+// spendableOutputs returns the unspent outputs in an account that have at least `requiredConfirmations`,
+// largest amounts first.
+func (mock *MockWallet) spendableOutputs(accountNumber uint32, requiredConfirmations int32) []*unspentOutput {
+	var utxos []*unspentOutput
+	for _, utxo := range mock.utxos {
+		if utxo.account != accountNumber {
+			continue
+		}
+		if txhelper.TxConfirmations(utxo.blockHeight, mock.bestBlock) < requiredConfirmations {
+			continue
+		}
+		utxos = append(utxos, utxo)
+	}
+
+	sort.Slice(utxos, func(i, j int) bool {
+		if utxos[i].amount == utxos[j].amount {
+			return utxos[i].key() < utxos[j].key()
+		}
+		return utxos[i].amount > utxos[j].amount
+	})
+	return utxos
+}
+
+// selectInputs uses the `coinSelection` strategy to pick unlocked unspent outputs from an account that can pay `destinations`
+// and the tx fee at `feeRate` atoms/kB. All unlocked spendable outputs are selected if any destination is set to receive max amount.
+func (mock *MockWallet) selectInputs(accountNumber uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination,
+	feeRate int64, coinSelection string) ([]*unspentOutput, error) {
+	spendableOutputs := mock.spendableOutputs(accountNumber, requiredConfirmations)
+	utxos := make([]*walletcore.UnspentOutput, len(spendableOutputs))
+	for i, utxo := range spendableOutputs {
+		utxos[i] = mock.walletUnspentOutput(utxo)
+	}
+	mock.lockedOutputs.MarkLocked(utxos)
+
+	selectedUtxos, _, err := walletcore.SelectCoins(coinSelection, utxos, destinations, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	if len(selectedUtxos) == 0 {
+		return nil, errInsufficientFunds
+	}
+
+	inputs := make([]*unspentOutput, len(selectedUtxos))
+	for i, utxo := range selectedUtxos {
+		inputs[i] = mock.utxos[utxo.OutputKey]
+	}
+	return inputs, nil
+}
+
+// walletUnspentOutput returns the details of `utxo` as a walletcore.UnspentOutput.
+func (mock *MockWallet) walletUnspentOutput(utxo *unspentOutput) *walletcore.UnspentOutput {
+	return &walletcore.UnspentOutput{
+		OutputKey:       utxo.key(),
+		TransactionHash: utxo.txHash,
+		OutputIndex:     utxo.index,
+		Tree:            int32(utxo.tree),
+		ReceiveTime:     utxo.receiveTime,
+		Amount:          dcrutil.Amount(utxo.amount),
+		Address:         utxo.address,
+		Confirmations:   txhelper.TxConfirmations(utxo.blockHeight, mock.bestBlock),
+	}
+}
+
+// estimateFee returns the fee required at `feeRate` atoms/kB by a signed transaction spending `nInputs` p2pkh outputs
+// to `outputs` and an optional change output.
+func estimateFee(nInputs int, outputs []*wire.TxOut, withChange bool, feeRate int64) int64 {
+	var changeScriptSize int
+	if withChange {
+		changeScriptSize = p2pkhPkScriptSize
+	}
+
+	return walletcore.EstimateFee(nInputs, outputs, changeScriptSize, feeRate)
+}
+
+func makeTxOutput(address string, amount int64) (*wire.TxOut, error) {
+	pkScript, err := addresshelper.PkScript(address)
+	if err != nil {
+		return nil, err
+	}
+	return wire.NewTxOut(amount, pkScript), nil
+}
+
+func makeTxOutputs(destinations []txhelper.TransactionDestination) (outputs []*wire.TxOut, sendMaxAddress string, err error) {
+	for _, destination := range destinations {
+		if destination.SendMax {
+			if sendMaxAddress != "" {
+				return nil, "", fmt.Errorf("cannot send max amount to more than one destination")
+			}
+			sendMaxAddress = destination.Address
+			continue
+		}
+
+		amount, err := dcrutil.NewAmount(destination.Amount)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid amount for %s: %s", destination.Address, err.Error())
+		}
+
+		output, err := makeTxOutput(destination.Address, int64(amount))
+		if err != nil {
+			return nil, "", err
+		}
+		outputs = append(outputs, output)
+	}
+	return
+}
+
+// createSpendTx creates and signs a transaction that spends `inputs` to the specified destinations.
+// If no change destinations are provided, any change is sent to a new internal address of the source account.
+func (mock *MockWallet) createSpendTx(sourceAccount *account, inputs []*unspentOutput,
+	destinations, changeDestinations []txhelper.TransactionDestination, feeRate int64) (*wire.MsgTx, error) {
+
+	msgTx, err := mock.createUnsignedSpendTx(sourceAccount, inputs, destinations, changeDestinations, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	return msgTx, mock.signInputs(msgTx, inputs)
+}
+
+// createUnsignedSpendTx is like createSpendTx but leaves the inputs of the transaction unsigned.
+func (mock *MockWallet) createUnsignedSpendTx(sourceAccount *account, inputs []*unspentOutput,
+	destinations, changeDestinations []txhelper.TransactionDestination, feeRate int64) (*wire.MsgTx, error) {
+
+	outputs, sendMaxAddress, err := makeTxOutputs(destinations)
+	if err != nil {
+		return nil, err
+	}
+	changeOutputs, _, err := makeTxOutputs(changeDestinations)
+	if err != nil {
+		return nil, err
+	}
+	outputs = append(outputs, changeOutputs...)
+
+	msgTx := wire.NewMsgTx()
+	var inputsTotal int64
+	for _, input := range inputs {
+		prevHash, err := chainhash.NewHashFromStr(input.txHash)
+		if err != nil {
+			return nil, err
+		}
+		msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, input.index, input.tree), input.amount, nil))
+		inputsTotal += input.amount
+	}
+
+	var outputsTotal int64
+	for _, output := range outputs {
+		outputsTotal += output.Value
+		msgTx.AddTxOut(output)
+	}
+
+	autoChange := sendMaxAddress != "" || len(changeDestinations) == 0
+	fee := estimateFee(len(inputs), outputs, autoChange, feeRate)
+	remainder := inputsTotal - outputsTotal - fee
+	if remainder < 0 {
+		return nil, fmt.Errorf("%s: need %s, available %s", errInsufficientFunds.Error(),
+			dcrutil.Amount(outputsTotal+fee), dcrutil.Amount(inputsTotal))
+	}
+
+	if sendMaxAddress != "" || (autoChange && !txrules.IsDustAmount(dcrutil.Amount(remainder), p2pkhPkScriptSize, dcrutil.Amount(feeRate))) {
+		remainderAddress := sendMaxAddress
+		if remainderAddress == "" {
+			remainderAddress, err = mock.deriveAddress(sourceAccount, internalBranch)
+			if err != nil {
+				return nil, err
+			}
+		}
+		remainderOutput, err := makeTxOutput(remainderAddress, remainder)
+		if err != nil {
+			return nil, err
+		}
+		msgTx.AddTxOut(remainderOutput)
+	}
+
+	return msgTx, nil
+}
+
+// signInputs signs each input in msgTx using the key of the wallet address that received the spent output.
+func (mock *MockWallet) signInputs(msgTx *wire.MsgTx, inputs []*unspentOutput) error {
+	for i, input := range inputs {
+		walletAddress, ok := mock.addresses[input.address]
+		if !ok {
+			return fmt.Errorf("no key for address %s", input.address)
+		}
+		acc, err := mock.accountByNumber(walletAddress.account)
+		if err != nil {
+			return err
+		}
+		addressKey, err := mock.addressKey(acc, walletAddress.branch, walletAddress.index)
+		if err != nil {
+			return err
+		}
+		privKey, err := addressKey.ECPrivKey()
+		if err != nil {
+			return err
+		}
+
+		msgTx.TxIn[i].SignatureScript, err = txscript.SignatureScript(msgTx, i, input.pkScript, txscript.SigHashAll, privKey, true)
+		if err != nil {
+			return fmt.Errorf("error signing input %d: %s", i, err.Error())
+		}
+	}
+	return nil
+}
+
+// verifyInputScript executes the signature script of input `index` against the script of the output it spends.
+func verifyInputScript(msgTx *wire.MsgTx, index int, pkScript []byte) error {
+	vm, err := txscript.NewEngine(pkScript, msgTx, index, 0, txscript.DefaultScriptVersion, nil)
+	if err == nil {
+		err = vm.Execute()
+	}
+	if err != nil {
+		return fmt.Errorf("invalid signature for input %d: %s", index, err.Error())
+	}
+	return nil
+}
+
+// recordTransaction indexes a transaction mined at `blockHeight` (-1 if unmined) and updates the wallet's
+// unspent outputs and tickets: outputs spent by the tx are removed and outputs paying to wallet addresses are added.
+func (mock *MockWallet) recordTransaction(msgTx *wire.MsgTx, blockHeight int32, timestamp int64) (*txhelper.Transaction, error) {
+	txBytes, err := msgTx.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("error serializing transaction: %s", err.Error())
+	}
+
+	txInfo := &txhelper.TxInfoFromWallet{
+		Hex:         hex.EncodeToString(txBytes),
+		Timestamp:   timestamp,
+		BlockHeight: blockHeight,
+	}
+
+	txHash := msgTx.TxHash().String()
+	txType := stake.DetermineTxType(msgTx)
+
+	var spentOutputs []string
+	for i, txIn := range msgTx.TxIn {
+		prevOutput := fmt.Sprintf("%s:%d", txIn.PreviousOutPoint.Hash.String(), txIn.PreviousOutPoint.Index)
+		if utxo, ok := mock.utxos[prevOutput]; ok {
+			txInfo.Inputs = append(txInfo.Inputs, mock.walletInput(i, utxo.amount, utxo.account))
+			spentOutputs = append(spentOutputs, prevOutput)
+			continue
+		}
+
+		for _, t := range mock.tickets {
+			if t.hash == txIn.PreviousOutPoint.Hash.String() {
+				txInfo.Inputs = append(txInfo.Inputs, mock.walletInput(i, t.price, t.account))
+				t.spenderHash = txHash
+				t.reward = -t.price
+				for _, txOut := range msgTx.TxOut {
+					t.reward += txOut.Value
+				}
+				if txType == stake.TxTypeSSGen {
+					t.spenderType = "vote"
+				} else {
+					t.spenderType = "revocation"
+				}
+			}
+		}
+	}
+
+	var newOutputs []*unspentOutput
+	for i, txOut := range msgTx.TxOut {
+		_, addresses, _, _ := txscript.ExtractPkScriptAddrs(txOut.Version, txOut.PkScript, mock.activeNet.Params)
+		if len(addresses) == 0 {
+			continue
+		}
+		walletAddress, isMine := mock.addresses[addresses[0].EncodeAddress()]
+		if !isMine {
+			continue
+		}
+		walletAddress.used = true
+
+		acc, err := mock.accountByNumber(walletAddress.account)
+		if err != nil {
+			return nil, err
+		}
+		txInfo.Outputs = append(txInfo.Outputs, &txhelper.WalletOutput{
+			Index:     int32(i),
+			AmountOut: txOut.Value,
+			Address:   walletAddress.address,
+			WalletAccount: &txhelper.WalletAccount{
+				AccountNumber: int32(acc.number),
+				AccountName:   acc.name,
+			},
+		})
+
+		switch txscript.GetScriptClass(txOut.Version, txOut.PkScript) {
+		case txscript.PubKeyHashTy, txscript.StakeGenTy, txscript.StakeRevocationTy:
+			if txOut.Value == 0 {
+				continue
+			}
+			tree := wire.TxTreeRegular
+			if txType != stake.TxTypeRegular {
+				tree = wire.TxTreeStake
+			}
+			newOutputs = append(newOutputs, &unspentOutput{
+				txHash:      txHash,
+				index:       uint32(i),
+				tree:        tree,
+				account:     acc.number,
+				amount:      txOut.Value,
+				address:     walletAddress.address,
+				pkScript:    txOut.PkScript,
+				blockHeight: blockHeight,
+				receiveTime: timestamp,
+			})
+		}
+	}
+
+	tx, err := txhelper.DecodeTransaction(txInfo, mock.activeNet.Params)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding transaction: %s", err.Error())
+	}
+
+	err = mock.txIndexDB.SaveOrUpdate(tx)
+	if err != nil {
+		return nil, fmt.Errorf("error indexing transaction: %s", err.Error())
+	}
+
+	for _, prevOutput := range spentOutputs {
+		delete(mock.utxos, prevOutput)
+	}
+	for _, utxo := range newOutputs {
+		mock.utxos[utxo.key()] = utxo
+	}
+
+	// like dcrwallet, notify subscribers of transactions that are not yet mined
+	if blockHeight == -1 {
+		mock.events.Publish(events.Event{Type: events.TxReceived, Data: txEventData(tx)})
+		mock.events.Publish(events.Event{Type: events.BalanceChanged})
+	}
+
+	return tx, nil
+}
+
+func txEventData(tx *txhelper.Transaction) events.Tx {
+	return events.Tx{
+		Hash:        tx.Hash,
+		Amount:      tx.Amount,
+		Fee:         tx.Fee,
+		Direction:   int32(tx.Direction),
+		BlockHeight: tx.BlockHeight,
+	}
+}
+
+func (mock *MockWallet) walletInput(index int, amount int64, accountNumber uint32) *txhelper.WalletInput {
+	var accountName string
+	if acc, err := mock.accountByNumber(accountNumber); err == nil {
+		accountName = acc.name
+	}
+
+	return &txhelper.WalletInput{
+		Index:    int32(index),
+		AmountIn: amount,
+		WalletAccount: &txhelper.WalletAccount{
+			AccountNumber: int32(accountNumber),
+			AccountName:   accountName,
+		},
+	}
+}
+
+// sendFromAccount creates, signs and records a transaction paying `destinations` with funds from an account.
+func (mock *MockWallet) sendFromAccount(sourceAccount *account, requiredConfirmations int32, destinations []txhelper.TransactionDestination,
+	feeRate int64, coinSelection string, blockHeight int32, timestamp int64) (*txhelper.Transaction, error) {
+
+	msgTx, inputs, err := mock.constructTransaction(sourceAccount, requiredConfirmations, destinations, feeRate, coinSelection)
+	if err != nil {
+		return nil, err
+	}
+	if err = mock.signInputs(msgTx, inputs); err != nil {
+		return nil, err
+	}
+
+	return mock.recordTransaction(msgTx, blockHeight, timestamp)
+}
+
+// constructTransaction creates an unsigned transaction paying `destinations` with funds from an account.
+// The unspent outputs spent by the transaction are also returned.
+func (mock *MockWallet) constructTransaction(sourceAccount *account, requiredConfirmations int32,
+	destinations []txhelper.TransactionDestination, feeRate int64, coinSelection string) (*wire.MsgTx, []*unspentOutput, error) {
+
+	inputs, err := mock.selectInputs(sourceAccount.number, requiredConfirmations, destinations, feeRate, coinSelection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msgTx, err := mock.createUnsignedSpendTx(sourceAccount, inputs, destinations, nil, feeRate)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msgTx, inputs, nil
+}
+
+// spentOutputs returns the wallet's unspent outputs that are spent by the inputs of msgTx, in the same order as the inputs.
+func (mock *MockWallet) spentOutputs(msgTx *wire.MsgTx) ([]*unspentOutput, error) {
+	inputs := make([]*unspentOutput, len(msgTx.TxIn))
+	for i, txIn := range msgTx.TxIn {
+		prevOutput := fmt.Sprintf("%s:%d", txIn.PreviousOutPoint.Hash.String(), txIn.PreviousOutPoint.Index)
+		utxo, ok := mock.utxos[prevOutput]
+		if !ok {
+			return nil, fmt.Errorf("input %d spends an output that is not an unspent output in this wallet: %s", i, prevOutput)
+		}
+		inputs[i] = utxo
+	}
+	return inputs, nil
+}
+
+// receiveFromExternalWallet records a transaction paying `amount` from an external wallet into an account.
+func (mock *MockWallet) receiveFromExternalWallet(acc *account, amount int64, blockHeight int32, timestamp int64) (*txhelper.Transaction, error) {
+	address, err := mock.deriveAddress(acc, externalBranch)
+	if err != nil {
+		return nil, err
+	}
+	walletOutput, err := makeTxOutput(address, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	externalChangeAddress, err := mock.externalAddress()
+	if err != nil {
+		return nil, err
+	}
+	externalChangeAmount := amount / 3
+	changeOutput, err := makeTxOutput(externalChangeAddress, externalChangeAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// the spent output is not known to this wallet, use a unique made-up outpoint
+	prevHash := chainhash.HashH([]byte(fmt.Sprintf("%s%d", externalChangeAddress, blockHeight)))
+	fee := estimateFee(1, []*wire.TxOut{walletOutput, changeOutput}, false, walletcore.DefaultFeeRate)
+
+	msgTx := wire.NewMsgTx()
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0, wire.TxTreeRegular), amount+externalChangeAmount+fee,
+		make([]byte, txhelper.RedeemP2PKHSigScriptSize)))
+	msgTx.AddTxOut(walletOutput)
+	msgTx.AddTxOut(changeOutput)
+
+	return mock.recordTransaction(msgTx, blockHeight, timestamp)
+}
+
+// purchaseTicket buys a ticket with funds from an account. Like dcrwallet, a split transaction is first
+// created to produce an output of the exact amount needed for the ticket, which is then spent by the ticket.
+// Both transactions pay fees at `feeRate` atoms/kB.
+func (mock *MockWallet) purchaseTicket(acc *account, requiredConfirmations int32, feeRate int64, blockHeight int32, timestamp int64) (*ticket, error) {
+	votingAddress, err := mock.deriveAddress(acc, internalBranch)
+	if err != nil {
+		return nil, err
+	}
+	rewardAddress, err := mock.deriveAddress(acc, internalBranch)
+	if err != nil {
+		return nil, err
+	}
+	decodedVotingAddress, err := addresshelper.DecodeForNetwork(votingAddress, mock.activeNet.Params)
+	if err != nil {
+		return nil, err
+	}
+	decodedRewardAddress, err := addresshelper.DecodeForNetwork(rewardAddress, mock.activeNet.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketScript, err := txscript.PayToSStx(decodedVotingAddress)
+	if err != nil {
+		return nil, err
+	}
+	changeScript, err := txscript.PayToSStxChange(decodedRewardAddress)
+	if err != nil {
+		return nil, err
+	}
+	// commitment script size does not depend on the committed amount, use 0 to estimate the ticket fee
+	commitmentScript, err := txscript.GenerateSStxAddrPush(decodedRewardAddress, 0, ticketFeeLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTx := wire.NewMsgTx()
+	msgTx.AddTxOut(wire.NewTxOut(ticketPrice, ticketScript))
+	msgTx.AddTxOut(wire.NewTxOut(0, commitmentScript))
+	msgTx.AddTxOut(wire.NewTxOut(0, changeScript))
+	ticketCost := ticketPrice + estimateFee(1, msgTx.TxOut, false, feeRate)
+
+	splitAddress, err := mock.deriveAddress(acc, internalBranch)
+	if err != nil {
+		return nil, err
+	}
+	splitTx, err := mock.sendFromAccount(acc, requiredConfirmations, []txhelper.TransactionDestination{{
+		Address: splitAddress,
+		Amount:  dcrutil.Amount(ticketCost).ToCoin(),
+	}}, feeRate, walletcore.DefaultCoinSelection, blockHeight, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	var splitOutput *unspentOutput
+	for _, utxo := range mock.utxos {
+		if utxo.txHash == splitTx.Hash && utxo.address == splitAddress {
+			splitOutput = utxo
+		}
+	}
+	if splitOutput == nil {
+		return nil, fmt.Errorf("error creating ticket split transaction")
+	}
+
+	msgTx.TxOut[1].PkScript, err = txscript.GenerateSStxAddrPush(decodedRewardAddress, dcrutil.Amount(splitOutput.amount),
+		ticketFeeLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	prevHash, err := chainhash.NewHashFromStr(splitOutput.txHash)
+	if err != nil {
+		return nil, err
+	}
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, splitOutput.index, splitOutput.tree), splitOutput.amount, nil))
+
+	err = mock.signInputs(msgTx, []*unspentOutput{splitOutput})
+	if err != nil {
+		return nil, err
+	}
+
+	t := &ticket{
+		hash:          msgTx.TxHash().String(),
+		account:       acc.number,
+		price:         ticketPrice,
+		rewardAddress: rewardAddress,
+		blockHeight:   blockHeight,
+	}
+	mock.tickets = append(mock.tickets, t)
+
+	_, err = mock.recordTransaction(msgTx, blockHeight, timestamp)
+	if err != nil {
+		mock.tickets = mock.tickets[:len(mock.tickets)-1]
+		return nil, err
+	}
+	return t, nil
+}
+
+// voteOnTicket records a vote that spends `t` in the block at `blockHeight`.
+func (mock *MockWallet) voteOnTicket(t *ticket, blockHeight int32) error {
+	rewardAddress, err := addresshelper.DecodeForNetwork(t.rewardAddress, mock.activeNet.Params)
+	if err != nil {
+		return err
+	}
+	rewardScript, err := txscript.PayToSSGen(rewardAddress)
+	if err != nil {
+		return err
+	}
+
+	// votes reference the previous block, make up its hash
+	blockHash := chainhash.HashH([]byte(fmt.Sprintf("block%d", blockHeight-1)))
+	blockRefScript, err := txscript.GenerateSSGenBlockRef(blockHash, uint32(blockHeight-1))
+	if err != nil {
+		return err
+	}
+	votesScript, err := txscript.GenerateSSGenVotes(txhelper.BlockValid)
+	if err != nil {
+		return err
+	}
+
+	ticketHash, err := chainhash.NewHashFromStr(t.hash)
+	if err != nil {
+		return err
+	}
+
+	msgTx := wire.NewMsgTx()
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, math.MaxUint32, wire.TxTreeRegular), voteSubsidy,
+		mock.activeNet.StakeBaseSigScript))
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(ticketHash, 0, wire.TxTreeStake), t.price,
+		make([]byte, txhelper.RedeemP2PKHSigScriptSize)))
+	msgTx.AddTxOut(wire.NewTxOut(0, blockRefScript))
+	msgTx.AddTxOut(wire.NewTxOut(0, votesScript))
+	msgTx.AddTxOut(wire.NewTxOut(t.price+voteSubsidy, rewardScript))
+
+	_, err = mock.recordTransaction(msgTx, blockHeight, mock.blockTimestamp(blockHeight))
+	return err
+}
+
+// revokeTicket records a revocation that spends a missed or expired ticket in the block at `blockHeight`.
+func (mock *MockWallet) revokeTicket(t *ticket, blockHeight int32) error {
+	rewardAddress, err := addresshelper.DecodeForNetwork(t.rewardAddress, mock.activeNet.Params)
+	if err != nil {
+		return err
+	}
+	rewardScript, err := txscript.PayToSSRtx(rewardAddress)
+	if err != nil {
+		return err
+	}
+
+	ticketHash, err := chainhash.NewHashFromStr(t.hash)
+	if err != nil {
+		return err
+	}
+
+	msgTx := wire.NewMsgTx()
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(ticketHash, 0, wire.TxTreeStake), t.price,
+		make([]byte, txhelper.RedeemP2PKHSigScriptSize)))
+	msgTx.AddTxOut(wire.NewTxOut(t.price, rewardScript))
+	msgTx.TxOut[0].Value -= estimateFee(1, msgTx.TxOut, false, walletcore.DefaultFeeRate)
+
+	_, err = mock.recordTransaction(msgTx, blockHeight, mock.blockTimestamp(blockHeight))
+	return err
+}
+
+func (mock *MockWallet) ticketStatus(t *ticket) string {
+	switch {
+	case t.spenderType == "vote":
+		return walletcore.TicketStatusVoted
+	case t.spenderType == "revocation":
+		return walletcore.TicketStatusRevoked
+	case t.missed:
+		return walletcore.TicketStatusMissed
+	case t.blockHeight == -1:
+		return walletcore.TicketStatusUnmined
+	}
+
+	confirmations := txhelper.TxConfirmations(t.blockHeight, mock.bestBlock)
+	if confirmations <= int32(mock.activeNet.TicketMaturity) {
+		return walletcore.TicketStatusImmature
+	}
+	if confirmations > int32(mock.activeNet.TicketMaturity)+int32(mock.activeNet.TicketExpiry) {
+		return walletcore.TicketStatusExpired
+	}
+	return walletcore.TicketStatusLive
+}
+
+// mineUnminedTransactions includes all unmined transactions in the block at `blockHeight`
+// and notifies subscribers of the wallet's events of the confirmed transactions.
+func (mock *MockWallet) mineUnminedTransactions(blockHeight int32) error {
+	unminedTxs, err := mock.txIndexDB.Read(0, 0, nil)
+	if err != nil {
+		return err
+	}
+
+	var minedTxs int
+	defer func() {
+		if minedTxs > 0 {
+			mock.events.Publish(events.Event{Type: events.BalanceChanged})
+		}
+	}()
+
+	for _, tx := range unminedTxs {
+		if tx.BlockHeight != -1 {
+			continue
+		}
+		tx.BlockHeight = blockHeight
+		if err = mock.txIndexDB.SaveOrUpdate(tx); err != nil {
+			return err
+		}
+		minedTxs++
+		mock.events.Publish(events.Event{Type: events.TxConfirmed, Data: txEventData(tx)})
+
+		for _, utxo := range mock.utxos {
+			if utxo.txHash == tx.Hash {
+				utxo.blockHeight = blockHeight
+			}
+		}
+		for _, t := range mock.tickets {
+			if t.hash == tx.Hash {
+				t.blockHeight = blockHeight
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,678 @@
+package mockwallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1"
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet"
+	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/dcrlibwallet/addresshelper"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/dcrlibwallet/txindex"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+func (mock *MockWallet) AccountBalance(accountNumber uint32, requiredConfirmations int32) (*walletcore.Balance, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	if _, err := mock.accountByNumber(accountNumber); err != nil {
+		return nil, err
+	}
+	return mock.accountBalance(accountNumber, requiredConfirmations), nil
+}
+
+func (mock *MockWallet) accountBalance(accountNumber uint32, requiredConfirmations int32) *walletcore.Balance {
+	balance := &walletcore.Balance{}
+	for _, utxo := range mock.utxos {
+		if utxo.account != accountNumber {
+			continue
+		}
+		if txhelper.TxConfirmations(utxo.blockHeight, mock.bestBlock) >= requiredConfirmations {
+			balance.Spendable += dcrutil.Amount(utxo.amount)
+		} else {
+			balance.Unconfirmed += dcrutil.Amount(utxo.amount)
+		}
+	}
+
+	for _, t := range mock.tickets {
+		if t.account != accountNumber || t.spenderHash != "" {
+			continue
+		}
+		balance.LockedByTickets += dcrutil.Amount(t.price)
+		balance.VotingAuthority += dcrutil.Amount(t.price)
+	}
+
+	balance.Total = balance.Spendable + balance.Unconfirmed + balance.LockedByTickets
+	return balance
+}
+
+func (mock *MockWallet) AccountsOverview(requiredConfirmations int32) ([]*walletcore.Account, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	accountsOverview := make([]*walletcore.Account, len(mock.accounts))
+	for i, acc := range mock.accounts {
+		accountsOverview[i] = &walletcore.Account{
+			Name:             acc.name,
+			Number:           acc.number,
+			Balance:          mock.accountBalance(acc.number, requiredConfirmations),
+			ExternalKeyCount: int32(acc.nextExternalIndex),
+			InternalKeyCount: int32(acc.nextInternalIndex),
+		}
+	}
+
+	return accountsOverview, nil
+}
+
+func (mock *MockWallet) NextAccount(accountName string, passphrase string) (uint32, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if err := mock.checkPassphrase(passphrase); err != nil {
+		return 0, err
+	}
+
+	accountName = strings.TrimSpace(accountName)
+	if accountName == "" {
+		return 0, errors.New("account name cannot be empty")
+	}
+	for _, acc := range mock.accounts {
+		if acc.name == accountName {
+			return 0, fmt.Errorf("account named %s already exists", accountName)
+		}
+	}
+
+	acc, err := mock.addAccount(accountName)
+	if err != nil {
+		return 0, err
+	}
+	return acc.number, nil
+}
+
+func (mock *MockWallet) AccountNumber(accountName string) (uint32, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	for _, acc := range mock.accounts {
+		if acc.name == accountName {
+			return acc.number, nil
+		}
+	}
+	return 0, fmt.Errorf("account not found: %s", accountName)
+}
+
+func (mock *MockWallet) AccountName(accountNumber uint32) (string, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	acc, err := mock.accountByNumber(accountNumber)
+	if err != nil {
+		return "", err
+	}
+	return acc.name, nil
+}
+
+func (mock *MockWallet) RenameAccount(accountNumber uint32, newName string) error {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	acc, err := mock.accountByNumber(accountNumber)
+	if err != nil {
+		return err
+	}
+
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return errors.New("account name cannot be empty")
+	}
+	for _, otherAccount := range mock.accounts {
+		if otherAccount.name == newName && otherAccount.number != accountNumber {
+			return fmt.Errorf("account named %s already exists", newName)
+		}
+	}
+
+	acc.name = newName
+	return nil
+}
+
+func (mock *MockWallet) AccountExtendedPubKey(accountNumber uint32) (string, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	acc, err := mock.accountByNumber(accountNumber)
+	if err != nil {
+		return "", err
+	}
+
+	accountPubKey, err := acc.key.Neuter()
+	if err != nil {
+		return "", fmt.Errorf("error deriving account public key: %s", err.Error())
+	}
+	return accountPubKey.String(), nil
+}
+
+func (mock *MockWallet) AddressInfo(address string) (*dcrlibwallet.AddressInfo, error) {
+	if _, err := addresshelper.DecodeForNetwork(address, mock.activeNet.Params); err != nil {
+		return nil, err
+	}
+
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	addressInfo := &dcrlibwallet.AddressInfo{
+		Address: address,
+	}
+	if walletAddress, isMine := mock.addresses[address]; isMine {
+		addressInfo.IsMine = true
+		addressInfo.AccountNumber = walletAddress.account
+		addressInfo.AccountName = mock.accounts[walletAddress.account].name
+	}
+	return addressInfo, nil
+}
+
+func (mock *MockWallet) ValidateAddress(address string) (bool, error) {
+	_, err := addresshelper.DecodeForNetwork(address, mock.activeNet.Params)
+	return err == nil, nil
+}
+
+// SignMessage signs `message` the same way dcrwallet does, so the signature can be verified by any decred wallet.
+func (mock *MockWallet) SignMessage(address, message, passphrase string) (string, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	if err := mock.checkPassphrase(passphrase); err != nil {
+		return "", err
+	}
+
+	walletAddress, isMine := mock.addresses[address]
+	if !isMine {
+		return "", fmt.Errorf("address does not belong to this wallet: %s", address)
+	}
+	acc, err := mock.accountByNumber(walletAddress.account)
+	if err != nil {
+		return "", err
+	}
+	addressKey, err := mock.addressKey(acc, walletAddress.branch, walletAddress.index)
+	if err != nil {
+		return "", err
+	}
+	privKey, err := addressKey.ECPrivKey()
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := secp256k1.SignCompact(privKey, signedMessageHash(message), true)
+	if err != nil {
+		return "", fmt.Errorf("error signing message: %s", err.Error())
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+func (mock *MockWallet) VerifyMessage(address, message, signature string) (bool, error) {
+	addr, err := addresshelper.DecodeForNetwork(address, mock.activeNet.Params)
+	if err != nil {
+		return false, fmt.Errorf("invalid address: %s", err.Error())
+	}
+	signatureBytes, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false, fmt.Errorf("error decoding signature: %s", err.Error())
+	}
+	return wallet.VerifyMessage(message, addr, signatureBytes)
+}
+
+// signedMessageHash returns the hash that is signed to produce a message signature, same as in dcrwallet
+func signedMessageHash(message string) []byte {
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, "Decred Signed Message:\n")
+	wire.WriteVarString(&buf, 0, message)
+	return chainhash.HashB(buf.Bytes())
+}
+
+func (mock *MockWallet) ReceiveAddress(account uint32) (string, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	acc, err := mock.accountByNumber(account)
+	if err != nil {
+		return "", err
+	}
+
+	if acc.lastReturnedAddress != "" && !mock.addresses[acc.lastReturnedAddress].used {
+		return acc.lastReturnedAddress, nil
+	}
+	return mock.nextExternalAddress(acc)
+}
+
+func (mock *MockWallet) GenerateNewAddress(account uint32) (string, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	acc, err := mock.accountByNumber(account)
+	if err != nil {
+		return "", err
+	}
+	return mock.nextExternalAddress(acc)
+}
+
+func (mock *MockWallet) nextExternalAddress(acc *account) (string, error) {
+	address, err := mock.deriveAddress(acc, externalBranch)
+	if err != nil {
+		return "", err
+	}
+	acc.lastReturnedAddress = address
+	return address, nil
+}
+
+func (mock *MockWallet) UnspentOutputs(account uint32, targetAmount int64, requiredConfirmations int32) ([]*walletcore.UnspentOutput, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	if _, err := mock.accountByNumber(account); err != nil {
+		return nil, err
+	}
+
+	utxos := mock.spendableOutputs(account, requiredConfirmations)
+	sort.Slice(utxos, func(i, j int) bool {
+		return utxos[i].receiveTime > utxos[j].receiveTime
+	})
+
+	var unspentOutputs []*walletcore.UnspentOutput
+	var total int64
+	for _, utxo := range utxos {
+		unspentOutputs = append(unspentOutputs, mock.walletUnspentOutput(utxo))
+
+		total += utxo.amount
+		if targetAmount > 0 && total >= targetAmount {
+			break
+		}
+	}
+
+	mock.lockedOutputs.MarkLocked(unspentOutputs)
+	return unspentOutputs, nil
+}
+
+func (mock *MockWallet) LockUnspent(outputKeys []string, lock bool) error {
+	return mock.lockedOutputs.Lock(outputKeys, lock)
+}
+
+func (mock *MockWallet) LockedOutputs() ([]string, error) {
+	return mock.lockedOutputs.OutputKeys(), nil
+}
+
+func (mock *MockWallet) SendFromAccount(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination,
+	feeRate int64, coinSelection string, passphrase string) (string, error) {
+
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if err := mock.checkPassphrase(passphrase); err != nil {
+		return "", err
+	}
+
+	acc, err := mock.accountByNumber(sourceAccount)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := mock.sendFromAccount(acc, requiredConfirmations, destinations, feeRate, coinSelection, -1, mock.bestBlockTime)
+	if err != nil {
+		return "", err
+	}
+	return tx.Hash, nil
+}
+
+func (mock *MockWallet) SendFromUTXOs(sourceAccount uint32, requiredConfirmations int32, utxoKeys []string,
+	txDestinations []txhelper.TransactionDestination, changeDestinations []txhelper.TransactionDestination, feeRate int64, passphrase string) (string, error) {
+
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if err := mock.checkPassphrase(passphrase); err != nil {
+		return "", err
+	}
+
+	acc, err := mock.accountByNumber(sourceAccount)
+	if err != nil {
+		return "", err
+	}
+
+	inputs := make([]*unspentOutput, len(utxoKeys))
+	for i, utxoKey := range utxoKeys {
+		utxo, ok := mock.utxos[utxoKey]
+		if !ok || utxo.account != sourceAccount {
+			return "", fmt.Errorf("unspent output %s not found in account", utxoKey)
+		}
+		if txhelper.TxConfirmations(utxo.blockHeight, mock.bestBlock) < requiredConfirmations {
+			return "", fmt.Errorf("unspent output %s does not have %d confirmations", utxoKey, requiredConfirmations)
+		}
+		inputs[i] = utxo
+	}
+
+	msgTx, err := mock.createSpendTx(acc, inputs, txDestinations, changeDestinations, feeRate)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := mock.recordTransaction(msgTx, -1, mock.bestBlockTime)
+	if err != nil {
+		return "", err
+	}
+	return tx.Hash, nil
+}
+
+func (mock *MockWallet) ConstructTransaction(sourceAccount uint32, requiredConfirmations int32, destinations []txhelper.TransactionDestination,
+	feeRate int64, coinSelection string) ([]byte, error) {
+
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	acc, err := mock.accountByNumber(sourceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTx, _, err := mock.constructTransaction(acc, requiredConfirmations, destinations, feeRate, coinSelection)
+	if err != nil {
+		return nil, err
+	}
+	return msgTx.Bytes()
+}
+
+func (mock *MockWallet) SignRawTransaction(serializedTx []byte, passphrase string) ([]byte, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	if err := mock.checkPassphrase(passphrase); err != nil {
+		return nil, err
+	}
+
+	var msgTx wire.MsgTx
+	if err := msgTx.FromBytes(serializedTx); err != nil {
+		return nil, fmt.Errorf("error decoding transaction: %s", err.Error())
+	}
+
+	inputs, err := mock.spentOutputs(&msgTx)
+	if err != nil {
+		return nil, err
+	}
+	if err = mock.signInputs(&msgTx, inputs); err != nil {
+		return nil, err
+	}
+	return msgTx.Bytes()
+}
+
+// PublishRawTransaction adds a signed transaction to the wallet's unmined transactions.
+// The transaction is included in the next simulated block.
+func (mock *MockWallet) PublishRawTransaction(serializedTx []byte) (string, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	var msgTx wire.MsgTx
+	if err := msgTx.FromBytes(serializedTx); err != nil {
+		return "", fmt.Errorf("error decoding transaction: %s", err.Error())
+	}
+
+	inputs, err := mock.spentOutputs(&msgTx)
+	if err != nil {
+		return "", err
+	}
+	for i, input := range inputs {
+		if len(msgTx.TxIn[i].SignatureScript) == 0 {
+			return "", fmt.Errorf("input %d is not signed", i)
+		}
+		if err = verifyInputScript(&msgTx, i, input.pkScript); err != nil {
+			return "", err
+		}
+	}
+
+	tx, err := mock.recordTransaction(&msgTx, -1, mock.bestBlockTime)
+	if err != nil {
+		return "", err
+	}
+	return tx.Hash, nil
+}
+
+func (mock *MockWallet) TransactionCount(filter *txindex.ReadFilter) (int, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	return mock.txIndexDB.CountTx(filter)
+}
+
+func (mock *MockWallet) TransactionHistory(offset, count int32, filter *txindex.ReadFilter) ([]*walletcore.Transaction, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	txs, err := mock.txIndexDB.Read(offset, count, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	processedTxs := make([]*walletcore.Transaction, len(txs))
+	for i, tx := range txs {
+		confirmations := txhelper.TxConfirmations(tx.BlockHeight, mock.bestBlock)
+		processedTxs[i] = walletcore.TxDetails(tx, confirmations)
+	}
+	walletcore.AddTransactionLabels(mock.txLabels, processedTxs...)
+	return processedTxs, nil
+}
+
+func (mock *MockWallet) GetTransaction(transactionHash string) (*walletcore.Transaction, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	tx, err := mock.findTransaction(transactionHash)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmations := txhelper.TxConfirmations(tx.BlockHeight, mock.bestBlock)
+	txDetails := walletcore.TxDetails(tx, confirmations)
+	walletcore.AddTransactionLabels(mock.txLabels, txDetails)
+	return txDetails, nil
+}
+
+func (mock *MockWallet) SetTransactionLabel(transactionHash, label string) error {
+	tx, err := mock.GetTransaction(transactionHash)
+	if err != nil {
+		return err
+	}
+	return mock.txLabels.SetLabel(tx.Hash, label)
+}
+
+func (mock *MockWallet) SearchTransactions(query string) ([]*walletcore.Transaction, error) {
+	return walletcore.SearchLabeledTransactions(mock.txLabels, query, mock.GetTransaction)
+}
+
+func (mock *MockWallet) findTransaction(transactionHash string) (*txhelper.Transaction, error) {
+	txs, err := mock.txIndexDB.Read(0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		if tx.Hash == transactionHash {
+			return tx, nil
+		}
+	}
+	return nil, fmt.Errorf("transaction not found: %s", transactionHash)
+}
+
+func (mock *MockWallet) StakeInfo(ctx context.Context) (*walletcore.StakeInfo, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	stakeInfo := &walletcore.StakeInfo{
+		PoolSize: uint32(mock.activeNet.TicketPoolSize) * uint32(mock.activeNet.TicketsPerBlock),
+	}
+
+	var totalSubsidy dcrutil.Amount
+	for _, t := range mock.tickets {
+		switch mock.ticketStatus(t) {
+		case walletcore.TicketStatusUnmined:
+			stakeInfo.OwnMempoolTix++
+		case walletcore.TicketStatusImmature:
+			stakeInfo.Immature++
+			stakeInfo.Unspent++
+		case walletcore.TicketStatusLive:
+			stakeInfo.Live++
+			stakeInfo.Unspent++
+		case walletcore.TicketStatusVoted:
+			stakeInfo.Voted++
+			totalSubsidy += dcrutil.Amount(voteSubsidy)
+		case walletcore.TicketStatusMissed:
+			stakeInfo.Missed++
+		case walletcore.TicketStatusExpired:
+			stakeInfo.Expired++
+		case walletcore.TicketStatusRevoked:
+			stakeInfo.Revoked++
+			if t.missed {
+				stakeInfo.Missed++
+			} else {
+				stakeInfo.Expired++
+			}
+		}
+	}
+
+	// other wallets are buying tickets too
+	stakeInfo.AllMempoolTix = stakeInfo.OwnMempoolTix + uint32(mock.activeNet.TicketsPerBlock)
+	stakeInfo.TotalSubsidy = totalSubsidy.String()
+
+	return stakeInfo, nil
+}
+
+func (mock *MockWallet) Tickets(ctx context.Context, filter *walletcore.TicketFilter) ([]*walletcore.Ticket, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+
+	var tickets []*walletcore.Ticket
+	for _, t := range mock.tickets {
+		status := mock.ticketStatus(t)
+		if !filter.Matches(status) {
+			continue
+		}
+
+		tickets = append(tickets, &walletcore.Ticket{
+			Hash:           t.hash,
+			PurchaseHeight: t.blockHeight,
+			Price:          dcrutil.Amount(t.price),
+			Status:         status,
+			SpenderHash:    t.spenderHash,
+			Reward:         dcrutil.Amount(t.reward),
+		})
+	}
+
+	return tickets, nil
+}
+
+func (mock *MockWallet) PurchaseTicket(ctx context.Context, request dcrlibwallet.PurchaseTicketsRequest) ([]string, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if err := mock.checkPassphrase(string(request.Passphrase)); err != nil {
+		return nil, err
+	}
+	if request.NumTickets < 1 {
+		return nil, errors.New("number of tickets to purchase must be at least 1")
+	}
+
+	acc, err := mock.accountByNumber(request.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := mock.accountBalance(acc.number, int32(request.RequiredConfirmations))
+	totalTicketPrice := dcrutil.Amount(ticketPrice * int64(request.NumTickets))
+	if balance.Spendable < totalTicketPrice {
+		return nil, fmt.Errorf("insufficient funds: spendable account balance (%s) is less than ticket purchase cost %s",
+			balance.Spendable, totalTicketPrice)
+	}
+
+	feeRate := request.TxFee
+	if feeRate == 0 {
+		feeRate = walletcore.DefaultFeeRate
+	}
+
+	var ticketHashes []string
+	for i := uint32(0); i < request.NumTickets; i++ {
+		t, err := mock.purchaseTicket(acc, int32(request.RequiredConfirmations), feeRate, -1, mock.bestBlockTime)
+		if err != nil {
+			return ticketHashes, fmt.Errorf("could not complete ticket(s) purchase, encountered an error:\n%s", err.Error())
+		}
+		ticketHashes = append(ticketHashes, t.hash)
+	}
+
+	return ticketHashes, nil
+}
+
+func (mock *MockWallet) RevokeTickets(ctx context.Context, passphrase string) error {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if err := mock.checkPassphrase(passphrase); err != nil {
+		return err
+	}
+
+	for _, t := range mock.tickets {
+		status := mock.ticketStatus(t)
+		if status != walletcore.TicketStatusMissed && status != walletcore.TicketStatusExpired {
+			continue
+		}
+		if err := mock.revokeTicket(t, -1); err != nil {
+			return fmt.Errorf("error revoking ticket %s: %s", t.hash, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (mock *MockWallet) TicketPrice(ctx context.Context) (int64, error) {
+	return ticketPrice, nil
+}
+
+func (mock *MockWallet) ChangePrivatePassphrase(_ context.Context, oldPass, newPass string) error {
+	if oldPass == "" || newPass == "" {
+		return errors.New("Passphrase cannot be empty")
+	}
+
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if err := mock.checkPassphrase(oldPass); err != nil {
+		return err
+	}
+	mock.privatePassphrase = newPass
+	return nil
+}
+
+func (mock *MockWallet) SeedBackupVerified() bool {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+	return mock.watchingOnly || mock.seedBackup.Verified()
+}
+
+// VerifySeedBackup accepts the seed the wallet was created from. The seed of the sample wallet
+// is the mnemonic encoding of the hash used to initialize it in `Connect`.
+func (mock *MockWallet) VerifySeedBackup(seed string) error {
+	mock.mu.RLock()
+	watchingOnly := mock.watchingOnly
+	mock.mu.RUnlock()
+
+	if watchingOnly {
+		return walletcore.ErrWatchingOnlyWallet
+	}
+	return walletcore.VerifySeedBackup(mock, mock.seedBackup, mock.activeNet.Params, seed)
+}
+
+func (mock *MockWallet) NetType() string {
+	return mock.activeNet.Params.Name
+}
@@ -0,0 +1,318 @@
+package mockwallet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/hdkeychain"
+	"github.com/decred/dcrwallet/walletseed"
+	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+const (
+	// delay between simulated sync updates
+	syncUpdateInterval = 500 * time.Millisecond
+
+	// number of peers the wallet pretends to be connected to after sync starts
+	simulatedPeers = 3
+
+	// after sync, a new block is attached to the simulated network at this interval
+	// and any unmined transaction is included in the block
+	simulatedBlockInterval = 1 * time.Minute
+)
+
+func (mock *MockWallet) GenerateNewWalletSeed() (string, error) {
+	return utils.GenerateSeed()
+}
+
+func (mock *MockWallet) WalletExists() (bool, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+	return mock.walletCreated, nil
+}
+
+// CreateWallet replaces the sample wallet with an empty wallet created from `seed`.
+func (mock *MockWallet) CreateWallet(passphrase, seed string) error {
+	seedBytes, err := walletseed.DecodeUserInput(seed)
+	if err != nil {
+		return err
+	}
+
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if err = mock.initialize(seedBytes, passphrase); err != nil {
+		return err
+	}
+	return mock.seedBackup.RecordSeed(seed)
+}
+
+// CreateWatchingOnlyWallet replaces the sample wallet with an empty wallet
+// whose default account addresses are derived from `extendedPublicKey`.
+func (mock *MockWallet) CreateWatchingOnlyWallet(extendedPublicKey string) error {
+	accountKey, err := hdkeychain.NewKeyFromString(extendedPublicKey)
+	if err != nil {
+		return fmt.Errorf("invalid extended public key: %s", err.Error())
+	}
+	if accountKey.IsPrivate() {
+		return fmt.Errorf("invalid extended public key: private keys cannot be used to create a watching-only wallet")
+	}
+	if !accountKey.IsForNet(mock.activeNet.Params) {
+		return fmt.Errorf("invalid extended public key: key is not for %s", mock.NetType())
+	}
+
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return mock.initializeWatchingOnly(accountKey)
+}
+
+func (mock *MockWallet) IsWalletOpen() bool {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+	return mock.walletOpen
+}
+
+func (mock *MockWallet) IsWatchingOnlyWallet() bool {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+	return mock.watchingOnly
+}
+
+// SyncBlockChain simulates the steps of an spv sync, reporting progress to `syncProgressUpdated` as a real sync would.
+func (mock *MockWallet) SyncBlockChain(showLog bool, syncProgressUpdated func(*defaultsynclistener.ProgressReport)) {
+	getBestBlock := func() int32 {
+		bestBlock, _ := mock.BestBlock()
+		return int32(bestBlock)
+	}
+	getBestBlockTimestamp := func() int64 {
+		mock.mu.RLock()
+		defer mock.mu.RUnlock()
+		return mock.bestBlockTime
+	}
+
+	// use syncProgressUpdatedWrapper to suppress op parameter that's not needed by callers
+	// and to publish the progress to subscribers of the wallet's events
+	syncProgressUpdatedWrapper := func(progressReport *defaultsynclistener.ProgressReport, _ defaultsynclistener.SyncOp) {
+		syncProgressUpdated(progressReport)
+		mock.events.Publish(syncProgressEvent(progressReport))
+	}
+	syncListener := defaultsynclistener.DefaultSyncProgressListener(mock.NetType(), showLog, getBestBlock,
+		getBestBlockTimestamp, syncProgressUpdatedWrapper)
+
+	mock.mu.Lock()
+	mock.syncListener = syncListener
+	alreadySynced := mock.synced
+	mock.mu.Unlock()
+
+	if alreadySynced {
+		syncListener.OnSynced(true)
+		return
+	}
+
+	go func() {
+		for peers := int32(1); peers <= simulatedPeers; peers++ {
+			mock.mu.Lock()
+			mock.numberOfPeers = peers
+			mock.mu.Unlock()
+			syncListener.OnPeerConnected(peers)
+			time.Sleep(syncUpdateInterval)
+		}
+
+		// fetch the headers of blocks attached to the network since the wallet was last synced
+		syncListener.OnFetchedHeaders(0, 0, dcrlibwallet.SyncStateStart)
+		const headerBatches = 10
+		for i := 0; i < headerBatches; i++ {
+			time.Sleep(syncUpdateInterval)
+
+			fetchedHeaders := int32(unsyncedBlocks / headerBatches)
+			mock.mu.Lock()
+			mock.bestBlock += fetchedHeaders
+			mock.bestBlockTime += int64(fetchedHeaders) * int64(mock.activeNet.TargetTimePerBlock/time.Second)
+			lastHeaderTime := mock.bestBlockTime
+			mock.mu.Unlock()
+
+			syncListener.OnFetchedHeaders(fetchedHeaders, lastHeaderTime, dcrlibwallet.SyncStateProgress)
+		}
+		syncListener.OnFetchedHeaders(0, 0, dcrlibwallet.SyncStateFinish)
+
+		syncListener.OnDiscoveredAddresses(dcrlibwallet.SyncStateStart)
+		time.Sleep(2 * syncUpdateInterval)
+		syncListener.OnDiscoveredAddresses(dcrlibwallet.SyncStateFinish)
+
+		mock.simulateRescan(syncListener)
+
+		mock.mu.Lock()
+		mock.synced = true
+		mock.mu.Unlock()
+		syncListener.OnSynced(true)
+
+		mock.attachBlocks()
+	}()
+}
+
+// simulateRescan reports rescan progress from the genesis block to the wallet's best block.
+func (mock *MockWallet) simulateRescan(syncListener *defaultsynclistener.DefaultSyncListener) {
+	bestBlock, _ := mock.BestBlock()
+
+	syncListener.OnRescan(0, dcrlibwallet.SyncStateStart)
+	const rescanBatches = 5
+	for i := 1; i <= rescanBatches; i++ {
+		time.Sleep(syncUpdateInterval)
+		syncListener.OnRescan(int32(bestBlock)*int32(i)/rescanBatches, dcrlibwallet.SyncStateProgress)
+	}
+	syncListener.OnRescan(0, dcrlibwallet.SyncStateFinish)
+}
+
+// attachBlocks adds a new block to the simulated network at regular intervals until the wallet is closed.
+func (mock *MockWallet) attachBlocks() {
+	// the wallet may be closed and reopened, which replaces mock.shutdown
+	mock.mu.RLock()
+	shutdown := mock.shutdown
+	mock.mu.RUnlock()
+
+	ticker := time.NewTicker(simulatedBlockInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			mock.mu.Lock()
+			mock.bestBlock++
+			mock.bestBlockTime = time.Now().Unix()
+			mock.events.Publish(events.Event{
+				Type: events.BlockAttached,
+				Data: events.Block{Height: mock.bestBlock, Timestamp: mock.bestBlockTime},
+			})
+			if mock.txIndexDB != nil {
+				mock.mineUnminedTransactions(mock.bestBlock)
+			}
+			mock.mu.Unlock()
+
+		case <-shutdown:
+			return
+		}
+	}
+}
+
+func (mock *MockWallet) RescanBlockChain(ctx context.Context, rescanProgressUpdated func(walletcore.RescanProgress)) error {
+	mock.mu.RLock()
+	synced := mock.synced
+	mock.mu.RUnlock()
+
+	if !synced {
+		return fmt.Errorf("blockchain has not been synced previously")
+	}
+
+	bestBlock, _ := mock.BestBlock()
+	progress := walletcore.RescanProgress{TotalHeight: int32(bestBlock)}
+	rescanProgressUpdated(progress)
+
+	const rescanBatches = 5
+	for i := 1; i < rescanBatches; i++ {
+		select {
+		case <-time.After(syncUpdateInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		progress.CurrentHeight = progress.TotalHeight * int32(i) / rescanBatches
+		rescanProgressUpdated(progress)
+	}
+
+	progress.CurrentHeight = progress.TotalHeight
+	progress.Done = true
+	rescanProgressUpdated(progress)
+	return nil
+}
+
+func (mock *MockWallet) WalletConnectionInfo() (info walletcore.ConnectionInfo, err error) {
+	accounts, loadAccountErr := mock.AccountsOverview(walletcore.DefaultRequiredConfirmations)
+	if loadAccountErr != nil {
+		err = fmt.Errorf("error fetching account balance: %s", loadAccountErr.Error())
+		info.TotalBalance = "0 DCR"
+	} else {
+		var totalBalance dcrutil.Amount
+		for _, acc := range accounts {
+			totalBalance += acc.Balance.Total
+		}
+		info.TotalBalance = totalBalance.String()
+	}
+
+	info.LatestBlock, _ = mock.BestBlock()
+	info.NetworkType = mock.NetType()
+	info.ConnectionState = walletcore.ConnectionStateConnected
+	info.SyncMode = walletcore.SyncModeSPV
+	info.SyncSource = "simulated peers"
+
+	mock.mu.RLock()
+	info.PeersConnected = mock.numberOfPeers
+	mock.mu.RUnlock()
+
+	return
+}
+
+// ConnectionState always returns `walletcore.ConnectionStateConnected` as the mock wallet is in memory.
+func (mock *MockWallet) ConnectionState() walletcore.ConnectionState {
+	return walletcore.ConnectionStateConnected
+}
+
+func (mock *MockWallet) Events() *events.Bus {
+	return mock.events
+}
+
+func (mock *MockWallet) BestBlock() (uint32, error) {
+	mock.mu.RLock()
+	defer mock.mu.RUnlock()
+	return uint32(mock.bestBlock), nil
+}
+
+func (mock *MockWallet) CloseWallet() {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if !mock.walletOpen {
+		return
+	}
+	mock.walletOpen = false
+	select {
+	case <-mock.shutdown:
+		// already closed
+	default:
+		close(mock.shutdown)
+	}
+	mock.closeTxIndexDB()
+	os.RemoveAll(mock.txIndexDir)
+}
+
+func (mock *MockWallet) DeleteWallet() error {
+	mock.CloseWallet()
+
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.walletCreated = false
+	mock.accounts = nil
+	mock.addresses = nil
+	mock.utxos = nil
+	mock.tickets = nil
+	return nil
+}
+
+func syncProgressEvent(progressReport *defaultsynclistener.ProgressReport) events.Event {
+	report := progressReport.Read()
+	return events.Event{
+		Type: events.SyncProgress,
+		Data: events.SyncProgressData{
+			Percentage:     report.TotalSyncProgress,
+			ConnectedPeers: report.ConnectedPeers,
+			Done:           report.Done,
+			Error:          report.Error,
+		},
+	}
+}
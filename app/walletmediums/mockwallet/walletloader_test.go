@@ -0,0 +1,32 @@
+package mockwallet
+
+import "testing"
+
+func TestCloseWalletAfterRecreatingIt(t *testing.T) {
+	mock, err := Connect("testnet3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.DeleteWallet()
+
+	seed, err := mock.GenerateNewWalletSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.CloseWallet()
+	if mock.IsWalletOpen() {
+		t.Fatal("wallet is still open after CloseWallet")
+	}
+
+	if err = mock.CreateWallet("passphrase", seed); err != nil {
+		t.Fatal(err)
+	}
+	if !mock.IsWalletOpen() {
+		t.Fatal("wallet is not open after CreateWallet")
+	}
+
+	// closing the recreated wallet must not close the shutdown channel of the previous wallet again
+	mock.CloseWallet()
+	mock.CloseWallet()
+}
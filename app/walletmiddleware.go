@@ -1,7 +1,10 @@
 package app
 
 import (
+	"context"
+
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/godcr/app/events"
 	"github.com/raedahgroup/godcr/app/walletcore"
 )
 
@@ -14,14 +17,31 @@ type WalletMiddleware interface {
 
 	CreateWallet(passphrase, seed string) error
 
+	// CreateWatchingOnlyWallet creates a wallet that has no private keys using the extended public key of an account.
+	// Such a wallet can track balances and transactions, but cannot be used to send funds or purchase tickets.
+	CreateWatchingOnlyWallet(extendedPublicKey string) error
+
 	IsWalletOpen() bool
 
+	// IsWatchingOnlyWallet returns true if the open wallet was created using `CreateWatchingOnlyWallet`
+	IsWatchingOnlyWallet() bool
+
 	SyncBlockChain(showLog bool, syncProgressUpdated func(*defaultsynclistener.ProgressReport))
 
-	RescanBlockChain() error
+	// RescanBlockChain rescans the blockchain from the genesis block for transactions of the wallet.
+	// `rescanProgressUpdated` is called as blocks are scanned and a last time with `Done` set once the best block is reached.
+	// It blocks until the rescan is done or fails, or until `ctx` is canceled.
+	RescanBlockChain(ctx context.Context, rescanProgressUpdated func(walletcore.RescanProgress)) error
 
 	WalletConnectionInfo() (info walletcore.ConnectionInfo, err error)
 
+	// ConnectionState returns the state of the connection to the wallet, see `walletcore.ConnectionState`.
+	ConnectionState() walletcore.ConnectionState
+
+	// Events returns the bus on which the wallet publishes new transactions and blocks, sync progress,
+	// balance changes and changes to its connection state.
+	Events() *events.Bus
+
 	// BestBlock fetches the best block on the network
 	BestBlock() (uint32, error)
 
@@ -0,0 +1,39 @@
+// Package watchingonly records whether a wallet was created from an extended public key,
+// for wallet mediums that cannot query this from the wallet itself.
+package watchingonly
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileName is the name of the file that marks a wallet as watching-only, in the directory where godcr keeps the wallet's data.
+const FileName = "watchingonly"
+
+// Save marks the wallet as watching-only by creating an empty file at `filePath`,
+// or removes the mark if `watchingOnly` is false.
+func Save(filePath string, watchingOnly bool) error {
+	if !watchingOnly {
+		err := os.Remove(filePath)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("error saving watching-only status: %s", err.Error())
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+		return fmt.Errorf("error saving watching-only status: %s", err.Error())
+	}
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("error saving watching-only status: %s", err.Error())
+	}
+	return file.Close()
+}
+
+// Load returns true if the wallet was marked as watching-only with Save.
+func Load(filePath string) bool {
+	_, err := os.Stat(filePath)
+	return err == nil
+}
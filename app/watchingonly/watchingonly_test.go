@@ -0,0 +1,43 @@
+package watchingonly
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "godcr-watchingonly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, "wallet", FileName)
+	if Load(filePath) {
+		t.Fatal("wallet is watching-only before the status is saved")
+	}
+
+	if err = Save(filePath, true); err != nil {
+		t.Fatal(err)
+	}
+	if !Load(filePath) {
+		t.Fatal("wallet is not watching-only after saving it as watching-only")
+	}
+
+	// saving the same status again must not fail
+	if err = Save(filePath, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = Save(filePath, false); err != nil {
+		t.Fatal(err)
+	}
+	if Load(filePath) {
+		t.Fatal("wallet is still watching-only after saving it as not watching-only")
+	}
+	if err = Save(filePath, false); err != nil {
+		t.Fatal(err)
+	}
+}
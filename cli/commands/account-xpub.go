@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// AccountExtendedPubKeyCommand displays the extended public key of a wallet account.
+// The key can be used to create a watch-only wallet for the account on another machine.
+type AccountExtendedPubKeyCommand struct {
+	commanderStub
+	Args AccountExtendedPubKeyCommandArgs `positional-args:"yes"`
+}
+type AccountExtendedPubKeyCommandArgs struct {
+	AccountName string `positional-arg-name:"account-name" description:"Name of the account. You will be asked to select an account if this is not provided"`
+}
+
+// Run runs the `accountxpub` command.
+func (xpubCommand AccountExtendedPubKeyCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	var accountNumber uint32
+	var err error
+	if xpubCommand.Args.AccountName != "" {
+		accountNumber, err = wallet.AccountNumber(xpubCommand.Args.AccountName)
+		if err != nil {
+			return fmt.Errorf("error looking up account: %s", err.Error())
+		}
+	} else {
+		accountNumber, err = selectAccount(wallet)
+		if err != nil {
+			return err
+		}
+	}
+
+	extendedPubKey, err := wallet.AccountExtendedPubKey(accountNumber)
+	if err != nil {
+		return fmt.Errorf("error getting account extended public key: %s", err.Error())
+	}
+
+	fmt.Println(extendedPubKey)
+	return nil
+}
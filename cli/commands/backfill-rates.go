@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/conversion"
+	"github.com/raedahgroup/godcr/app/taxreport"
+	"github.com/raedahgroup/godcr/app/txrates"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+)
+
+// BackfillRatesCommand saves fiat rates for past transactions that have none,
+// from an exchange rate source that provides past rates or from a csv file of daily prices.
+type BackfillRatesCommand struct {
+	commanderStub
+	Source    string `long:"source" default:"coingecko" description:"Exchange rate source to get past rates from, ignored if --prices-csv is set"`
+	PricesCSV string `long:"prices-csv" description:"Csv file of daily DCR prices in the report currency with the columns date,price"`
+	Currency  string `long:"currency" description:"Fiat currency of the rates, defaults to the fiatcurrency setting"`
+	Overwrite bool   `long:"overwrite" description:"Replace rates already saved for transactions"`
+}
+
+// Run runs the `backfillrates` command.
+func (backfillCommand BackfillRatesCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	cfg, err := config.ReadConfigFile()
+	if err != nil {
+		return fmt.Errorf("error reading config file: %s", err.Error())
+	}
+	currency := reportCurrency(backfillCommand.Currency, cfg.FiatCurrency)
+
+	rates, err := txrates.Load(cfg.AppDataDir)
+	if err != nil {
+		return fmt.Errorf("error loading transaction rates: %s", err.Error())
+	}
+
+	var sourceName string
+	var rateOn taxreport.RateOnFunc
+	if backfillCommand.PricesCSV != "" {
+		sourceName, rateOn, err = pricesCSVRates(backfillCommand.PricesCSV)
+	} else {
+		sourceName, rateOn, err = historicalSourceRates(backfillCommand.Source, currency)
+	}
+	if err != nil {
+		return err
+	}
+
+	filled, notFound, err := taxreport.Backfill(ctx, wallet, rates, currency, sourceName, rateOn, backfillCommand.Overwrite)
+	if filled > 0 {
+		clilog.LogInfo(fmt.Sprintf("%s rates saved for %d transaction(s)", currency, filled))
+	}
+	if err != nil {
+		return fmt.Errorf("error filling in rates: %s", err.Error())
+	}
+
+	if notFound > 0 {
+		clilog.LogWarn(fmt.Sprintf("No %s rate found for %d transaction(s)", currency, notFound))
+	}
+	if filled == 0 && notFound == 0 {
+		clilog.LogInfo("All transactions already have rates")
+	}
+	return nil
+}
+
+// pricesCSVRates reads the daily prices in `filePath` and returns the file name and a function that looks up those prices.
+func pricesCSVRates(filePath string) (string, taxreport.RateOnFunc, error) {
+	pricesFile, err := os.Open(filePath)
+	if err != nil {
+		return "", nil, fmt.Errorf("error opening prices csv: %s", err.Error())
+	}
+	defer pricesFile.Close()
+
+	prices, err := txrates.ReadPricesCSV(pricesFile)
+	if err != nil {
+		return "", nil, err
+	}
+
+	rateOn := func(_ context.Context, date time.Time) (float64, error) {
+		price, ok := prices.PriceOn(date)
+		if !ok {
+			return 0, conversion.ErrRateNotFound
+		}
+		return price, nil
+	}
+	return filePath, rateOn, nil
+}
+
+// historicalSourceRates returns the name of the exchange rate source named `name` and a function that gets past rates from it.
+func historicalSourceRates(name, currency string) (string, taxreport.RateOnFunc, error) {
+	source, err := conversion.NewHistoricalRateSource(name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	rateOn := func(ctx context.Context, date time.Time) (float64, error) {
+		return source.DcrRateOn(ctx, currency, date)
+	}
+	return source.Name(), rateOn, nil
+}
@@ -8,19 +8,37 @@ import (
 
 // AvailableCommands defines thoroughly-tested commands and options available on the cli
 type AvailableCommands struct {
-	Balance         BalanceCommand         `command:"balance" description:"Show total balance for each account in wallet" long-description:"Also shows spendable balance if different from total balance"`
-	Send            SendCommand            `command:"send" description:"Send a transaction"`
-	Receive         ReceiveCommand         `command:"receive" description:"Show your address to receive funds"`
-	History         HistoryCommand         `command:"history" description:"Show your transaction history"`
-	ShowTransaction ShowTransactionCommand `command:"showtransaction" description:"Show details of a transaction"`
-	Help            HelpCommand            `command:"help" description:"Show general application help. Run help <command-name> to get help message for a specific command"`
-	StakeInfo       StakeInfoCommand       `command:"stakeinfo" description:"Show information about the wallet stakes, tickets and their statuses"`
-	PurchaseTicket  PurchaseTicketCommand  `command:"purchaseticket" description:"Purchase one or more tickets"`
+	Balance               BalanceCommand               `command:"balance" description:"Show total balance for each account in wallet" long-description:"Also shows spendable balance if different from total balance"`
+	Send                  SendCommand                  `command:"send" description:"Send a transaction"`
+	Receive               ReceiveCommand               `command:"receive" description:"Show your address to receive funds"`
+	History               HistoryCommand               `command:"history" description:"Show your transaction history"`
+	ShowTransaction       ShowTransactionCommand       `command:"showtransaction" description:"Show details of a transaction"`
+	LabelTransaction      LabelTransactionCommand      `command:"labeltransaction" description:"Attach a label to a transaction to note what it was for, labels are searchable with history --search"`
+	ExportHistory         ExportHistoryCommand         `command:"exporthistory" description:"Export your transaction history to a csv, json or ofx file"`
+	TaxReport             TaxReportCommand             `command:"taxreport" description:"Show the fiat value of received, sent, fees, staking rewards and gains per year"`
+	BackfillRates         BackfillRatesCommand         `command:"backfillrates" description:"Save fiat rates for past transactions from an exchange rate source or a csv of daily prices"`
+	Help                  HelpCommand                  `command:"help" description:"Show general application help. Run help <command-name> to get help message for a specific command"`
+	StakeInfo             StakeInfoCommand             `command:"stakeinfo" description:"Show information about the wallet stakes, tickets and their statuses"`
+	Tickets               TicketsCommand               `command:"tickets" description:"List the tickets purchased by the wallet with their statuses, prices and rewards"`
+	PurchaseTicket        PurchaseTicketCommand        `command:"purchaseticket" description:"Purchase one or more tickets"`
+	RevokeTickets         RevokeTicketsCommand         `command:"revoketickets" description:"Revoke missed and expired tickets to return the locked funds to the wallet"`
+	SignMessage           SignMessageCommand           `command:"signmessage" description:"Sign a message with the private key of a wallet address"`
+	VerifyMessage         VerifyMessageCommand         `command:"verifymessage" description:"Verify that a message was signed with the private key of an address"`
+	CreateAccount         CreateAccountCommand         `command:"createaccount" description:"Create a new account in the wallet"`
+	RenameAccount         RenameAccountCommand         `command:"renameaccount" description:"Change the name of an account"`
+	AccountExtendedPubKey AccountExtendedPubKeyCommand `command:"accountxpub" description:"Show the extended public key of an account, which can be used to create a watch-only wallet"`
+	LockUnspent           LockUnspentCommand           `command:"lockunspent" description:"Freeze or unfreeze unspent outputs, frozen outputs are never selected automatically as transaction inputs"`
+	LockedOutputs         LockedOutputsCommand         `command:"lockedoutputs" description:"List the frozen unspent outputs in the wallet"`
+	Contacts              ContactsCommand              `command:"contacts" description:"Manage the address book, contact names can be entered in place of addresses when sending"`
+	VerifySeed            VerifySeedCommand            `command:"verifyseed" description:"Confirm that you have a backup of the wallet seed by entering it, you are reminded to do this until it is done"`
 }
 
 // ExperimentalCommands defines experimental commands and options available on the cli
 type ExperimentalCommands struct {
-	SendCustom SendCustomCommand `command:"sendcustom" description:"Send a transaction, manually selecting inputs from unspent outputs"`
+	SendCustom            SendCustomCommand            `command:"sendcustom" description:"Send a transaction, manually selecting inputs from unspent outputs"`
+	ConstructTransaction  ConstructTransactionCommand  `command:"constructtransaction" description:"Create an unsigned transaction and write it to a file as hex"`
+	SignRawTransaction    SignRawTransactionCommand    `command:"signrawtransaction" description:"Sign a hex-encoded transaction read from a file and write the signed transaction to another file"`
+	PublishRawTransaction PublishRawTransactionCommand `command:"publishrawtransaction" description:"Broadcast a hex-encoded signed transaction read from a file"`
 }
 
 // Categories return information for the different categories of commands defined in this file
@@ -50,3 +68,11 @@ type commanderStub struct{}
 func (w commanderStub) Execute(args []string) error {
 	return nil
 }
+
+// privateKeysCommanderStub should be embedded instead of commanderStub by commands that require the wallet's private keys.
+// It implements `runner.PrivateKeysCommand` so that such commands are not run if the wallet is watching-only.
+type privateKeysCommanderStub struct {
+	commanderStub
+}
+
+func (w privateKeysCommanderStub) RequiresPrivateKeys() {}
@@ -0,0 +1,181 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/raedahgroup/godcr/app/addressbook"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// ContactsCommand groups the subcommands for managing the address book.
+// Contacts are saved per network and their names can be entered in place of destination addresses when sending.
+type ContactsCommand struct {
+	Add    ContactsAddCommand    `command:"add" description:"Save an address to the address book under a contact name"`
+	List   ContactsListCommand   `command:"list" description:"List the contacts saved for the wallet's network"`
+	Remove ContactsRemoveCommand `command:"remove" description:"Delete a contact from the address book"`
+	Import ContactsImportCommand `command:"import" description:"Add contacts from a csv file with the columns name,address,network,notes"`
+	Export ContactsExportCommand `command:"export" description:"Write the contacts saved for the wallet's network to a csv file"`
+}
+
+// ContactsAddCommand saves a new contact.
+type ContactsAddCommand struct {
+	commanderStub
+	Notes string                 `long:"notes" description:"Notes to save with the contact"`
+	Args  ContactsAddCommandArgs `positional-args:"yes"`
+}
+type ContactsAddCommandArgs struct {
+	Name    string `positional-arg-name:"name" description:"Name of the contact" required:"yes"`
+	Address string `positional-arg-name:"address" description:"Address of the contact" required:"yes"`
+}
+
+// Run runs the `contacts add` command.
+func (addCommand ContactsAddCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	addressBook, err := loadAddressBook()
+	if err != nil {
+		return err
+	}
+
+	err = addressBook.Add(addressbook.Contact{
+		Name:    addCommand.Args.Name,
+		Address: addCommand.Args.Address,
+		Network: wallet.NetType(),
+		Notes:   addCommand.Notes,
+	}, wallet.ValidateAddress)
+	if err != nil {
+		return fmt.Errorf("error adding contact: %s", err.Error())
+	}
+
+	clilog.LogInfo("Contact added successfully")
+	return nil
+}
+
+// ContactsListCommand lists the contacts saved for the wallet's network.
+type ContactsListCommand struct {
+	commanderStub
+}
+
+// Run runs the `contacts list` command.
+func (listCommand ContactsListCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	addressBook, err := loadAddressBook()
+	if err != nil {
+		return err
+	}
+
+	contacts := addressBook.Contacts(wallet.NetType())
+	if len(contacts) == 0 {
+		fmt.Println("No contacts saved")
+		return nil
+	}
+
+	columns := []string{"Name", "Address", "Notes"}
+	rows := make([][]interface{}, len(contacts))
+	for i, contact := range contacts {
+		rows[i] = []interface{}{contact.Name, contact.Address, contact.Notes}
+	}
+	termio.PrintTabularResult(termio.StdoutWriter, columns, rows)
+	return nil
+}
+
+// ContactsRemoveCommand deletes a contact.
+type ContactsRemoveCommand struct {
+	commanderStub
+	Args ContactsRemoveCommandArgs `positional-args:"yes"`
+}
+type ContactsRemoveCommandArgs struct {
+	Name string `positional-arg-name:"name" description:"Name of the contact to delete" required:"yes"`
+}
+
+// Run runs the `contacts remove` command.
+func (removeCommand ContactsRemoveCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	addressBook, err := loadAddressBook()
+	if err != nil {
+		return err
+	}
+
+	if err = addressBook.Remove(removeCommand.Args.Name, wallet.NetType()); err != nil {
+		return fmt.Errorf("error removing contact: %s", err.Error())
+	}
+
+	clilog.LogInfo("Contact removed successfully")
+	return nil
+}
+
+// ContactsImportCommand adds the contacts in a csv file to the address book.
+type ContactsImportCommand struct {
+	commanderStub
+	Args ContactsImportCommandArgs `positional-args:"yes"`
+}
+type ContactsImportCommandArgs struct {
+	InputFile string `positional-arg-name:"input-file" description:"Csv file to read contacts from" required:"yes"`
+}
+
+// Run runs the `contacts import` command.
+func (importCommand ContactsImportCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	addressBook, err := loadAddressBook()
+	if err != nil {
+		return err
+	}
+
+	inputFile, err := os.Open(importCommand.Args.InputFile)
+	if err != nil {
+		return fmt.Errorf("error opening csv file: %s", err.Error())
+	}
+	defer inputFile.Close()
+
+	importedCount, err := addressBook.ImportCSV(inputFile, wallet.NetType(), wallet.ValidateAddress)
+	if err != nil {
+		return fmt.Errorf("error importing contacts: %s", err.Error())
+	}
+
+	clilog.LogInfo(fmt.Sprintf("%d contact(s) imported successfully", importedCount))
+	return nil
+}
+
+// ContactsExportCommand writes the contacts saved for the wallet's network to a csv file.
+type ContactsExportCommand struct {
+	commanderStub
+	Args ContactsExportCommandArgs `positional-args:"yes"`
+}
+type ContactsExportCommandArgs struct {
+	OutputFile string `positional-arg-name:"output-file" description:"Csv file to write contacts to" required:"yes"`
+}
+
+// Run runs the `contacts export` command.
+func (exportCommand ContactsExportCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	addressBook, err := loadAddressBook()
+	if err != nil {
+		return err
+	}
+
+	outputFile, err := os.Create(exportCommand.Args.OutputFile)
+	if err != nil {
+		return fmt.Errorf("error creating csv file: %s", err.Error())
+	}
+	defer outputFile.Close()
+
+	if err = addressBook.ExportCSV(outputFile, wallet.NetType()); err != nil {
+		return fmt.Errorf("error exporting contacts: %s", err.Error())
+	}
+
+	clilog.LogInfo(fmt.Sprintf("Contacts exported to %s", exportCommand.Args.OutputFile))
+	return nil
+}
+
+// loadAddressBook loads the address book saved in the app data directory set in the config file.
+func loadAddressBook() (*addressbook.AddressBook, error) {
+	cfg, err := config.ReadConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file: %s", err.Error())
+	}
+
+	addressBook, err := addressbook.Load(cfg.AppDataDir)
+	if err != nil {
+		return nil, fmt.Errorf("error loading address book: %s", err.Error())
+	}
+	return addressBook, nil
+}
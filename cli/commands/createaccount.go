@@ -8,11 +8,11 @@ import (
 )
 
 type CreateAccountCommand struct {
-	commanderStub
+	privateKeysCommanderStub
 	Args CreateAccountArgs `positional-args:"yes"`
 }
 type CreateAccountArgs struct {
-	AccountName string `positional-arg-name:"account-name" required:"yes"`
+	AccountName string `positional-arg-name:"account-name" description:"Name of the new account" required:"yes"`
 }
 
 func (c CreateAccountCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
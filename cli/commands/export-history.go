@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+)
+
+// ExportHistoryCommand writes the wallet's transaction history to a csv, json or ofx file.
+type ExportHistoryCommand struct {
+	commanderStub
+	historyFilterOptions
+	Format string                   `long:"format" choice:"csv" choice:"json" choice:"ofx" default:"csv" description:"Format of the exported history"`
+	Args   ExportHistoryCommandArgs `positional-args:"yes"`
+}
+type ExportHistoryCommandArgs struct {
+	OutputFile string `positional-arg-name:"output-file" description:"File to write the history to. History is written to the terminal if not set."`
+}
+
+// Run runs the `exporthistory` command.
+func (exportHistory ExportHistoryCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	criteria, err := exportHistory.criteria()
+	if err != nil {
+		return err
+	}
+
+	var writer io.Writer = os.Stdout
+	if exportHistory.Args.OutputFile != "" {
+		outputFile, err := os.Create(exportHistory.Args.OutputFile)
+		if err != nil {
+			return fmt.Errorf("error creating output file: %s", err.Error())
+		}
+		defer outputFile.Close()
+		writer = outputFile
+	}
+
+	exportedCount, err := walletcore.ExportTransactionHistory(wallet, writer, exportHistory.Format, exportHistory.Filter, criteria)
+	if err != nil {
+		return err
+	}
+
+	if exportHistory.Args.OutputFile != "" {
+		clilog.LogInfo(fmt.Sprintf("%d transaction(s) exported to %s", exportedCount, exportHistory.Args.OutputFile))
+	}
+	return nil
+}
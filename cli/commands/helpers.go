@@ -11,6 +11,8 @@ import (
 
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/paymenturi"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
 )
@@ -61,23 +63,45 @@ func selectAccount(wallet walletcore.Wallet) (uint32, error) {
 }
 
 // getSendTxDestinations fetches the destinations info to send DCRs to from the user.
+// The name of a contact in the address book or a `decred:` payment uri can be entered in place of a destination address.
+// The amount requested by a payment uri is used if the user confirms it.
 func getSendTxDestinations(wallet walletcore.Wallet) (destinations []txhelper.TransactionDestination, sendAmountTotal float64, err error) {
+	addressBook, err := loadAddressBook()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// contactAddress returns the address of the contact named `input` or `input` itself if there is no such contact
+	contactAddress := func(input string) string {
+		if contact, ok := addressBook.ContactByName(input, wallet.NetType()); ok {
+			return contact.Address
+		}
+		return input
+	}
+
 	var index int
-	validateAddressInput := func(address string) error {
-		if address == "" && index > 0 {
+	validateAddressInput := func(input string) error {
+		if input == "" && index > 0 {
 			return nil
 		}
-		if address == "" {
+		if input == "" {
 			return errors.New("You did not specify an address. Try again.")
 		}
 
+		if paymenturi.IsPaymentURI(input) {
+			_, err := walletcore.ParsePaymentURI(wallet, input)
+			return err
+		}
+
+		address := contactAddress(input)
+
 		isValid, err := wallet.ValidateAddress(address)
 		if err != nil {
 			return fmt.Errorf("error validating address: %s", err.Error())
 		}
 
 		if !isValid {
-			return errors.New("That is not a valid address. Try again.")
+			return errors.New("That is not a valid address or contact name. Try again.")
 		}
 		return nil
 	}
@@ -85,20 +109,41 @@ func getSendTxDestinations(wallet walletcore.Wallet) (destinations []txhelper.Tr
 	sendAmountAddressMap := make(map[string]float64)
 
 	for {
-		label := "Destination Address"
+		label := "Destination Address, Contact Name or Payment URI"
 		if index > 0 {
-			label = fmt.Sprintf("Destination Address %d (or blank to continue)", index+1)
+			label = fmt.Sprintf("Destination Address, Contact Name or Payment URI %d (or blank to continue)", index+1)
 		}
 
-		destinationAddress, err := terminalprompt.RequestInput(label, validateAddressInput)
+		destinationInput, err := terminalprompt.RequestInput(label, validateAddressInput)
 		if err != nil {
 			return nil, 0, fmt.Errorf("error receiving input: %s", err.Error())
 		}
 
-		if destinationAddress == "" {
+		if destinationInput == "" {
 			break
 		}
 
+		var paymentRequest *paymenturi.PaymentRequest
+		var destinationAddress string
+		if paymenturi.IsPaymentURI(destinationInput) {
+			paymentRequest, err = walletcore.ParsePaymentURI(wallet, destinationInput)
+			if err != nil {
+				return nil, 0, err
+			}
+			destinationAddress = paymentRequest.Address
+			if paymentRequest.Label != "" {
+				fmt.Printf("Sending to %s at %s\n", paymentRequest.Label, destinationAddress)
+			}
+			if paymentRequest.Message != "" {
+				fmt.Printf("Message: %s\n", paymentRequest.Message)
+			}
+		} else {
+			destinationAddress = contactAddress(destinationInput)
+			if destinationAddress != destinationInput {
+				fmt.Printf("Sending to %s at %s\n", destinationInput, destinationAddress)
+			}
+		}
+
 		if _, addressExists := sendAmountAddressMap[destinationAddress]; addressExists {
 			promptMessage := fmt.Sprintf("The address %s has already been added. Do you want to change the amount?", destinationAddress)
 			changeAmountConfirmed, err := terminalprompt.RequestYesNoConfirmation(promptMessage, "N")
@@ -111,9 +156,22 @@ func getSendTxDestinations(wallet walletcore.Wallet) (destinations []txhelper.Tr
 			index--
 		}
 
-		sendAmount, err := getSendAmount()
-		if err != nil {
-			return nil, 0, fmt.Errorf("error receiving input: %s", err.Error())
+		var sendAmount float64
+		if paymentRequest != nil && paymentRequest.Amount > 0 {
+			requestedAmount := dcrutil.Amount(paymentRequest.Amount)
+			payRequestedAmount, err := terminalprompt.RequestYesNoConfirmation(fmt.Sprintf("Send the requested amount of %s?", requestedAmount), "Y")
+			if err != nil {
+				return nil, 0, fmt.Errorf("error receiving input: %s", err.Error())
+			}
+			if payRequestedAmount {
+				sendAmount = requestedAmount.ToCoin()
+			}
+		}
+		if sendAmount == 0 {
+			sendAmount, err = getSendAmount()
+			if err != nil {
+				return nil, 0, fmt.Errorf("error receiving input: %s", err.Error())
+			}
 		}
 		sendAmountAddressMap[destinationAddress] = sendAmount
 		index++
@@ -153,8 +211,9 @@ func getSendAmount() (float64, error) {
 }
 
 // getChangeOutputDestinations fetches the amount to be sent to each change address
+// after paying the tx fee at `feeRate` atoms/kB
 func getChangeOutputDestinations(wallet walletcore.Wallet, totalInputAmount float64, sourceAccount uint32,
-	nUtxoSelection int, sendDestinations []txhelper.TransactionDestination) ([]txhelper.TransactionDestination, error) {
+	nUtxoSelection int, sendDestinations []txhelper.TransactionDestination, feeRate int64) ([]txhelper.TransactionDestination, error) {
 
 	useRandomChangeAmounts, err := terminalprompt.RequestYesNoConfirmation("Use random amounts for the change outputs?", "y")
 	if err != nil {
@@ -172,15 +231,16 @@ func getChangeOutputDestinations(wallet walletcore.Wallet, totalInputAmount floa
 			return nil, err
 		}
 		return walletcore.GetChangeDestinationsWithRandomAmounts(wallet, nChangeOutputs, int64(amountInAtom), sourceAccount,
-			nUtxoSelection, sendDestinations)
+			nUtxoSelection, sendDestinations, feeRate)
 	} else {
 		return getChangeDestinationsFromUser(wallet, int64(amountInAtom), sourceAccount,
-			nUtxoSelection, sendDestinations)
+			nUtxoSelection, sendDestinations, feeRate)
 	}
 }
 
 // getChangeDestinationsFromUser fetches change destination from the user progressively until the total available change amount is covered
-func getChangeDestinationsFromUser(wallet walletcore.Wallet, amountInAtom int64, sourceAccount uint32, nUtxoSelection int, sendDestinations []txhelper.TransactionDestination) ([]txhelper.TransactionDestination, error) {
+func getChangeDestinationsFromUser(wallet walletcore.Wallet, amountInAtom int64, sourceAccount uint32, nUtxoSelection int,
+	sendDestinations []txhelper.TransactionDestination, feeRate int64) ([]txhelper.TransactionDestination, error) {
 	var changeOutputDestinations []txhelper.TransactionDestination
 	var changeAddresses []string
 	var amountAssigned int64
@@ -192,7 +252,7 @@ func getChangeDestinationsFromUser(wallet walletcore.Wallet, amountInAtom int64,
 			return nil, fmt.Errorf("error in generating address: %s", err.Error())
 		}
 		changeAddresses = append(changeAddresses, address)
-		totalChangeAmount, err := txhelper.EstimateChange(nUtxoSelection, amountInAtom, sendDestinations, changeAddresses)
+		totalChangeAmount, err := walletcore.EstimateChange(nUtxoSelection, amountInAtom, sendDestinations, changeAddresses, feeRate)
 		if err != nil {
 			return nil, err
 		}
@@ -265,7 +325,8 @@ func getWalletPassphrase() (string, error) {
 }
 
 // getUtxosForNewTransaction fetches unspent transaction outputs to be used in a transaction.
-func getUtxosForNewTransaction(utxos []*walletcore.UnspentOutput, sendAmount float64) (selectedUtxos []*walletcore.UnspentOutput, totalAmountSelected float64, err error) {
+// The user can also freeze or unfreeze outputs from the list, frozen outputs are never selected automatically.
+func getUtxosForNewTransaction(wallet walletcore.Wallet, utxos []*walletcore.UnspentOutput, sendAmount float64) (selectedUtxos []*walletcore.UnspentOutput, totalAmountSelected float64, err error) {
 	var removeWhiteSpace = func(str string) string {
 		return strings.Map(func(r rune) rune {
 			if unicode.IsSpace(r) {
@@ -275,8 +336,8 @@ func getUtxosForNewTransaction(utxos []*walletcore.UnspentOutput, sendAmount flo
 		}, str)
 	}
 
-	// validateAccountSelection  ensures that the input received is a number that corresponds to an account
-	validateUtxoSelection := func(selectedOptions string) error {
+	// parseSelection converts comma-delimited selection ranges (e.g 1-4,6) to indexes of utxos
+	parseSelection := func(selectedOptions string) ([]int, error) {
 		minAllowed, maxAllowed := 1, len(utxos)
 		errWrongInput := errors.New("your selection does not match any available option")
 
@@ -291,7 +352,7 @@ func getUtxosForNewTransaction(utxos []*walletcore.UnspentOutput, sendAmount flo
 
 			min, err = strconv.Atoi(minMax[0])
 			if err != nil || min < minAllowed || min > maxAllowed {
-				return errWrongInput
+				return nil, errWrongInput
 			}
 
 			if len(minMax) == 1 {
@@ -301,7 +362,7 @@ func getUtxosForNewTransaction(utxos []*walletcore.UnspentOutput, sendAmount flo
 
 			max, err = strconv.Atoi(minMax[1])
 			if err != nil || max < minAllowed || max > maxAllowed {
-				return errWrongInput
+				return nil, errWrongInput
 			}
 
 			// ensure min is actually smaller than max, swap if otherwise
@@ -315,7 +376,26 @@ func getUtxosForNewTransaction(utxos []*walletcore.UnspentOutput, sendAmount flo
 		}
 
 		if len(selection) == 0 {
-			return errWrongInput
+			return nil, errWrongInput
+		}
+		return selection, nil
+	}
+
+	// selections prefixed with f are outputs to freeze or unfreeze, rather than inputs for the transaction
+	var freezeSelection []int
+
+	// validateUtxoSelection ensures that the input received matches available utxos
+	validateUtxoSelection := func(selectedOptions string) error {
+		freezeSelection = nil
+		if strings.HasPrefix(strings.ToLower(selectedOptions), "f") {
+			var parseErr error
+			freezeSelection, parseErr = parseSelection(selectedOptions[1:])
+			return parseErr
+		}
+
+		selection, err := parseSelection(selectedOptions)
+		if err != nil {
+			return err
 		}
 
 		selectedUtxos = selectedUtxos[:0]
@@ -333,50 +413,71 @@ func getUtxosForNewTransaction(utxos []*walletcore.UnspentOutput, sendAmount flo
 		return nil
 	}
 
-	options := make([]string, len(utxos))
 	sort.Slice(utxos, func(i, j int) bool {
 		return utxos[i].Amount < utxos[j].Amount
 	})
-	for index, utxo := range utxos {
-		date := time.Unix(utxo.ReceiveTime, 0).Format("Mon Jan 2, 2006 3:04PM")
-		options[index] = fmt.Sprintf("%s (%s) \t %s \t %d confirmation(s)", utxo.Address, utxo.Amount.String(), date, utxo.Confirmations)
-	}
 
-	_, err = terminalprompt.RequestSelection("Select input(s) (e.g 1-4,6)", options, validateUtxoSelection)
-	if err != nil {
-		// There was an error reading input; we cannot proceed.
-		return nil, 0, fmt.Errorf("error reading selection: %s", err.Error())
+	for {
+		options := make([]string, len(utxos))
+		for index, utxo := range utxos {
+			date := time.Unix(utxo.ReceiveTime, 0).Format("Mon Jan 2, 2006 3:04PM")
+			options[index] = fmt.Sprintf("%s (%s) \t %s \t %d confirmation(s)", utxo.Address, utxo.Amount.String(), date, utxo.Confirmations)
+			if utxo.Locked {
+				options[index] += " \t [frozen]"
+			}
+		}
+
+		_, err = terminalprompt.RequestSelection("Select input(s) (e.g 1-4,6), or enter f and input(s) to freeze/unfreeze (e.g f 2)",
+			options, validateUtxoSelection)
+		if err != nil {
+			// There was an error reading input; we cannot proceed.
+			return nil, 0, fmt.Errorf("error reading selection: %s", err.Error())
+		}
+
+		if freezeSelection == nil {
+			return selectedUtxos, totalAmountSelected, nil
+		}
+
+		// toggle the frozen state of each output in the freeze selection
+		var freezeKeys, unfreezeKeys []string
+		for _, n := range freezeSelection {
+			if utxos[n].Locked {
+				unfreezeKeys = append(unfreezeKeys, utxos[n].OutputKey)
+			} else {
+				freezeKeys = append(freezeKeys, utxos[n].OutputKey)
+			}
+		}
+		if err = wallet.LockUnspent(freezeKeys, true); err == nil {
+			err = wallet.LockUnspent(unfreezeKeys, false)
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("error freezing outputs: %s", err.Error())
+		}
+		for _, n := range freezeSelection {
+			utxos[n].Locked = !utxos[n].Locked
+		}
 	}
-	return selectedUtxos, totalAmountSelected, nil
 }
 
-// bestSizedInput returns the smallest output or the least consecutive combination of
-// outputs that can handle a transaction of the supplied sendAmountTotal from the utxos
-func bestSizedInput(utxos []*walletcore.UnspentOutput, sendAmountTotal float64) ([]*walletcore.UnspentOutput, float64) {
-	sort.Slice(utxos, func(i, j int) bool {
-		return utxos[i].Amount < utxos[j].Amount
-	})
-	for _, utxo := range utxos {
-		if utxo.Amount.ToCoin() > sendAmountTotal {
-			return []*walletcore.UnspentOutput{utxo}, utxo.Amount.ToCoin()
-		}
+// feeRateOrDefault returns walletcore.DefaultFeeRate if the feerate option was not set.
+func feeRateOrDefault(feeRate int64) int64 {
+	if feeRate == 0 {
+		return walletcore.DefaultFeeRate
 	}
-	for noOfPairs := 2; noOfPairs <= len(utxos); noOfPairs++ {
-		for i := 0; i < len(utxos); i++ {
-			var accumulatedAmount float64
-			var result []*walletcore.UnspentOutput
-			for j := i; j < i+noOfPairs && j < len(utxos); j++ {
-				result = append(result, utxos[j])
-				accumulatedAmount += utxos[j].Amount.ToCoin()
-				if accumulatedAmount >= sendAmountTotal {
-					return result, accumulatedAmount
-				}
-			}
+	return feeRate
+}
+
+// coinSelectionOrDefault returns the coin selection strategy set in the config file,
+// or walletcore.DefaultCoinSelection, if the coinselection option was not set.
+func coinSelectionOrDefault(coinSelection string) (string, error) {
+	if coinSelection == "" {
+		coinSelection = walletcore.DefaultCoinSelection
+		if cfg, err := config.ReadConfigFile(); err == nil && cfg.CoinSelection != "" {
+			coinSelection = cfg.CoinSelection
 		}
 	}
-	var totalInputAmount float64
-	for _, utxo := range utxos {
-		totalInputAmount += utxo.Amount.ToCoin()
+	if _, err := walletcore.NewCoinSelector(coinSelection); err != nil {
+		return "", err
 	}
-	return utxos, sendAmountTotal
+	return coinSelection, nil
 }
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/decred/dcrd/dcrutil"
+	"github.com/raedahgroup/godcr/app/txfilter"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/cli/termio"
 	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
@@ -16,10 +17,37 @@ import (
 // HistoryCommand enables the user view their transaction history.
 type HistoryCommand struct {
 	commanderStub
+	historyFilterOptions
+	Search            string `long:"search" description:"Only show transactions with labels containing this text"`
 	txHistoryOffset   int32
 	displayedTxHashes []string
 }
 
+// historyFilterOptions are the flags for narrowing down the transactions that history commands work with.
+type historyFilterOptions struct {
+	Filter    string `long:"filter" choice:"All" choice:"Sent" choice:"Received" choice:"Yourself" choice:"Staking" choice:"Coinbase" default:"All" description:"Only include transactions of this kind"`
+	From      string `long:"from" description:"Only include transactions made on or after this date (YYYY-MM-DD, UTC)"`
+	To        string `long:"to" description:"Only include transactions made on or before this date (YYYY-MM-DD, UTC)"`
+	MinAmount string `long:"min-amount" description:"Only include transactions of at least this amount in DCR"`
+	MaxAmount string `long:"max-amount" description:"Only include transactions of at most this amount in DCR"`
+	Address   string `long:"address" description:"Only include transactions paying to this address"`
+	Account   string `long:"account" description:"Only include transactions spending from or paying to this account"`
+	Hash      string `long:"hash" description:"Only include transactions with hashes beginning with this text"`
+}
+
+// criteria returns the txfilter criteria set with the filter options.
+func (options historyFilterOptions) criteria() (*txfilter.Criteria, error) {
+	return txfilter.ParseCriteria(txfilter.CriteriaInput{
+		From:       options.From,
+		To:         options.To,
+		MinAmount:  options.MinAmount,
+		MaxAmount:  options.MaxAmount,
+		Address:    options.Address,
+		Account:    options.Account,
+		HashPrefix: options.Hash,
+	})
+}
+
 // Run runs the `history` command.
 func (history HistoryCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
 	columns := []string{
@@ -29,6 +57,27 @@ func (history HistoryCommand) Run(ctx context.Context, wallet walletcore.Wallet)
 		centerAlignAmountHeader("Amount"),
 		centerAlignAmountHeader("Fee"),
 		"Type",
+		"Label",
+	}
+
+	criteria, err := history.criteria()
+	if err != nil {
+		return err
+	}
+
+	if history.Search != "" {
+		return searchHistory(ctx, wallet, history.Search, criteria, columns)
+	}
+	if history.Filter != walletcore.TransactionFilterAll || !criteria.IsEmpty() {
+		transactions, err := walletcore.FilterTransactionHistory(wallet, history.Filter, criteria)
+		if err != nil {
+			return fmt.Errorf("error filtering history: %s", err.Error())
+		}
+		if len(transactions) == 0 {
+			fmt.Println("No transactions match the filters")
+			return nil
+		}
+		return listTransactions(ctx, wallet, transactions, columns)
 	}
 
 	txCount, err := wallet.TransactionCount(nil)
@@ -70,6 +119,7 @@ func (history HistoryCommand) Run(ctx context.Context, wallet walletcore.Wallet)
 			pageTxRows[i] = append(pageTxRows[i], formatAmount(tx.Amount))
 			pageTxRows[i] = append(pageTxRows[i], formatFee(tx.Fee))
 			pageTxRows[i] = append(pageTxRows[i], tx.Type)
+			pageTxRows[i] = append(pageTxRows[i], tx.Label)
 		}
 
 		previousPageTxCount = len(transactions)
@@ -159,6 +209,74 @@ func (history HistoryCommand) Run(ctx context.Context, wallet walletcore.Wallet)
 	return nil
 }
 
+// searchHistory displays the transactions with labels containing `query` that also match `criteria`
+// and lets the user view the details of a tx.
+func searchHistory(ctx context.Context, wallet walletcore.Wallet, query string, criteria *txfilter.Criteria, columns []string) error {
+	labeledTransactions, err := wallet.SearchTransactions(query)
+	if err != nil {
+		return fmt.Errorf("error searching transaction labels: %s", err.Error())
+	}
+
+	var transactions []*walletcore.Transaction
+	for _, tx := range labeledTransactions {
+		if criteria.Match(walletcore.TxFilterFields(tx)) {
+			transactions = append(transactions, tx)
+		}
+	}
+
+	if len(transactions) == 0 {
+		fmt.Printf("No transactions with labels containing %q\n", query)
+		return nil
+	}
+	return listTransactions(ctx, wallet, transactions, columns)
+}
+
+// listTransactions displays all `transactions` in one table and lets the user view the details of a tx.
+func listTransactions(ctx context.Context, wallet walletcore.Wallet, transactions []*walletcore.Transaction, columns []string) error {
+	txRows := make([][]interface{}, len(transactions))
+	for i, tx := range transactions {
+		txRows[i] = []interface{}{
+			i + 1,
+			tx.ShortTime,
+			tx.Direction,
+			formatAmount(tx.Amount),
+			formatFee(tx.Fee),
+			tx.Type,
+			tx.Label,
+		}
+	}
+	termio.PrintTabularResult(termio.StdoutWriter, columns, txRows)
+	fmt.Println()
+
+	validateUserInput := func(userInput string) error {
+		if strings.EqualFold(userInput, "q") {
+			return nil
+		}
+		txRowNumber, err := strconv.ParseUint(userInput, 10, 32)
+		if err != nil || txRowNumber < 1 || int(txRowNumber) > len(transactions) {
+			return fmt.Errorf("invalid response, try again")
+		}
+		return nil
+	}
+
+	prompt := fmt.Sprintf("Found %d transaction(s), enter # for details or (q)uit", len(transactions))
+	userChoice, err := terminalprompt.RequestInput(prompt, validateUserInput)
+	if err != nil {
+		return fmt.Errorf("error reading response: %s", err.Error())
+	}
+	if strings.EqualFold(userChoice, "q") {
+		return nil
+	}
+
+	txRowNumber, _ := strconv.ParseUint(userChoice, 10, 32)
+	showTxDetails := ShowTransactionCommand{
+		Args: ShowTransactionCommandArgs{transactions[txRowNumber-1].Hash},
+	}
+
+	fmt.Println()
+	return showTxDetails.Run(ctx, wallet)
+}
+
 // centerAlignAmountHeader returns the Amount or Fee header as a 17-character string
 // padded with equal spaces to the left and right
 func centerAlignAmountHeader(header string) string {
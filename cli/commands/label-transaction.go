@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+)
+
+// LabelTransactionCommand attaches a free-text label to a transaction.
+// Labels are only stored locally and can be searched with `history --search`.
+type LabelTransactionCommand struct {
+	commanderStub
+	Args LabelTransactionCommandArgs `positional-args:"yes"`
+}
+type LabelTransactionCommandArgs struct {
+	TxHash string `positional-arg-name:"transaction hash" required:"yes"`
+	Label  string `positional-arg-name:"label" description:"Text to attach to the transaction, leave out to remove the transaction's label"`
+}
+
+// Run runs the `labeltransaction` command.
+func (labelTxCommand LabelTransactionCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	err := wallet.SetTransactionLabel(labelTxCommand.Args.TxHash, labelTxCommand.Args.Label)
+	if err != nil {
+		return fmt.Errorf("error labeling transaction: %s", err.Error())
+	}
+
+	if labelTxCommand.Args.Label == "" {
+		clilog.LogInfo("Transaction label removed")
+	} else {
+		clilog.LogInfo("Transaction labeled successfully")
+	}
+	return nil
+}
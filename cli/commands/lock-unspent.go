@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// LockUnspentCommand freezes or unfreezes unspent outputs.
+// Frozen outputs are never selected automatically as inputs for transactions.
+type LockUnspentCommand struct {
+	commanderStub
+	Unlock bool                   `long:"unlock" description:"Unfreeze the outputs instead of freezing them"`
+	Args   LockUnspentCommandArgs `positional-args:"yes"`
+}
+type LockUnspentCommandArgs struct {
+	OutputKeys []string `positional-arg-name:"output-key" description:"Key of the output to freeze, in the format txhash:index" required:"1"`
+}
+
+// Run runs the `lockunspent` command.
+func (lockUnspentCommand LockUnspentCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	for _, outputKey := range lockUnspentCommand.Args.OutputKeys {
+		if !strings.Contains(outputKey, ":") {
+			return fmt.Errorf("invalid output key %s, use the format txhash:index", outputKey)
+		}
+	}
+
+	err := wallet.LockUnspent(lockUnspentCommand.Args.OutputKeys, !lockUnspentCommand.Unlock)
+	if err != nil {
+		return fmt.Errorf("error updating frozen outputs: %s", err.Error())
+	}
+
+	if lockUnspentCommand.Unlock {
+		clilog.LogInfo("Output(s) unfrozen successfully")
+	} else {
+		clilog.LogInfo("Output(s) frozen successfully")
+	}
+	return nil
+}
+
+// LockedOutputsCommand lists the frozen outputs in the wallet.
+type LockedOutputsCommand struct {
+	commanderStub
+}
+
+// Run runs the `lockedoutputs` command.
+func (lockedOutputsCommand LockedOutputsCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	outputKeys, err := wallet.LockedOutputs()
+	if err != nil {
+		return fmt.Errorf("error reading frozen outputs: %s", err.Error())
+	}
+
+	if len(outputKeys) == 0 {
+		fmt.Println("No frozen outputs")
+		return nil
+	}
+
+	columns := []string{"Frozen Output"}
+	rows := make([][]interface{}, len(outputKeys))
+	for i, outputKey := range outputKeys {
+		rows[i] = []interface{}{outputKey}
+	}
+	termio.PrintTabularResult(termio.StdoutWriter, columns, rows)
+	return nil
+}
@@ -11,7 +11,7 @@ import (
 )
 
 type PurchaseTicketCommand struct {
-	commanderStub
+	privateKeysCommanderStub
 	MinConfirmations uint32  `long:"min-conf" default:"2" description:"The number of required confirmations for funds used to purchase a ticket." long-description:"If set to zero, it will use unconfirmed and confirmed outputs to purchase tickets."`
 	TicketAddress    string  `long:"ticket-address" description:"The address to give voting rights to." long-description:"If it is set to an empty string, an internal address will be used from the wallet."`
 	NumTickets       uint32  `long:"num-tickets" default:"1" description:"The number of tickets to purchase."`
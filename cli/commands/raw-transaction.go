@@ -0,0 +1,191 @@
+package commands
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/raedahgroup/dcrlibwallet/addresshelper"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
+)
+
+// ConstructTransactionCommand creates an unsigned transaction and writes it to a file,
+// so that it can be signed by a wallet that has the private keys, e.g. an offline wallet.
+type ConstructTransactionCommand struct {
+	commanderStub
+	SpendUnconfirmed bool                            `short:"u" long:"spendunconfirmed" description:"Use unconfirmed outputs for the transaction."`
+	FeeRate          int64                           `long:"feerate" description:"Fee rate in atoms/kB to pay for the transaction, defaults to the minimum fee rate relayed by the network."`
+	CoinSelection    string                          `long:"coinselection" description:"Strategy for automatically selecting inputs, defaults to the coinselection setting in the config file {largest-first, smallest-first, branch-and-bound, oldest-first, privacy}"`
+	Args             ConstructTransactionCommandArgs `positional-args:"yes"`
+}
+type ConstructTransactionCommandArgs struct {
+	OutputFile string `positional-arg-name:"output-file" description:"File to write the hex-encoded unsigned transaction to" required:"yes"`
+}
+
+// Run runs the `constructtransaction` command.
+func (constructTxCommand ConstructTransactionCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	feeRate := feeRateOrDefault(constructTxCommand.FeeRate)
+	if err := walletcore.ValidateFeeRate(feeRate); err != nil {
+		return err
+	}
+
+	coinSelection, err := coinSelectionOrDefault(constructTxCommand.CoinSelection)
+	if err != nil {
+		return err
+	}
+
+	var requiredConfirmations int32 = walletcore.DefaultRequiredConfirmations
+	if constructTxCommand.SpendUnconfirmed {
+		requiredConfirmations = 0
+	}
+
+	sourceAccount, err := selectAccount(wallet)
+	if err != nil {
+		return err
+	}
+
+	sendDestinations, _, err := getSendTxDestinations(wallet)
+	if err != nil {
+		return err
+	}
+
+	unsignedTx, err := wallet.ConstructTransaction(sourceAccount, requiredConfirmations, sendDestinations, feeRate, coinSelection)
+	if err != nil {
+		return err
+	}
+
+	err = writeRawTransaction(constructTxCommand.Args.OutputFile, unsignedTx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Unsigned transaction written to %s\n", constructTxCommand.Args.OutputFile)
+	return nil
+}
+
+// SignRawTransactionCommand signs a transaction read from a file and writes the signed transaction to another file.
+type SignRawTransactionCommand struct {
+	privateKeysCommanderStub
+	Args SignRawTransactionCommandArgs `positional-args:"yes"`
+}
+type SignRawTransactionCommandArgs struct {
+	InputFile  string `positional-arg-name:"input-file" description:"File containing the hex-encoded unsigned transaction" required:"yes"`
+	OutputFile string `positional-arg-name:"output-file" description:"File to write the hex-encoded signed transaction to" required:"yes"`
+}
+
+// Run runs the `signrawtransaction` command.
+func (signTxCommand SignRawTransactionCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	unsignedTx, err := readRawTransaction(signTxCommand.Args.InputFile)
+	if err != nil {
+		return err
+	}
+
+	err = printRawTransactionSummary(unsignedTx, wallet.NetType())
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := getWalletPassphrase()
+	if err != nil {
+		return err
+	}
+
+	signedTx, err := wallet.SignRawTransaction(unsignedTx, passphrase)
+	if err != nil {
+		return err
+	}
+
+	err = writeRawTransaction(signTxCommand.Args.OutputFile, signedTx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Signed transaction written to %s\n", signTxCommand.Args.OutputFile)
+	return nil
+}
+
+// PublishRawTransactionCommand broadcasts a signed transaction read from a file.
+type PublishRawTransactionCommand struct {
+	commanderStub
+	Args PublishRawTransactionCommandArgs `positional-args:"yes"`
+}
+type PublishRawTransactionCommandArgs struct {
+	InputFile string `positional-arg-name:"input-file" description:"File containing the hex-encoded signed transaction" required:"yes"`
+}
+
+// Run runs the `publishrawtransaction` command.
+func (publishTxCommand PublishRawTransactionCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	signedTx, err := readRawTransaction(publishTxCommand.Args.InputFile)
+	if err != nil {
+		return err
+	}
+
+	err = printRawTransactionSummary(signedTx, wallet.NetType())
+	if err != nil {
+		return err
+	}
+
+	publishConfirmed, err := terminalprompt.RequestYesNoConfirmation("Do you want to broadcast it?", "")
+	if err != nil {
+		return fmt.Errorf("error reading your response: %s", err.Error())
+	}
+	if !publishConfirmed {
+		return errors.New("transaction canceled")
+	}
+
+	txHash, err := wallet.PublishRawTransaction(signedTx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Sent txid", txHash)
+	return nil
+}
+
+func readRawTransaction(filePath string) ([]byte, error) {
+	fileContent, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading transaction file: %s", err.Error())
+	}
+
+	serializedTx, err := hex.DecodeString(strings.TrimSpace(string(fileContent)))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding transaction hex: %s", err.Error())
+	}
+	return serializedTx, nil
+}
+
+func writeRawTransaction(filePath string, serializedTx []byte) error {
+	err := ioutil.WriteFile(filePath, []byte(hex.EncodeToString(serializedTx)+"\n"), 0644)
+	if err != nil {
+		return fmt.Errorf("error writing transaction file: %s", err.Error())
+	}
+	return nil
+}
+
+// printRawTransactionSummary displays the outputs and fee of a serialized transaction
+// so the user can check what is being signed or published.
+func printRawTransactionSummary(serializedTx []byte, netType string) error {
+	msgTx, fee, size, _, err := txhelper.MsgTxFeeSizeRate(hex.EncodeToString(serializedTx))
+	if err != nil {
+		return fmt.Errorf("error decoding transaction: %s", err.Error())
+	}
+
+	fmt.Println("This transaction sends")
+	for _, txOut := range msgTx.TxOut {
+		addresses, err := addresshelper.PkScriptAddresses(utils.NetParams(netType).Params, txOut.PkScript)
+		if err != nil {
+			return fmt.Errorf("error decoding transaction output: %s", err.Error())
+		}
+		fmt.Printf(" %s \t to %s\n", dcrutil.Amount(txOut.Value).String(), strings.Join(addresses, ", "))
+	}
+	fmt.Printf("Fee: %s, Size: %d bytes\n", fee.String(), size)
+	return nil
+}
@@ -5,15 +5,20 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/raedahgroup/godcr/app/paymenturi"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
 	qrcode "github.com/skip2/go-qrcode"
 )
 
 // ReceiveCommand generates an address for a user to receive DCR.
+// If an amount, label or message is set, a `decred:` payment uri that requests them is shown with the address.
 type ReceiveCommand struct {
 	commanderStub
-	Args ReceiveCommandArgs `positional-args:"yes"`
+	Amount  string             `long:"amount" description:"Amount of DCR to request"`
+	Label   string             `long:"label" description:"Name of the recipient to show to the sender"`
+	Message string             `long:"message" description:"Description of the payment to show to the sender"`
+	Args    ReceiveCommandArgs `positional-args:"yes"`
 }
 type ReceiveCommandArgs struct {
 	AccountName string `positional-arg-name:"account-name" description:"The name of the account to receive into"`
@@ -21,6 +26,11 @@ type ReceiveCommandArgs struct {
 
 // Run runs the `receive` command.
 func (receiveCommand ReceiveCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	requestedAmount, err := paymenturi.ParseAmount(receiveCommand.Amount)
+	if err != nil {
+		return err
+	}
+
 	var accountNumber uint32
 	// if no account name was passed in
 	if receiveCommand.Args.AccountName == "" {
@@ -48,6 +58,19 @@ func (receiveCommand ReceiveCommand) Run(ctx context.Context, wallet walletcore.
 	// Print out address as string
 	fmt.Println(receiveAddress)
 
+	// the qr code encodes the payment uri if any payment detail is requested
+	qrContent := receiveAddress
+	paymentRequest := paymenturi.PaymentRequest{
+		Address: receiveAddress,
+		Amount:  requestedAmount,
+		Label:   receiveCommand.Label,
+		Message: receiveCommand.Message,
+	}
+	if paymentRequest.HasDetails() {
+		qrContent = paymentRequest.String()
+		fmt.Println(qrContent)
+	}
+
 	// Print out QR code?
 	printQR, err := terminalprompt.RequestYesNoConfirmation("View QR code?", "N")
 	if err != nil {
@@ -55,7 +78,7 @@ func (receiveCommand ReceiveCommand) Run(ctx context.Context, wallet walletcore.
 	}
 
 	if printQR {
-		qr, err := qrcode.New(receiveAddress, qrcode.Medium)
+		qr, err := qrcode.New(qrContent, qrcode.Medium)
 		if err != nil {
 			return fmt.Errorf("error generating QR code, %s", err.Error())
 		}
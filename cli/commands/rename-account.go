@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+)
+
+// RenameAccountCommand changes the name of a wallet account.
+type RenameAccountCommand struct {
+	commanderStub
+	Args RenameAccountCommandArgs `positional-args:"yes"`
+}
+type RenameAccountCommandArgs struct {
+	AccountName string `positional-arg-name:"account-name" description:"Current name of the account" required:"yes"`
+	NewName     string `positional-arg-name:"new-name" description:"New name for the account" required:"yes"`
+}
+
+// Run runs the `renameaccount` command.
+func (renameAccountCommand RenameAccountCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	accountNumber, err := wallet.AccountNumber(renameAccountCommand.Args.AccountName)
+	if err != nil {
+		return fmt.Errorf("error looking up account: %s", err.Error())
+	}
+
+	err = wallet.RenameAccount(accountNumber, renameAccountCommand.Args.NewName)
+	if err != nil {
+		return fmt.Errorf("error renaming account: %s", err.Error())
+	}
+
+	clilog.LogInfo("Account renamed successfully")
+	return nil
+}
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+)
+
+// RevokeTicketsCommand revokes all missed and expired tickets in the wallet.
+type RevokeTicketsCommand struct {
+	privateKeysCommanderStub
+}
+
+// Run runs the `revoketickets` command.
+func (revokeTicketsCommand RevokeTicketsCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	stakeInfo, err := wallet.StakeInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting stake info: %s", err.Error())
+	}
+	if stakeInfo.Missed == 0 && stakeInfo.Expired == 0 {
+		clilog.LogInfo("There are no missed or expired tickets to revoke")
+		return nil
+	}
+
+	passphrase, err := getWalletPassphrase()
+	if err != nil {
+		return err
+	}
+
+	err = wallet.RevokeTickets(ctx, passphrase)
+	if err != nil {
+		return err
+	}
+
+	clilog.LogInfo("Missed and expired tickets revoked successfully")
+	return nil
+}
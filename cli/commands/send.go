@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/decred/dcrd/dcrutil"
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
@@ -13,27 +14,41 @@ import (
 
 // SendCommand lets the user send DCR.
 type SendCommand struct {
-	commanderStub
-	SpendUnconfirmed bool `short:"u" long:"spendunconfirmed" description:"Use unconfirmed outputs for send transactions."`
+	privateKeysCommanderStub
+	SpendUnconfirmed bool   `short:"u" long:"spendunconfirmed" description:"Use unconfirmed outputs for send transactions."`
+	FeeRate          int64  `long:"feerate" description:"Fee rate in atoms/kB to pay for the transaction, defaults to the minimum fee rate relayed by the network."`
+	CoinSelection    string `long:"coinselection" description:"Strategy for automatically selecting inputs, defaults to the coinselection setting in the config file {largest-first, smallest-first, branch-and-bound, oldest-first, privacy}"`
 }
 
 // Run runs the `send` command.
 func (s SendCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
-	return send(wallet, s.SpendUnconfirmed, false)
+	return send(wallet, s.SpendUnconfirmed, s.FeeRate, s.CoinSelection, false)
 }
 
 // SendCustomCommand sends DCR using coin control.
 type SendCustomCommand struct {
-	commanderStub
-	SpendUnconfirmed bool `short:"u" long:"spendunconfirmed" description:"Use unconfirmed outputs for send transactions."`
+	privateKeysCommanderStub
+	SpendUnconfirmed bool   `short:"u" long:"spendunconfirmed" description:"Use unconfirmed outputs for send transactions."`
+	FeeRate          int64  `long:"feerate" description:"Fee rate in atoms/kB to pay for the transaction, defaults to the minimum fee rate relayed by the network."`
+	CoinSelection    string `long:"coinselection" description:"Strategy for automatically selecting inputs, defaults to the coinselection setting in the config file {largest-first, smallest-first, branch-and-bound, oldest-first, privacy}"`
 }
 
 // Run runs the `send-custom` command.
 func (s SendCustomCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
-	return send(wallet, s.SpendUnconfirmed, true)
+	return send(wallet, s.SpendUnconfirmed, s.FeeRate, s.CoinSelection, true)
 }
 
-func send(wallet walletcore.Wallet, spendUnconfirmed bool, custom bool) error {
+func send(wallet walletcore.Wallet, spendUnconfirmed bool, feeRate int64, coinSelection string, custom bool) error {
+	feeRate = feeRateOrDefault(feeRate)
+	if err := walletcore.ValidateFeeRate(feeRate); err != nil {
+		return err
+	}
+
+	coinSelection, err := coinSelectionOrDefault(coinSelection)
+	if err != nil {
+		return err
+	}
+
 	var requiredConfirmations int32 = walletcore.DefaultRequiredConfirmations
 	if spendUnconfirmed {
 		requiredConfirmations = 0
@@ -66,9 +81,10 @@ func send(wallet walletcore.Wallet, spendUnconfirmed bool, custom bool) error {
 
 	var sentTxHash string
 	if custom {
-		sentTxHash, err = completeCustomSend(wallet, sourceAccount, sendDestinations, sendAmountTotal, requiredConfirmations)
+		sentTxHash, err = completeCustomSend(wallet, sourceAccount, sendDestinations, sendAmountTotal, requiredConfirmations, feeRate,
+			coinSelection)
 	} else {
-		sentTxHash, err = completeNormalSend(wallet, sourceAccount, sendDestinations, requiredConfirmations)
+		sentTxHash, err = completeNormalSend(wallet, sourceAccount, sendDestinations, requiredConfirmations, feeRate, coinSelection)
 	}
 
 	if err != nil {
@@ -78,7 +94,7 @@ func send(wallet walletcore.Wallet, spendUnconfirmed bool, custom bool) error {
 	return nil
 }
 
-func completeCustomSend(wallet walletcore.Wallet, sourceAccount uint32, sendDestinations []txhelper.TransactionDestination, sendAmountTotal float64, requiredConfirmations int32) (string, error) {
+func completeCustomSend(wallet walletcore.Wallet, sourceAccount uint32, sendDestinations []txhelper.TransactionDestination, sendAmountTotal float64, requiredConfirmations int32, feeRate int64, coinSelection string) (string, error) {
 	var changeOutputDestinations []txhelper.TransactionDestination
 	var utxoSelection []*walletcore.UnspentOutput
 	var totalInputAmount float64
@@ -100,16 +116,21 @@ func completeCustomSend(wallet walletcore.Wallet, sourceAccount uint32, sendDest
 		return "", fmt.Errorf("error in reading choice: %s", err.Error())
 	}
 	if strings.ToLower(choice) == "a" || choice == "" {
-		utxoSelection, totalInputAmount = bestSizedInput(utxos, sendAmountTotal)
+		var selectedAmount dcrutil.Amount
+		utxoSelection, selectedAmount, err = walletcore.SelectCoins(coinSelection, utxos, sendDestinations, feeRate)
+		if err != nil {
+			return "", err
+		}
+		totalInputAmount = selectedAmount.ToCoin()
 	} else {
-		utxoSelection, totalInputAmount, err = getUtxosForNewTransaction(utxos, sendAmountTotal)
+		utxoSelection, totalInputAmount, err = getUtxosForNewTransaction(wallet, utxos, sendAmountTotal)
 		if err != nil {
 			return "", err
 		}
 	}
 
 	changeOutputDestinations, err = getChangeOutputDestinations(wallet, totalInputAmount, sourceAccount,
-		len(utxoSelection), sendDestinations)
+		len(utxoSelection), sendDestinations, feeRate)
 	if err != nil {
 		return "", err
 	}
@@ -144,10 +165,10 @@ func completeCustomSend(wallet walletcore.Wallet, sourceAccount uint32, sendDest
 	for _, utxo := range utxoSelection {
 		outputKeys = append(outputKeys, utxo.OutputKey)
 	}
-	return wallet.SendFromUTXOs(sourceAccount, requiredConfirmations, outputKeys, sendDestinations, changeOutputDestinations, passphrase)
+	return wallet.SendFromUTXOs(sourceAccount, requiredConfirmations, outputKeys, sendDestinations, changeOutputDestinations, feeRate, passphrase)
 }
 
-func completeNormalSend(wallet walletcore.Wallet, sourceAccount uint32, sendDestinations []txhelper.TransactionDestination, requiredConfirmations int32) (string, error) {
+func completeNormalSend(wallet walletcore.Wallet, sourceAccount uint32, sendDestinations []txhelper.TransactionDestination, requiredConfirmations int32, feeRate int64, coinSelection string) (string, error) {
 	passphrase, err := getWalletPassphrase()
 	if err != nil {
 		return "", err
@@ -171,5 +192,5 @@ func completeNormalSend(wallet walletcore.Wallet, sourceAccount uint32, sendDest
 		return "", errors.New("transaction cancelled")
 	}
 
-	return wallet.SendFromAccount(sourceAccount, requiredConfirmations, sendDestinations, passphrase)
+	return wallet.SendFromAccount(sourceAccount, requiredConfirmations, sendDestinations, feeRate, coinSelection, passphrase)
 }
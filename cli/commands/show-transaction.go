@@ -76,6 +76,9 @@ func (showTxCommand ShowTransactionCommand) Run(ctx context.Context, wallet wall
 	txDetailsOutput := strings.Builder{}
 	txDetailsOutput.WriteString("Transaction Details\n")
 	txDetailsOutput.WriteString(basicOutput)
+	if transaction.Label != "" {
+		txDetailsOutput.WriteString(fmt.Sprintf("  Label \t %s\n", transaction.Label))
+	}
 	txDetailsOutput.WriteString("-Inputs- \t \n")
 	for _, input := range transaction.Inputs {
 		inputAmount := formatAmount(input.Amount)
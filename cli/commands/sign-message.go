@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// SignMessageCommand signs a message with the private key of a wallet address.
+type SignMessageCommand struct {
+	privateKeysCommanderStub
+	Args SignMessageCommandArgs `positional-args:"yes"`
+}
+type SignMessageCommandArgs struct {
+	Address string `positional-arg-name:"address" description:"The wallet address whose private key should be used to sign the message" required:"yes"`
+	Message string `positional-arg-name:"message" description:"The message to sign" required:"yes"`
+}
+
+// Run runs the `signmessage` command, displaying the base64-encoded signature.
+func (signMessageCommand SignMessageCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	passphrase, err := getWalletPassphrase()
+	if err != nil {
+		return err
+	}
+
+	signature, err := wallet.SignMessage(signMessageCommand.Args.Address, signMessageCommand.Args.Message, passphrase)
+	if err != nil {
+		return fmt.Errorf("error signing message: %s", err.Error())
+	}
+
+	fmt.Println(signature)
+	return nil
+}
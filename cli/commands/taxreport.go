@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/conversion"
+	"github.com/raedahgroup/godcr/app/taxreport"
+	"github.com/raedahgroup/godcr/app/txrates"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/clilog"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// TaxReportCommand shows the fiat value of the wallet's transactions per year, with gains matched first in first out.
+type TaxReportCommand struct {
+	commanderStub
+	Year     int    `long:"year" description:"Only show the report for this year"`
+	Currency string `long:"currency" description:"Fiat currency of the report, defaults to the fiatcurrency setting"`
+}
+
+// Run runs the `taxreport` command.
+func (taxReportCommand TaxReportCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	cfg, err := config.ReadConfigFile()
+	if err != nil {
+		return fmt.Errorf("error reading config file: %s", err.Error())
+	}
+	currency := reportCurrency(taxReportCommand.Currency, cfg.FiatCurrency)
+
+	rates, err := txrates.Load(cfg.AppDataDir)
+	if err != nil {
+		return fmt.Errorf("error loading transaction rates: %s", err.Error())
+	}
+
+	report, err := taxreport.Generate(wallet, rates, currency)
+	if err != nil {
+		return fmt.Errorf("error generating tax report: %s", err.Error())
+	}
+
+	yearReports := report.Years
+	if taxReportCommand.Year != 0 {
+		yearReport := report.Year(taxReportCommand.Year)
+		if yearReport == nil {
+			fmt.Printf("No transactions in %d\n", taxReportCommand.Year)
+			return nil
+		}
+		yearReports = []*taxreport.YearReport{yearReport}
+	}
+	if len(yearReports) == 0 {
+		fmt.Println("No transactions")
+		return nil
+	}
+
+	columns := []string{"Year", "Received", "Staking Rewards", "Sent", "Fees", "Proceeds", "Cost Basis", "Gains", "Transactions", "Missing Rates"}
+	rows := make([][]interface{}, len(yearReports))
+	var missingRates int
+	for i, yearReport := range yearReports {
+		rows[i] = []interface{}{
+			yearReport.Year,
+			formatFiat(yearReport.Received),
+			formatFiat(yearReport.StakingRewards),
+			formatFiat(yearReport.Sent),
+			formatFiat(yearReport.Fees),
+			formatFiat(yearReport.Proceeds),
+			formatFiat(yearReport.CostBasis),
+			formatFiat(yearReport.Gains),
+			yearReport.TransactionCount,
+			yearReport.MissingRates,
+		}
+		missingRates += yearReport.MissingRates
+	}
+
+	fmt.Printf("Amounts in %s\n", currency)
+	termio.PrintTabularResult(termio.StdoutWriter, columns, rows)
+
+	if missingRates > 0 {
+		clilog.LogWarn(fmt.Sprintf("%d transaction(s) have no %s rate and are valued at 0, fill in their rates with `backfillrates`",
+			missingRates, currency))
+	}
+	return nil
+}
+
+// reportCurrency returns the currency set with a command option, the fiat currency setting or the default currency.
+func reportCurrency(optionValue, settingValue string) string {
+	if currency := conversion.NormalizeCurrency(optionValue); currency != "" {
+		return currency
+	}
+	if currency := conversion.NormalizeCurrency(settingValue); currency != "" {
+		return currency
+	}
+	return conversion.DefaultFiatCurrency
+}
+
+func formatFiat(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/termio"
+)
+
+// TicketsCommand lists the tickets purchased by the wallet with their statuses.
+type TicketsCommand struct {
+	commanderStub
+	Statuses []string `short:"s" long:"status" choice:"unmined" choice:"immature" choice:"live" choice:"voted" choice:"missed" choice:"expired" choice:"revoked" description:"Only show tickets with this status. Can be specified multiple times to show tickets with any of the specified statuses."`
+}
+
+// Run runs the `tickets` command.
+func (ticketsCommand TicketsCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	tickets, err := wallet.Tickets(ctx, &walletcore.TicketFilter{Statuses: ticketsCommand.Statuses})
+	if err != nil {
+		return err
+	}
+
+	if len(tickets) == 0 {
+		termio.PrintStringResult("no tickets found")
+		return nil
+	}
+
+	columns := []string{
+		"Hash",
+		"Height",
+		centerAlignAmountHeader("Price"),
+		"Status",
+		centerAlignAmountHeader("Reward"),
+		"Spender",
+	}
+
+	rows := make([][]interface{}, len(tickets))
+	for i, ticket := range tickets {
+		purchaseHeight := "unmined"
+		if ticket.PurchaseHeight >= 0 {
+			purchaseHeight = fmt.Sprintf("%d", ticket.PurchaseHeight)
+		}
+
+		var reward string
+		if ticket.SpenderHash != "" {
+			reward = formatAmount(int64(ticket.Reward))
+		}
+
+		rows[i] = []interface{}{
+			ticket.Hash,
+			purchaseHeight,
+			formatAmount(int64(ticket.Price)),
+			ticket.Status,
+			reward,
+			ticket.SpenderHash,
+		}
+	}
+
+	termio.PrintTabularResult(termio.StdoutWriter, columns, rows)
+	return nil
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// VerifyMessageCommand checks that a message was signed with the private key of an address.
+type VerifyMessageCommand struct {
+	commanderStub
+	Args VerifyMessageCommandArgs `positional-args:"yes"`
+}
+type VerifyMessageCommandArgs struct {
+	Address   string `positional-arg-name:"address" description:"The address that was used to sign the message" required:"yes"`
+	Message   string `positional-arg-name:"message" description:"The message that was signed" required:"yes"`
+	Signature string `positional-arg-name:"signature" description:"The base64-encoded signature" required:"yes"`
+}
+
+// Run runs the `verifymessage` command.
+func (verifyMessageCommand VerifyMessageCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	valid, err := wallet.VerifyMessage(verifyMessageCommand.Args.Address, verifyMessageCommand.Args.Message,
+		verifyMessageCommand.Args.Signature)
+	if err != nil {
+		return fmt.Errorf("error verifying message: %s", err.Error())
+	}
+
+	if valid {
+		fmt.Println("Valid signature")
+	} else {
+		fmt.Println("Invalid signature")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
+)
+
+// VerifySeedCommand checks a seed entered by the user against the wallet
+// and records the wallet's seed backup as verified if the seed is correct.
+type VerifySeedCommand struct {
+	privateKeysCommanderStub
+}
+
+// Run runs the `verifyseed` command.
+func (verifySeedCommand VerifySeedCommand) Run(ctx context.Context, wallet walletcore.Wallet) error {
+	if wallet.SeedBackupVerified() {
+		fmt.Println("The seed backup of this wallet has already been verified.")
+	}
+
+	seed, err := terminalprompt.RequestInputSecure("Enter your wallet seed", terminalprompt.EmptyValidator)
+	if err != nil {
+		return fmt.Errorf("error reading seed: %s", err.Error())
+	}
+
+	err = wallet.VerifySeedBackup(seed)
+	if err != nil {
+		return fmt.Errorf("error verifying seed backup: %s", err.Error())
+	}
+
+	fmt.Println("Seed backup verified.")
+	return nil
+}
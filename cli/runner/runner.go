@@ -2,6 +2,7 @@ package runner
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/raedahgroup/godcr/app"
@@ -43,6 +44,10 @@ func (runner *CommandRunner) Run(command flags.Commander, args []string, options
 	// inject wallet dependency for commands implementing WalletCommandRunner
 	// the decred wallet is prepared for use before such commands are executed
 	if commandRunner, ok := command.(WalletCommandRunner); ok {
+		if _, requiresPrivateKeys := command.(PrivateKeysCommand); requiresPrivateKeys && runner.walletMiddleware.IsWatchingOnlyWallet() {
+			return fmt.Errorf("%s cannot be used with a watch-only wallet", commandName(runner.parser.Active))
+		}
+
 		walletExists, err := prepareWallet(runner.ctx, runner.walletMiddleware, options)
 		if err != nil || !walletExists {
 			return err
@@ -28,6 +28,13 @@ type WalletMiddlewareCommandRunner interface {
 	flags.Commander
 }
 
+// PrivateKeysCommand is implemented by wallet commands that require the wallet's private keys
+// such as commands that send funds, purchase tickets or sign data.
+// Such commands are not run if the wallet is watching-only.
+type PrivateKeysCommand interface {
+	RequiresPrivateKeys()
+}
+
 // ParserCommandRunner defines the Run method that cli commands that depends on
 // flags.Parser can implement to have it injected at run time
 type ParserCommandRunner interface {
@@ -14,6 +14,7 @@ import (
 	"github.com/decred/dcrwallet/walletseed"
 	"github.com/raedahgroup/dcrlibwallet/utils"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/seedbackup"
 	"github.com/raedahgroup/godcr/app/walletmediums/dcrlibwallet"
 	"github.com/raedahgroup/godcr/cli/termio"
 	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
@@ -46,7 +47,13 @@ func createWallet(ctx context.Context, cfg *config.Config) (dcrlibwalletMiddlewa
 		return
 	}
 
-	// user says they have backed up the generated wallet seed, finalize wallet creation
+	// ask user to confirm some words from the seed to be sure it was backed up
+	seedBackupVerified, err := runSeedBackupQuiz(seed)
+	if err != nil {
+		return
+	}
+
+	// finalize wallet creation
 	err = dcrlibwalletMiddleware.CreateWallet(newWalletPassphrase, seed)
 	if err != nil {
 		return nil, fmt.Errorf("\nError creating wallet: %s.", err.Error())
@@ -54,6 +61,15 @@ func createWallet(ctx context.Context, cfg *config.Config) (dcrlibwalletMiddlewa
 	fmt.Printf("Decred %s wallet created successfully at\n", dcrlibwalletMiddleware.NetType())
 	fmt.Println(dcrlibwalletMiddleware.WalletDbDir)
 
+	if seedBackupVerified {
+		err = dcrlibwalletMiddleware.VerifySeedBackup(seed)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving seed backup status: %s\n", err.Error())
+		}
+	} else {
+		fmt.Println("Your seed backup has not been verified. Run `godcr-cli verifyseed` once you have written down your seed.")
+	}
+
 	sync, err := runInitialSync(cfg)
 	if err != nil || !sync {
 		return dcrlibwalletMiddleware, err
@@ -97,6 +113,48 @@ func restoreWallet(ctx context.Context, cfg *config.Config) (dcrlibwalletMiddlew
 	fmt.Printf("Decred %s wallet created successfully at\n", dcrlibwalletMiddleware.NetType())
 	fmt.Println(dcrlibwalletMiddleware.WalletDbDir)
 
+	// the seed was entered by the user, so they evidently have a backup of it
+	err = dcrlibwalletMiddleware.VerifySeedBackup(seed)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving seed backup status: %s\n", err.Error())
+	}
+
+	sync, err := runInitialSync(cfg)
+	if err != nil || !sync {
+		return dcrlibwalletMiddleware, err
+	}
+
+	return dcrlibwalletMiddleware, SyncBlockChain(ctx, dcrlibwalletMiddleware)
+}
+
+// createWatchingOnlyWallet creates a new wallet using the dcrlibwallet WalletMiddleware.
+// User is prompted to select the network type for the wallet to be created.
+// User is asked to enter the extended public key of the account to watch.
+// The wallet is created without private keys, so it can only be used to track balances and transactions.
+func createWatchingOnlyWallet(ctx context.Context, cfg *config.Config) (dcrlibwalletMiddleware *dcrlibwallet.DcrWalletLib, err error) {
+	newWalletNetwork, err := requestNetworkTypeForNewWallet()
+	if err != nil {
+		return
+	}
+
+	// create dcrlibwallet wallet middleware and check if wallet of this type already exist
+	dcrlibwalletMiddleware, err = prepareMiddlewareToCreateNewWallet(ctx, newWalletNetwork)
+	if err != nil {
+		return
+	}
+
+	extendedPublicKey, err := terminalprompt.RequestInput("Enter extended public key of the account to watch", terminalprompt.EmptyValidator)
+	if err != nil {
+		return nil, fmt.Errorf("\nError reading extended public key: %s.", err.Error())
+	}
+
+	err = dcrlibwalletMiddleware.CreateWatchingOnlyWallet(strings.TrimSpace(extendedPublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("\nError creating wallet: %s.", err.Error())
+	}
+	fmt.Printf("Decred %s watch-only wallet created successfully at\n", dcrlibwalletMiddleware.NetType())
+	fmt.Println(dcrlibwalletMiddleware.WalletDbDir)
+
 	sync, err := runInitialSync(cfg)
 	if err != nil || !sync {
 		return dcrlibwalletMiddleware, err
@@ -158,7 +216,7 @@ func prepareMiddlewareToCreateNewWallet(ctx context.Context, newWalletNetwork st
 		networkDir = fmt.Sprintf("%s-%d", newWalletNetwork, networkDirSuffix)
 	}
 
-	return dcrlibwallet.Connect(ctx, walletDbDir, newWalletNetwork)
+	return dcrlibwallet.Connect(ctx, cfg.AppDataDir, walletDbDir, newWalletNetwork, dcrlibwallet.SyncOptionsFromConfig(cfg))
 }
 
 // requestNewWalletPassphrase asks user to enter private passphrase for new wallet twice.
@@ -219,6 +277,46 @@ func generateNewWalletSeedAndDisplay() (string, error) {
 	return seedWords, nil
 }
 
+// runSeedBackupQuiz asks the user to enter the words at a few randomly chosen positions in `seed`.
+// The user may retry after a wrong answer or skip the quiz, in which case false is returned
+// and the wallet is created with an unverified seed backup.
+func runSeedBackupQuiz(seed string) (bool, error) {
+	fmt.Println("Confirm that you have written down your seed by entering the requested words.")
+
+	seedWordCount := len(seedbackup.SeedWords(seed))
+	for {
+		positions, err := seedbackup.QuizPositions(seedWordCount, seedbackup.QuizWordCount)
+		if err != nil {
+			return false, fmt.Errorf("\nError preparing seed backup verification: %s.", err.Error())
+		}
+
+		allWordsCorrect := true
+		for _, position := range positions {
+			word, err := terminalprompt.RequestInput(fmt.Sprintf("Enter word #%d", position), terminalprompt.EmptyValidator)
+			if err != nil {
+				return false, fmt.Errorf("\nError reading seed word: %s.", err.Error())
+			}
+			if !seedbackup.CheckWord(seed, position, word) {
+				fmt.Printf("Word #%d is incorrect.\n", position)
+				allWordsCorrect = false
+				break
+			}
+		}
+		if allWordsCorrect {
+			fmt.Println("Seed backup verified.")
+			return true, nil
+		}
+
+		retry, err := terminalprompt.RequestYesNoConfirmation("Would you like to try again?", "Y")
+		if err != nil {
+			return false, fmt.Errorf("\nError reading your response: %s.", err.Error())
+		}
+		if !retry {
+			return false, nil
+		}
+	}
+}
+
 func requestAndValidateWalletSeed() (string, error) {
 	fmt.Print("Enter existing wallet seed (followed by a blank line): ")
 
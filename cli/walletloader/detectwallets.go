@@ -44,31 +44,12 @@ func DetectWallets(ctx context.Context, cfg *config.Config) (*dcrlibwallet.DcrWa
 }
 
 func findWalletsInDirectory(walletDir, walletSource string) (wallets []*WalletInfo, err error) {
-	// netType checks if the name of the directory where a wallet.db file was found is the name of a known/supported network type
-	// dcrwallet, decredition and dcrlibwallet place wallet db files in "mainnet" or "testnet3" directories
-	// returns nil if the directory used does not correspond to a known/supported network type
-	detectNetParams := func(path string) *netparams.Params {
-		walletDbDir := filepath.Dir(path)
-		dirName := filepath.Base(walletDbDir)
-
-		// check if folder name starts with any of the supported nettypes
-		if strings.Index(dirName, "mainnet") == 0 {
-			return utils.NetParams("mainnet")
-		} else if strings.Index(dirName, "testnet3") == 0 {
-			return utils.NetParams("testnet3")
-		} else if strings.Index(dirName, "simnet") == 0 {
-			return utils.NetParams("simnet")
-		}
-
-		return nil
-	}
-
 	err = filepath.Walk(walletDir, func(path string, file os.FileInfo, err error) error {
 		if err != nil || file.IsDir() || file.Name() != app.WalletDbFileName {
 			return nil
 		}
 
-		netParams := detectNetParams(path)
+		netParams := walletDbDirNetParams(filepath.Dir(path))
 		if netParams == nil {
 			return nil
 		}
@@ -83,13 +64,32 @@ func findWalletsInDirectory(walletDir, walletSource string) (wallets []*WalletIn
 	return
 }
 
+// walletDbDirNetParams checks if the name of the directory where a wallet.db file was found is the name of a known/supported network type
+// dcrwallet, decredition and dcrlibwallet place wallet db files in "mainnet" or "testnet3" directories
+// returns nil if the directory used does not correspond to a known/supported network type
+func walletDbDirNetParams(walletDbDir string) *netparams.Params {
+	dirName := filepath.Base(walletDbDir)
+
+	// check if folder name starts with any of the supported nettypes
+	if strings.Index(dirName, "mainnet") == 0 {
+		return utils.NetParams("mainnet")
+	} else if strings.Index(dirName, "testnet3") == 0 {
+		return utils.NetParams("testnet3")
+	} else if strings.Index(dirName, "simnet") == 0 {
+		return utils.NetParams("simnet")
+	}
+
+	return nil
+}
+
 func askToCreateOrRestoreWallet(ctx context.Context, cfg *config.Config) (*dcrlibwallet.DcrWalletLib, error) {
-	prompt := "No wallets found. Do you want to (c)reate a new one or (r)estore from seed backup?"
+	prompt := "No wallets found. Do you want to (c)reate a new one, (r)estore from seed backup " +
+		"or create a (w)atch-only wallet from an extended public key?"
 	userResponse, err := terminalprompt.RequestInput(prompt, func(input string) error {
-		if strings.EqualFold("c", input) || strings.EqualFold("r", input) {
+		if strings.EqualFold("c", input) || strings.EqualFold("r", input) || strings.EqualFold("w", input) {
 			return nil
 		}
-		return fmt.Errorf("invalid choice, please enter 'c' or 'r'")
+		return fmt.Errorf("invalid choice, please enter 'c', 'r' or 'w'")
 	})
 	if err != nil {
 		// There was an error reading input; we cannot proceed.
@@ -104,6 +104,10 @@ func askToCreateOrRestoreWallet(ctx context.Context, cfg *config.Config) (*dcrli
 		return restoreWallet(ctx, cfg)
 	}
 
+	if strings.EqualFold("w", userResponse) {
+		return createWatchingOnlyWallet(ctx, cfg)
+	}
+
 	fmt.Println("Maybe later. Bye.")
 	return nil, nil
 }
@@ -112,19 +116,21 @@ func askToCreateOrRestoreWallet(ctx context.Context, cfg *config.Config) (*dcrli
 func listWalletsForSelection(ctx context.Context, cfg *config.Config, allDetectedWallets []*WalletInfo) (*dcrlibwallet.DcrWalletLib, error) {
 	// this function will be called when a user responds to the prompt to select wallet
 	var selectedWallet *WalletInfo
-	var restoreWalletSelected bool
+	var restoreWalletSelected, watchingOnlyWalletSelected bool
 	validateWalletSelection := func(selection string) error {
-		if selection == "" || strings.EqualFold(selection, "C") || strings.EqualFold(selection, "R") {
+		if selection == "" || strings.EqualFold(selection, "C") || strings.EqualFold(selection, "R") ||
+			strings.EqualFold(selection, "W") {
 			restoreWalletSelected = strings.EqualFold(selection, "R")
+			watchingOnlyWalletSelected = strings.EqualFold(selection, "W")
 			return nil
 		}
 
 		selectedIndex, err := strconv.Atoi(selection)
 		if err != nil || selectedIndex < 1 || selectedIndex > len(allDetectedWallets) {
 			if len(allDetectedWallets) == 1 {
-				return fmt.Errorf("\nInvalid selection. Enter '1', 'C' or 'R'.")
+				return fmt.Errorf("\nInvalid selection. Enter '1', 'C', 'R' or 'W'.")
 			}
-			return fmt.Errorf("\nInvalid selection. Enter a number between 1 and %d or enter 'C' or 'R'.",
+			return fmt.Errorf("\nInvalid selection. Enter a number between 1 and %d or enter 'C', 'R' or 'W'.",
 				len(allDetectedWallets))
 		}
 
@@ -138,6 +144,7 @@ func listWalletsForSelection(ctx context.Context, cfg *config.Config, allDetecte
 	}
 	fmt.Println("(C)reate a new wallet.")
 	fmt.Println("(R)estore wallet from seed.")
+	fmt.Println("(W)atch-only wallet from extended public key.")
 
 	for {
 		response, err := terminalprompt.RequestInput("Select the wallet to use for this session", validateWalletSelection)
@@ -157,7 +164,8 @@ func listWalletsForSelection(ctx context.Context, cfg *config.Config, allDetecte
 		if len(allDetectedWallets) == 1 {
 			promptToSaveDefaultWallet(selectedWallet.DbDir)
 		}
-		return dcrlibwallet.Connect(ctx, selectedWallet.DbDir, selectedWallet.Network)
+		return dcrlibwallet.Connect(ctx, cfg.AppDataDir, selectedWallet.DbDir, selectedWallet.Network,
+			dcrlibwallet.SyncOptionsFromConfig(cfg.ConfFileOptions))
 	}
 
 	// did user chose to restore wallet?
@@ -165,6 +173,11 @@ func listWalletsForSelection(ctx context.Context, cfg *config.Config, allDetecte
 		return restoreWallet(ctx, cfg)
 	}
 
+	// did user chose to create a watch-only wallet?
+	if watchingOnlyWalletSelected {
+		return createWatchingOnlyWallet(ctx, cfg)
+	}
+
 	// final possible valid option is create wallet
 	return createWallet(ctx, cfg)
 }
@@ -0,0 +1,88 @@
+package walletloader
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/walletmediums/dcrlibwallet"
+)
+
+// OpenNamedWallets opens all wallets listed in the wallets config option and adds them to a wallet manager.
+// The wallet set by the wallet option, either by name or directory, is made the current wallet if it is one of them,
+// otherwise the first listed wallet is used.
+// Returns nil if no named wallets are configured.
+func OpenNamedWallets(ctx context.Context, cfg *config.Config) (*app.WalletManager, error) {
+	namedWallets, err := cfg.NamedWallets()
+	if err != nil || len(namedWallets) == 0 {
+		return nil, err
+	}
+
+	walletManager := app.NewWalletManager()
+	for _, namedWallet := range namedWallets {
+		walletMiddleware, err := ConnectWalletDir(ctx, cfg.AppDataDir, namedWallet.Dir, dcrlibwallet.SyncOptionsFromConfig(cfg.ConfFileOptions))
+		if err != nil {
+			walletManager.CloseWallets()
+			return nil, fmt.Errorf("error opening wallet %s: %s", namedWallet.Name, err.Error())
+		}
+
+		if err = walletManager.Add(namedWallet.Name, walletMiddleware); err != nil {
+			walletMiddleware.CloseWallet()
+			walletManager.CloseWallets()
+			return nil, err
+		}
+		fmt.Printf("Using wallet %s (%s)\n", namedWallet.Name, namedWallet.Dir)
+	}
+
+	walletDir, err := cfg.WalletDir()
+	if err != nil {
+		walletManager.CloseWallets()
+		return nil, err
+	}
+	for _, namedWallet := range namedWallets {
+		if namedWallet.Dir == walletDir {
+			walletManager.Switch(namedWallet.Name)
+			break
+		}
+	}
+
+	return walletManager, nil
+}
+
+// WalletDbDirNetType returns the network of the wallet in `walletDbDir` as determined from the directory name,
+// e.g. testnet3 for a ~/.godcr/testnet3-1 directory. Returns the directory name if it doesn't start with a known network.
+func WalletDbDirNetType(walletDbDir string) string {
+	if netParams := walletDbDirNetParams(walletDbDir); netParams != nil {
+		return netParams.Name
+	}
+	return filepath.Base(walletDbDir)
+}
+
+// ConnectWalletDir opens the wallet database in `walletDbDir`, the network of the wallet is determined from the directory name.
+// An error is returned if there is no wallet in the directory.
+func ConnectWalletDir(ctx context.Context, appDataDir, walletDbDir string, syncOptions dcrlibwallet.SyncOptions) (*dcrlibwallet.DcrWalletLib, error) {
+	netParams := walletDbDirNetParams(walletDbDir)
+	if netParams == nil {
+		return nil, fmt.Errorf("cannot tell the network of the wallet in %s, "+
+			"the directory name should start with mainnet, testnet3 or simnet", walletDbDir)
+	}
+
+	walletMiddleware, err := dcrlibwallet.Connect(ctx, appDataDir, walletDbDir, netParams.Name, syncOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	walletExists, err := walletMiddleware.WalletExists()
+	if err != nil {
+		walletMiddleware.CloseWallet()
+		return nil, fmt.Errorf("error checking %s for wallet database: %s", walletDbDir, err.Error())
+	}
+	if !walletExists {
+		walletMiddleware.CloseWallet()
+		return nil, fmt.Errorf("no wallet found in %s", walletDbDir)
+	}
+
+	return walletMiddleware, nil
+}
@@ -7,21 +7,23 @@ import (
 
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
 	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/events"
 )
 
 // todo review usages
 // syncBlockChain uses the WalletMiddleware provided to download block updates
 // this is a long running operation, listen for ctx.Done and stop processing
 func SyncBlockChain(ctx context.Context, walletMiddleware app.WalletMiddleware) error {
-	syncError := make(chan error)
+	syncError := make(chan error, 1)
 	var syncDone bool
 
-	processSyncUpdates := func(report *defaultsynclistener.ProgressReport) {
+	// sync progress is read from the wallet's events, which are delivered one at a time
+	unsubscribe := walletMiddleware.Events().Subscribe(func(event events.Event) {
 		if syncDone {
 			return
 		}
 
-		progressReport := report.Read()
+		progressReport := event.Data.(events.SyncProgressData)
 
 		if progressReport.Done {
 			syncDone = true
@@ -34,10 +36,11 @@ func SyncBlockChain(ctx context.Context, walletMiddleware app.WalletMiddleware)
 			}
 			return
 		}
-	}
+	}, events.SyncProgress)
+	defer unsubscribe()
 
 	fmt.Println("Sync started.")
-	walletMiddleware.SyncBlockChain(true, processSyncUpdates)
+	walletMiddleware.SyncBlockChain(true, func(_ *defaultsynclistener.ProgressReport) {})
 
 	// wait for context cancel or sync done trigger before exiting function
 	select {
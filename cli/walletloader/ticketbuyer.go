@@ -0,0 +1,34 @@
+package walletloader
+
+import (
+	"fmt"
+
+	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
+)
+
+// RequestTicketBuyerPassphrases asks for the spending passphrase of each wallet that can buy tickets
+// if the ticket buyer is enabled in the config file, so that interfaces can start the ticket buyers on launch.
+// The returned map is keyed by wallet name and is nil if the ticket buyer is not enabled.
+func RequestTicketBuyerPassphrases(walletManager *app.WalletManager, cfg config.TicketBuyerConfig) (map[string]string, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	passphrases := make(map[string]string)
+	for _, walletName := range walletManager.Names() {
+		wallet, _ := walletManager.Wallet(walletName)
+		if wallet.IsWatchingOnlyWallet() {
+			continue
+		}
+
+		passphrase, err := terminalprompt.RequestInputSecure(
+			fmt.Sprintf("Enter spending passphrase of wallet %s to start the ticket buyer", walletName), terminalprompt.EmptyValidator)
+		if err != nil {
+			return nil, fmt.Errorf("\nError reading spending passphrase: %s.", err.Error())
+		}
+		passphrases[walletName] = passphrase
+	}
+	return passphrases, nil
+}
@@ -7,6 +7,7 @@ require (
 	github.com/jessevdk/go-flags v1.4.0
 	github.com/jrick/logrotate v1.0.0
 	github.com/raedahgroup/godcr/app v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/addressbook v0.0.0-00010101000000-000000000000
 	github.com/raedahgroup/godcr/cli v0.0.0-00010101000000-000000000000
 	github.com/raedahgroup/godcr/nuklear v0.0.0-00010101000000-000000000000
 	github.com/raedahgroup/godcr/terminal v0.0.0-00010101000000-000000000000 // indirect
@@ -15,6 +16,13 @@ require (
 
 replace (
 	github.com/raedahgroup/godcr/app => ../app
+	github.com/raedahgroup/godcr/app/addressbook => ../app/addressbook
+	github.com/raedahgroup/godcr/app/events => ../app/events
+	github.com/raedahgroup/godcr/app/jsonfile => ../app/jsonfile
+	github.com/raedahgroup/godcr/app/paymenturi => ../app/paymenturi
+	github.com/raedahgroup/godcr/app/seedbackup => ../app/seedbackup
+	github.com/raedahgroup/godcr/app/txfilter => ../app/txfilter
+	github.com/raedahgroup/godcr/app/txlabels => ../app/txlabels
 	github.com/raedahgroup/godcr/cli => ../cli
 	github.com/raedahgroup/godcr/nuklear => ../nuklear
 	github.com/raedahgroup/godcr/terminal => ../terminal
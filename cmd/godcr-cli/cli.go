@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"sync"
 
 	"github.com/jessevdk/go-flags"
@@ -13,6 +12,7 @@ import (
 	"github.com/raedahgroup/godcr/app/help"
 	"github.com/raedahgroup/godcr/app/walletmediums/dcrlibwallet"
 	"github.com/raedahgroup/godcr/app/walletmediums/dcrwalletrpc"
+	"github.com/raedahgroup/godcr/app/walletmediums/mockwallet"
 	"github.com/raedahgroup/godcr/cli"
 	"github.com/raedahgroup/godcr/cli/commands"
 	"github.com/raedahgroup/godcr/cli/runner"
@@ -136,7 +136,12 @@ func attemptExecuteSimpleOp() (isSimpleOp bool, err error) {
 // connectToWallet opens connection to a wallet via any of the available walletmiddleware
 // default is connecting directly to a wallet database file via dcrlibwallet
 // alternative is connecting to wallet database via dcrwallet rpc (if rpc server address is provided)
+// or using an in-memory mock wallet (if the mockwallet flag or config option is set)
 func connectToWallet(ctx context.Context, cfg *config.Config) (app.WalletMiddleware, error) {
+	if cfg.MockWallet || cfg.UseMockWallet {
+		return connectToMockWallet()
+	}
+
 	if cfg.WalletRPCServer == "" {
 		walletMiddleware, err := connectViaDcrlibwallet(ctx, cfg)
 
@@ -151,14 +156,20 @@ func connectToWallet(ctx context.Context, cfg *config.Config) (app.WalletMiddlew
 	return connectViaDcrWalletRPC(ctx, cfg)
 }
 
-// connectViaDcrWalletRPC attempts to load the database at `cfg.DefaultWalletDir`.
+// connectViaDcrlibwallet attempts to load the database at the directory set by the wallet option.
 // Prompts user to select wallet to connect to if default wallet dir isn't set
 // or wallet could not be found at set default dir.
 func connectViaDcrlibwallet(ctx context.Context, cfg *config.Config) (*dcrlibwallet.DcrWalletLib, error) {
+	// the wallet option may be the name of one of the named wallets or a wallet directory
+	defaultWalletDir, err := cfg.WalletDir()
+	if err != nil {
+		return nil, err
+	}
+
 	// attempt to load default wallet if set and wallet db can be found
-	if cfg.DefaultWalletDir != "" {
-		netType := filepath.Base(cfg.DefaultWalletDir)
-		walletMiddleware, err := dcrlibwallet.Connect(ctx, cfg.DefaultWalletDir, netType)
+	if defaultWalletDir != "" {
+		netType := walletloader.WalletDbDirNetType(defaultWalletDir)
+		walletMiddleware, err := dcrlibwallet.Connect(ctx, cfg.AppDataDir, defaultWalletDir, netType, dcrlibwallet.SyncOptionsFromConfig(cfg.ConfFileOptions))
 		if err != nil {
 			return nil, err
 		}
@@ -170,7 +181,7 @@ func connectViaDcrlibwallet(ctx context.Context, cfg *config.Config) (*dcrlibwal
 		}
 
 		if defaultWalletExists {
-			fmt.Println("Using wallet", cfg.DefaultWalletDir)
+			fmt.Println("Using wallet", defaultWalletDir)
 			return walletMiddleware, nil
 		}
 	}
@@ -198,3 +209,14 @@ func connectViaDcrWalletRPC(ctx context.Context, cfg *config.Config) (*dcrwallet
 
 	return rpcWalletMiddleware, nil
 }
+
+// connectToMockWallet creates an in-memory wallet loaded with sample data on testnet
+func connectToMockWallet() (*mockwallet.MockWallet, error) {
+	mockWallet, err := mockwallet.Connect("testnet3")
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Using mock wallet, spending passphrase is '%s'\n", mockwallet.PrivatePassphrase)
+	return mockWallet, nil
+}
@@ -2,8 +2,6 @@ module github.com/raedahgroup/godcr/cmd/godcr-fyne
 
 go 1.12
 
-replace github.com/raedahgroup/godcr/fyne => ../../fyne
-
 replace github.com/raedahgroup/dcrlibwallet => github.com/C-ollins/mobilewallet v1.0.0-rc1.0.20191206032901-ef455a3cc250
 
 replace github.com/raedahgroup/dcrlibwallet/spv => github.com/C-ollins/mobilewallet/spv v0.0.0-20191206032901-ef455a3cc250
@@ -12,3 +10,15 @@ require (
 	github.com/decred/dcrd/dcrutil v1.4.0
 	github.com/raedahgroup/godcr/fyne v0.0.0-00010101000000-000000000000
 )
+
+replace (
+	github.com/raedahgroup/godcr/app => ../../app
+	github.com/raedahgroup/godcr/app/addressbook => ../../app/addressbook
+	github.com/raedahgroup/godcr/app/events => ../../app/events
+	github.com/raedahgroup/godcr/app/jsonfile => ../../app/jsonfile
+	github.com/raedahgroup/godcr/app/paymenturi => ../../app/paymenturi
+	github.com/raedahgroup/godcr/app/seedbackup => ../../app/seedbackup
+	github.com/raedahgroup/godcr/app/txfilter => ../../app/txfilter
+	github.com/raedahgroup/godcr/app/txlabels => ../../app/txlabels
+	github.com/raedahgroup/godcr/fyne => ../../fyne
+)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/decred/slog"
 	"github.com/jrick/logrotate/rotator"
+	"github.com/raedahgroup/godcr/app/ticketbuyer"
 	"github.com/raedahgroup/godcr/nuklear/nuklog"
 )
 
@@ -45,17 +46,20 @@ var (
 
 	log        = backendLog.Logger("GODCR")
 	nuklearLog = backendLog.Logger("NUKL")
+	tkbyLog    = backendLog.Logger("TKBY")
 )
 
 // Initialize package-global logger variables.
 func init() {
 	nuklog.UseLogger(nuklearLog)
+	ticketbuyer.UseLogger(tkbyLog)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
 var subsystemLoggers = map[string]slog.Logger{
 	"GODCR": log,
 	"NUKL":  nuklearLog,
+	"TKBY":  tkbyLog,
 }
 
 // initLogRotator initializes the logging rotater to write logs to logFile and
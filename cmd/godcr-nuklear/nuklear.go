@@ -4,14 +4,17 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
 	"sync"
 
 	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/addressbook"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/taxreport"
+	"github.com/raedahgroup/godcr/app/txrates"
 	"github.com/raedahgroup/godcr/app/walletmediums/dcrlibwallet"
 	"github.com/raedahgroup/godcr/app/walletmediums/dcrwalletrpc"
+	"github.com/raedahgroup/godcr/app/walletmediums/mockwallet"
 	"github.com/raedahgroup/godcr/cli/walletloader"
 	"github.com/raedahgroup/godcr/nuklear"
 )
@@ -59,24 +62,53 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	shutdownOps = append(shutdownOps, cancel)
 
-	// open connection to wallet and add wallet close function to shutdownOps
-	walletMiddleware, err := connectToWallet(ctx, appConfig)
+	addressBook, err := addressbook.Load(appConfig.AppDataDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to load address book.", err.Error())
+		fmt.Println("Exiting.")
+		os.Exit(1)
+	}
+
+	txRates, err := txrates.Load(appConfig.AppDataDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to load transaction rates.", err.Error())
+		fmt.Println("Exiting.")
+		os.Exit(1)
+	}
+
+	// open connection to wallets and add wallets close function to shutdownOps
+	walletManager, err := connectToWallets(ctx, appConfig)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "Failed to connect to wallet.", err.Error())
 		fmt.Println("Exiting.")
 		os.Exit(1)
 	}
 
-	if walletMiddleware == nil {
+	if walletManager == nil {
 		// there was no error but user did not select a wallet to connect to and did not create a new one
 		os.Exit(0)
 		return
 	}
 
-	shutdownOps = append(shutdownOps, walletMiddleware.CloseWallet)
+	shutdownOps = append(shutdownOps, walletManager.CloseWallets)
+
+	// record the exchange rate of each new transaction so that the tax report has its fiat value when it was made
+	walletManager.RecordTransactionRates(ctx, taxreport.NewRecorder(txRates, &appConfig.Settings), func(walletName string, err error) {
+		log.Errorf("Error recording transaction rates of wallet %s: %s", walletName, err.Error())
+	})
+
+	ticketBuyerPassphrases, err := walletloader.RequestTicketBuyerPassphrases(walletManager, appConfig.TicketBuyer)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		fmt.Println("Exiting.")
+		os.Exit(1)
+	}
 
 	log.Info("Launching desktop app with nuklear")
-	nuklear.LaunchApp(ctx, walletMiddleware, &appConfig.Settings)
+	err = nuklear.LaunchApp(ctx, walletManager, &appConfig.Settings, appConfig.TicketBuyer, ticketBuyerPassphrases, addressBook)
+	if err != nil {
+		log.Errorf("Desktop app stopped: %s", err.Error())
+	}
 	// todo need to properly listen for shutdown and trigger shutdown
 	beginShutdown <- true
 
@@ -84,10 +116,35 @@ func main() {
 	shutdownWaitGroup.Wait()
 }
 
+// connectToWallets opens the wallets listed in the wallets config option, if any,
+// otherwise the single wallet selected by connectToWallet is added to the returned wallet manager.
+func connectToWallets(ctx context.Context, cfg *config.Config) (*app.WalletManager, error) {
+	if !cfg.MockWallet && !cfg.UseMockWallet && cfg.WalletRPCServer == "" {
+		walletManager, err := walletloader.OpenNamedWallets(ctx, cfg)
+		if err != nil || walletManager != nil {
+			return walletManager, err
+		}
+	}
+
+	walletMiddleware, err := connectToWallet(ctx, cfg)
+	if err != nil || walletMiddleware == nil {
+		return nil, err
+	}
+
+	walletManager := app.NewWalletManager()
+	walletManager.Add(app.DefaultWalletName, walletMiddleware)
+	return walletManager, nil
+}
+
 // connectToWallet opens connection to a wallet via any of the available walletmiddleware
 // default is connecting directly to a wallet database file via dcrlibwallet
 // alternative is connecting to wallet database via dcrwallet rpc (if rpc server address is provided)
+// or using an in-memory mock wallet (if the mockwallet flag or config option is set)
 func connectToWallet(ctx context.Context, cfg *config.Config) (app.WalletMiddleware, error) {
+	if cfg.MockWallet || cfg.UseMockWallet {
+		return connectToMockWallet()
+	}
+
 	if cfg.WalletRPCServer == "" {
 		walletMiddleware, err := connectViaDcrlibwallet(ctx, cfg)
 
@@ -102,14 +159,20 @@ func connectToWallet(ctx context.Context, cfg *config.Config) (app.WalletMiddlew
 	return connectViaDcrWalletRPC(ctx, cfg)
 }
 
-// connectViaDcrWalletRPC attempts to load the database at `cfg.DefaultWalletDir`.
+// connectViaDcrlibwallet attempts to load the database at the directory set by the wallet option.
 // Prompts user to select wallet to connect to if default wallet dir isn't set
 // or wallet could not be found at set default dir.
 func connectViaDcrlibwallet(ctx context.Context, cfg *config.Config) (*dcrlibwallet.DcrWalletLib, error) {
+	// the wallet option may be the name of one of the named wallets or a wallet directory
+	defaultWalletDir, err := cfg.WalletDir()
+	if err != nil {
+		return nil, err
+	}
+
 	// attempt to load default wallet if set and wallet db can be found
-	if cfg.DefaultWalletDir != "" {
-		netType := filepath.Base(cfg.DefaultWalletDir)
-		walletMiddleware, err := dcrlibwallet.Connect(ctx, cfg.DefaultWalletDir, netType)
+	if defaultWalletDir != "" {
+		netType := walletloader.WalletDbDirNetType(defaultWalletDir)
+		walletMiddleware, err := dcrlibwallet.Connect(ctx, cfg.AppDataDir, defaultWalletDir, netType, dcrlibwallet.SyncOptionsFromConfig(cfg.ConfFileOptions))
 		if err != nil {
 			return nil, err
 		}
@@ -121,7 +184,7 @@ func connectViaDcrlibwallet(ctx context.Context, cfg *config.Config) (*dcrlibwal
 		}
 
 		if defaultWalletExists {
-			fmt.Println("Using wallet", cfg.DefaultWalletDir)
+			fmt.Println("Using wallet", defaultWalletDir)
 			return walletMiddleware, nil
 		}
 	}
@@ -149,3 +212,14 @@ func connectViaDcrWalletRPC(ctx context.Context, cfg *config.Config) (*dcrwallet
 
 	return rpcWalletMiddleware, nil
 }
+
+// connectToMockWallet creates an in-memory wallet loaded with sample data on testnet
+func connectToMockWallet() (*mockwallet.MockWallet, error) {
+	mockWallet, err := mockwallet.Connect("testnet3")
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Using mock wallet, spending passphrase is '%s'\n", mockwallet.PrivatePassphrase)
+	return mockWallet, nil
+}
@@ -7,4 +7,13 @@ require (
 	github.com/raedahgroup/godcr/terminal v0.0.0-00010101000000-000000000000
 )
 
-replace github.com/raedahgroup/godcr/terminal => ../../terminal
+replace (
+	github.com/raedahgroup/godcr/app/addressbook => ../../app/addressbook
+	github.com/raedahgroup/godcr/app/events => ../../app/events
+	github.com/raedahgroup/godcr/app/jsonfile => ../../app/jsonfile
+	github.com/raedahgroup/godcr/app/paymenturi => ../../app/paymenturi
+	github.com/raedahgroup/godcr/app/seedbackup => ../../app/seedbackup
+	github.com/raedahgroup/godcr/app/txfilter => ../../app/txfilter
+	github.com/raedahgroup/godcr/app/txlabels => ../../app/txlabels
+	github.com/raedahgroup/godcr/terminal => ../../terminal
+)
@@ -1,6 +1,10 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"os"
+
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/raedahgroup/godcr/terminal"
 )
@@ -13,5 +17,15 @@ const appDisplayName = "GoDCR"
 var defaultAppDataDir = dcrutil.AppDataDir("godcr", false)
 
 func main() {
+	mockWallet := flag.Bool("mockwallet", false, "Use an in-memory wallet with sample data instead of a real wallet.")
+	flag.Parse()
+
+	// the mock wallet implements app.WalletMiddleware, this interface uses dcrlibwallet directly instead, see todo above
+	if *mockWallet {
+		fmt.Fprintln(os.Stderr, "The mock wallet is not yet supported by godcr-terminal, it only works with wallets opened by dcrlibwallet.")
+		fmt.Fprintln(os.Stderr, "Use godcr-cli, godcr-web or godcr-nuklear with --mockwallet to try godcr with sample data.")
+		os.Exit(1)
+	}
+
 	terminal.LaunchUserInterface(appDisplayName, defaultAppDataDir, "testnet3")
 }
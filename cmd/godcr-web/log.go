@@ -14,6 +14,7 @@ import (
 
 	"github.com/decred/slog"
 	"github.com/jrick/logrotate/rotator"
+	"github.com/raedahgroup/godcr/app/ticketbuyer"
 	"github.com/raedahgroup/godcr/web/weblog"
 )
 
@@ -43,19 +44,22 @@ var (
 	// application shutdown.
 	logRotator *rotator.Rotator
 
-	log    = backendLog.Logger("GODCR")
-	webLog = backendLog.Logger("WEB")
+	log     = backendLog.Logger("GODCR")
+	webLog  = backendLog.Logger("WEB")
+	tkbyLog = backendLog.Logger("TKBY")
 )
 
 // Initialize package-global logger variables.
 func init() {
 	weblog.UseLogger(webLog)
+	ticketbuyer.UseLogger(tkbyLog)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
 var subsystemLoggers = map[string]slog.Logger{
 	"GODCR": log,
 	"WEB":   webLog,
+	"TKBY":  tkbyLog,
 }
 
 // initLogRotator initializes the logging rotater to write logs to logFile and
@@ -12,6 +12,8 @@ require (
 	github.com/gopherjs/gopherwasm v1.1.0 // indirect
 	github.com/nu7hatch/gouuid v0.0.0-20131221200532-179d4d0c4d8d // indirect
 	github.com/raedahgroup/dcrlibwallet v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/events v0.0.0-00010101000000-000000000000
 	github.com/skip2/go-qrcode v0.0.0-20191027152451-9434209cb086
 	github.com/tadvi/systray v0.0.0-20190226123456-11a2b8fa57af // indirect
 	gopkg.in/toast.v1 v1.0.0-20180812000517-0a84660828b2 // indirect
@@ -20,3 +22,14 @@ require (
 replace github.com/raedahgroup/dcrlibwallet => github.com/C-ollins/mobilewallet v1.0.0-rc1.0.20191206032901-ef455a3cc250
 
 replace github.com/raedahgroup/dcrlibwallet/spv => github.com/C-ollins/mobilewallet/spv v0.0.0-20191206032901-ef455a3cc250
+
+replace (
+	github.com/raedahgroup/godcr/app => ../app
+	github.com/raedahgroup/godcr/app/addressbook => ../app/addressbook
+	github.com/raedahgroup/godcr/app/events => ../app/events
+	github.com/raedahgroup/godcr/app/jsonfile => ../app/jsonfile
+	github.com/raedahgroup/godcr/app/paymenturi => ../app/paymenturi
+	github.com/raedahgroup/godcr/app/seedbackup => ../app/seedbackup
+	github.com/raedahgroup/godcr/app/txfilter => ../app/txfilter
+	github.com/raedahgroup/godcr/app/txlabels => ../app/txlabels
+)
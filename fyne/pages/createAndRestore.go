@@ -3,6 +3,7 @@ package pages
 import (
 	"fmt"
 	"image/color"
+	"log"
 	"strings"
 
 	"fyne.io/fyne"
@@ -40,8 +41,12 @@ func (app *AppInterface) createAndRestoreWalletPage() fyne.CanvasObject {
 	blueBar := canvas.NewRectangle(values.Blue)
 	blueBar.SetMinSize(fyne.NewSize(312, 56))
 
+	darkBlueBar := canvas.NewRectangle(values.DarkerBlueGrayTextColor)
+	darkBlueBar.SetMinSize(fyne.NewSize(312, 56))
+
 	restoreWalletLabel := canvas.NewText("Restore an existing wallet", color.White)
 	createWalletLabel := canvas.NewText("Create a new wallet", color.White)
+	watchOnlyWalletLabel := canvas.NewText("Create a watch-only wallet", color.White)
 
 	createWalletWidget := widgets.NewClickableBox(
 		widget.NewVBox(
@@ -61,13 +66,23 @@ func (app *AppInterface) createAndRestoreWalletPage() fyne.CanvasObject {
 			app.Window.SetContent(app.restoreWalletPage())
 		})
 
+	watchOnlyWalletWidget := widgets.NewClickableBox(widget.NewVBox(
+		fyne.NewContainerWithLayout(layout.NewBorderLayout(nil, nil, nil, nil), darkBlueBar,
+			fyne.NewContainerWithLayout(layout.NewHBoxLayout(),
+				widgets.NewHSpacer(16), widget.NewIcon(icons[assets.Add]), widgets.NewHSpacer(16), watchOnlyWalletLabel))),
+		func() {
+			app.Window.SetContent(app.watchOnlyWalletPage())
+		})
+
 	decredLogo := canvas.NewImageFromResource(icons[assets.DecredLogo])
 	decredLogo.FillMode = canvas.ImageFillOriginal
 
 	createAndRestoreButtons := widget.NewVBox(
 		fyne.NewContainerWithLayout(layout.NewFixedGridLayout(fyne.NewSize(308, 56)), createWalletWidget),
 		widgets.NewVSpacer(5),
-		fyne.NewContainerWithLayout(layout.NewFixedGridLayout(fyne.NewSize(308, 56)), restoreWalletWidget))
+		fyne.NewContainerWithLayout(layout.NewFixedGridLayout(fyne.NewSize(308, 56)), restoreWalletWidget),
+		widgets.NewVSpacer(5),
+		fyne.NewContainerWithLayout(layout.NewFixedGridLayout(fyne.NewSize(308, 56)), watchOnlyWalletWidget))
 
 	// canvas doesnt support escaping characters therefore the hack
 	welcomeLabel := canvas.NewText("Welcome to", color.Black)
@@ -236,3 +251,85 @@ func (app *AppInterface) restoreWalletPage() fyne.CanvasObject {
 		buttonContainer,
 		widgets.NewVSpacer(10)), widgets.NewHSpacer(10))
 }
+
+// watchOnlyWalletPublicPassphrase is the public passphrase that wallets are opened with when godcr-fyne starts,
+// see the OpenWallets call in fyne.go.
+const watchOnlyWalletPublicPassphrase = "public"
+
+// watchOnlyWalletPage lets the user create a wallet from the extended public key of an account.
+// Such a wallet has no private keys, it can only be used to monitor balances and transactions.
+func (app *AppInterface) watchOnlyWalletPage() fyne.CanvasObject {
+	icons, err := assets.GetIcons(assets.Back)
+	if err != nil {
+		return app.displayErrorPage(err.Error())
+	}
+
+	errorLabel := canvas.NewText("", values.ErrorColor)
+	errorLabel.TextSize = 10
+	errorLabel.Hide()
+
+	walletName := widget.NewEntry()
+	walletName.SetPlaceHolder("Wallet name")
+
+	extendedPublicKey := widget.NewMultiLineEntry()
+	extendedPublicKey.SetPlaceHolder("Extended public key")
+
+	displayError := func(err error) {
+		log.Println("Could not create watch-only wallet", err.Error())
+		errorLabel.Text = fmt.Sprintf("Could not create wallet: %s", err.Error())
+		errorLabel.Show()
+		canvas.Refresh(errorLabel)
+	}
+
+	createButton := widget.NewButton("Create", func() {
+		name := strings.TrimSpace(walletName.Text)
+		wallet, err := app.MultiWallet.CreateWatchOnlyWallet(name, watchOnlyWalletPublicPassphrase,
+			strings.TrimSpace(extendedPublicKey.Text))
+		if err != nil {
+			displayError(err)
+			return
+		}
+
+		// dcrlibwallet replaces the name of new wallets with a generated one when saving them.
+		if err = app.MultiWallet.RenameWallet(wallet.ID, name); err != nil {
+			displayError(err)
+			return
+		}
+
+		app.Window.SetFixedSize(false)
+		app.Window.SetOnClosed(nil)
+		app.setupNavigationMenu()
+		app.Window.SetContent(app.tabMenu)
+	})
+	createButton.Disable()
+
+	onChanged := func(string) {
+		if strings.TrimSpace(walletName.Text) == "" || strings.TrimSpace(extendedPublicKey.Text) == "" {
+			createButton.Disable()
+		} else {
+			createButton.Enable()
+		}
+	}
+	walletName.OnChanged = onChanged
+	extendedPublicKey.OnChanged = onChanged
+
+	backButton := widgets.NewImageButton(icons[assets.Back], nil, func() {
+		app.Window.SetContent(app.createAndRestoreWalletPage())
+		app.Window.Resize(fyne.NewSize(370, 626))
+	})
+
+	return widget.NewHBox(widgets.NewHSpacer(10), widget.NewVBox(
+		widgets.NewVSpacer(10),
+		widget.NewHBox(backButton, widgets.NewHSpacer(16), widget.NewLabelWithStyle("Create a watch-only wallet", fyne.TextAlignTrailing, fyne.TextStyle{Bold: true})),
+		widgets.NewVSpacer(18),
+		widget.NewLabelWithStyle("Enter the extended public key of the account to watch.", fyne.TextAlignCenter, fyne.TextStyle{}),
+		widget.NewLabelWithStyle("Funds cannot be spent from a watch-only wallet.", fyne.TextAlignCenter, fyne.TextStyle{}),
+		widgets.NewVSpacer(15),
+		errorLabel,
+		fyne.NewContainerWithLayout(layout.NewFixedGridLayout(fyne.NewSize(320, 40)), walletName),
+		widgets.NewVSpacer(10),
+		fyne.NewContainerWithLayout(layout.NewFixedGridLayout(fyne.NewSize(320, 80)), extendedPublicKey),
+		widgets.NewVSpacer(10),
+		widget.NewHBox(layout.NewSpacer(), createButton, layout.NewSpacer()),
+		widgets.NewVSpacer(10)), widgets.NewHSpacer(10))
+}
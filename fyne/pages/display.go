@@ -108,13 +108,21 @@ func (app *AppInterface) setupNavigationMenu() {
 			case 1:
 				newPageContent = historyPageContent()
 			case 2:
-				newPageContent = sendPageContent(app.MultiWallet, app.Window)
+				if app.watchingOnly() {
+					newPageContent = watchingOnlyPageContent("Funds cannot be sent from a watch-only wallet.")
+				} else {
+					newPageContent = sendPageContent(app.MultiWallet, app.Window)
+				}
 			case 3:
 				newPageContent = receivePageContent(app.MultiWallet, app.Window)
 			case 4:
 				newPageContent = accountsPageContent()
 			case 5:
-				newPageContent = stakingPageContent()
+				if app.watchingOnly() {
+					newPageContent = watchingOnlyPageContent("Tickets cannot be purchased with a watch-only wallet.")
+				} else {
+					newPageContent = stakingPageContent()
+				}
 			default:
 				continue
 			}
@@ -138,6 +146,26 @@ func (app *AppInterface) setupNavigationMenu() {
 	app.walletNotificationListener()
 }
 
+// watchingOnly returns true if none of the opened wallets can be used to spend funds.
+func (app *AppInterface) watchingOnly() bool {
+	openedWalletIDs := app.MultiWallet.OpenedWalletIDsRaw()
+	if len(openedWalletIDs) == 0 {
+		return false
+	}
+
+	for _, walletID := range openedWalletIDs {
+		if !app.MultiWallet.WalletWithID(walletID).IsWatchingOnlyWallet() {
+			return false
+		}
+	}
+	return true
+}
+
+// watchingOnlyPageContent is displayed instead of pages that require the wallet's private keys.
+func watchingOnlyPageContent(message string) fyne.CanvasObject {
+	return widget.NewLabelWithStyle(message, fyne.TextAlignLeading, fyne.TextStyle{Italic: true})
+}
+
 func (app *AppInterface) tearDown() {
 	if app.MultiWallet != nil {
 		app.MultiWallet.Shutdown()
@@ -49,6 +49,7 @@ func (ov *overview) container() fyne.CanvasObject {
 	return widget.NewVBox(
 		widgets.NewVSpacer(values.Padding),
 		title(),
+		ov.seedBackupWarnings(),
 		ov.balance(),
 		widgets.NewVSpacer(50),
 		ov.pageBoxes(),
@@ -0,0 +1,126 @@
+package pages
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"fyne.io/fyne"
+	"fyne.io/fyne/canvas"
+	"fyne.io/fyne/layout"
+	"fyne.io/fyne/widget"
+
+	"github.com/raedahgroup/dcrlibwallet"
+
+	"github.com/raedahgroup/godcr/fyne/pages/handler/values"
+	"github.com/raedahgroup/godcr/fyne/widgets"
+)
+
+// seedBackupQuizWordCount is the number of randomly chosen seed words the user is asked to confirm.
+const seedBackupQuizWordCount = 4
+
+// seedBackupWarnings returns a warning for each opened wallet whose seed has not been verified.
+// dcrlibwallet keeps the seed of a newly created wallet until it is verified, restored wallets have no seed to verify.
+func (ov *overview) seedBackupWarnings() fyne.CanvasObject {
+	warnings := widget.NewVBox()
+	for _, id := range ov.walletIds {
+		wallet := ov.multiWallet.WalletWithID(id)
+		if wallet == nil || wallet.Seed == "" || wallet.IsWatchingOnlyWallet() {
+			continue
+		}
+
+		warningText := canvas.NewText(fmt.Sprintf("The seed of wallet %s has not been backed up", wallet.Name), values.ErrorColor)
+		var warning *widget.Box
+		warning = widget.NewHBox(
+			warningText,
+			widgets.NewHSpacer(values.SpacerSize10),
+			widget.NewButton("Verify seed backup", func() {
+				ov.app.showSeedBackupPopup(wallet, warning.Hide)
+			}),
+		)
+		warnings.Append(warning)
+	}
+	return warnings
+}
+
+// showSeedBackupPopup displays the seed of `wallet`, then asks the user to enter a few randomly chosen words of the seed.
+// `onVerified` is called after the seed backup of the wallet is verified.
+func (app *AppInterface) showSeedBackupPopup(wallet *dcrlibwallet.Wallet, onVerified func()) {
+	var popup *widget.PopUp
+	popupContent := widget.NewVBox()
+
+	popup = widget.NewModalPopUp(widget.NewHBox(widgets.NewHSpacer(values.SpacerSize10),
+		widget.NewVBox(widgets.NewVSpacer(values.SpacerSize10),
+			widget.NewLabelWithStyle("Verify seed backup", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+			popupContent, widgets.NewVSpacer(values.SpacerSize10)),
+		widgets.NewHSpacer(values.SpacerSize10)),
+		app.Window.Canvas())
+
+	seedWords := strings.Fields(wallet.Seed)
+	seedGrid := fyne.NewContainerWithLayout(layout.NewGridLayout(4))
+	for index, word := range seedWords {
+		seedGrid.AddObject(widget.NewLabel(fmt.Sprintf("%d. %s", index+1, word)))
+	}
+
+	showQuiz := func() {
+		popupContent.Children = []fyne.CanvasObject{app.seedBackupQuiz(wallet, seedWords, func() {
+			popup.Hide()
+			onVerified()
+		}, popup.Hide)}
+		widget.Refresh(popupContent)
+	}
+
+	popupContent.Children = []fyne.CanvasObject{
+		widget.NewLabel("Write down your seed and keep it in a safe place. It is the only way to restore your wallet."),
+		seedGrid,
+		widget.NewHBox(layout.NewSpacer(),
+			widget.NewButton("Cancel", popup.Hide),
+			widget.NewButton("I have written down the seed", showQuiz)),
+	}
+	widget.Refresh(popupContent)
+}
+
+func (app *AppInterface) seedBackupQuiz(wallet *dcrlibwallet.Wallet, seedWords []string, onVerified, onCancel func()) fyne.CanvasObject {
+	positions := rand.New(rand.NewSource(time.Now().UnixNano())).Perm(len(seedWords))[:seedBackupQuizWordCount]
+	sort.Ints(positions)
+
+	errorLabel := canvas.NewText("", values.ErrorColor)
+	errorLabel.Hide()
+
+	quizForm := widget.NewForm()
+	wordEntries := make([]*widget.Entry, len(positions))
+	for i, position := range positions {
+		wordEntries[i] = widget.NewEntry()
+		quizForm.Append(fmt.Sprintf("Word #%d", position+1), wordEntries[i])
+	}
+
+	verifyButton := widget.NewButton("Verify", func() {
+		for i, position := range positions {
+			if !strings.EqualFold(strings.TrimSpace(wordEntries[i].Text), seedWords[position]) {
+				errorLabel.Text = fmt.Sprintf("Word #%d is incorrect", position+1)
+				errorLabel.Show()
+				canvas.Refresh(errorLabel)
+				return
+			}
+		}
+
+		err := app.MultiWallet.VerifySeedForWallet(wallet.ID, wallet.Seed)
+		if err != nil {
+			errorLabel.Text = fmt.Sprintf("Error verifying seed backup: %s", err.Error())
+			errorLabel.Show()
+			canvas.Refresh(errorLabel)
+			return
+		}
+
+		onVerified()
+	})
+
+	return widget.NewVBox(
+		widget.NewLabel("Enter the requested words of your seed"),
+		quizForm,
+		errorLabel,
+		widget.NewHBox(layout.NewSpacer(), widget.NewButton("Cancel", onCancel), verifyButton),
+	)
+}
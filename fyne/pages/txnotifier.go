@@ -6,17 +6,17 @@ import (
 	"log"
 	"strconv"
 
-	"fyne.io/fyne/widget"
-
 	"github.com/gen2brain/beeep"
 	"github.com/raedahgroup/dcrlibwallet"
 
+	"github.com/raedahgroup/godcr/app/events"
 	"github.com/raedahgroup/godcr/fyne/pages/handler/multipagecomponents"
 )
 
+// multiWalletTxListener publishes the tx and block notifications of all opened wallets on walletEvents.
 type multiWalletTxListener struct {
-	tabMenu     *widget.TabContainer
-	multiWallet *dcrlibwallet.MultiWallet
+	multiWallet  *dcrlibwallet.MultiWallet
+	walletEvents *events.Bus
 }
 
 func (app *multiWalletTxListener) OnSyncStarted() {
@@ -62,72 +62,81 @@ func (app *multiWalletTxListener) OnTransaction(transaction string) {
 		log.Println("could read transaction to json")
 		return
 	}
-	app.desktopNotifier(currentTransaction)
-
-	// place all dynamic widgets here to be updated only when tabmenu is in view.
-	if app.tabMenu.CurrentTabIndex() == 0 {
 
-	} else if app.tabMenu.CurrentTabIndex() == 2 {
-		multipagecomponents.UpdateAccountSelectorOnNotification(sendPage.sendingAccountBoxes, sendPage.sendingSelectedAccountBalanceLabel,
-			sendPage.spendableLabel, app.multiWallet, sendPage.sendingSelectedWalletID, sendPage.sendingSelectedAccountID, sendPage.Contents)
+	walletName := app.walletName(currentTransaction.WalletID)
+	app.walletEvents.Publish(events.Event{
+		Type:   events.TxReceived,
+		Wallet: walletName,
+		Data: events.Tx{
+			Hash:        currentTransaction.Hash,
+			Amount:      currentTransaction.Amount,
+			Fee:         currentTransaction.Fee,
+			Direction:   currentTransaction.Direction,
+			BlockHeight: currentTransaction.BlockHeight,
+		},
+	})
+	app.walletEvents.Publish(events.Event{Type: events.BalanceChanged, Wallet: walletName})
+}
 
-		multipagecomponents.UpdateAccountSelectorOnNotification(sendPage.selfSendingAccountBoxes, sendPage.selfSendingSelectedAccountBalanceLabel,
-			nil, app.multiWallet, sendPage.selfSendingSelectedWalletID, sendPage.selfSendingSelectedAccountID, sendPage.Contents)
+func (app *multiWalletTxListener) OnTransactionConfirmed(walletID int, hash string, blockHeight int32) {
+	walletName := app.walletName(walletID)
+	app.walletEvents.Publish(events.Event{
+		Type:   events.TxConfirmed,
+		Wallet: walletName,
+		Data:   events.Tx{Hash: hash, BlockHeight: blockHeight},
+	})
+	app.walletEvents.Publish(events.Event{Type: events.BalanceChanged, Wallet: walletName})
+}
 
-	} else if app.tabMenu.CurrentTabIndex() == 3 {
-		multipagecomponents.UpdateAccountSelectorOnNotification(receivePage.accountBoxes, receivePage.selectedAccountBalanceLabel,
-			nil, app.multiWallet, receivePage.selectedWalletID, receivePage.selectedAccountID, receivePage.Contents)
-	} else if app.tabMenu.CurrentTabIndex() == 4 {
+func (app *multiWalletTxListener) OnBlockAttached(walletID int, blockHeight int32) {
+	app.walletEvents.Publish(events.Event{
+		Type:   events.BlockAttached,
+		Wallet: app.walletName(walletID),
+		Data:   events.Block{Height: blockHeight},
+	})
+}
 
+func (app *multiWalletTxListener) walletName(walletID int) string {
+	wallet := app.multiWallet.WalletWithID(walletID)
+	if wallet == nil {
+		return ""
 	}
+	return wallet.Name
 }
 
-func (app *multiWalletTxListener) OnTransactionConfirmed(walletID int, hash string, blockHeight int32) {
-	// place all dynamic widgets in a function here, to be updated only when tabmenu is in view.
-	if app.tabMenu.CurrentTabIndex() == 0 {
+// walletEventReceived notifies the user of received funds and updates the account balances
+// displayed on the current page as the wallets notify new and confirmed transactions.
+func (app *AppInterface) walletEventReceived(event events.Event) {
+	if event.Type == events.TxReceived {
+		app.desktopNotifier(event.Wallet, event.Data.(events.Tx))
+	}
 
-	} else if app.tabMenu.CurrentTabIndex() == 2 {
+	// place all dynamic widgets here to be updated only when tabmenu is in view.
+	if app.tabMenu.CurrentTabIndex() == 2 {
 		multipagecomponents.UpdateAccountSelectorOnNotification(sendPage.sendingAccountBoxes, sendPage.sendingSelectedAccountBalanceLabel,
-			sendPage.spendableLabel, app.multiWallet, sendPage.sendingSelectedWalletID, sendPage.sendingSelectedAccountID, sendPage.Contents)
+			sendPage.spendableLabel, app.MultiWallet, sendPage.sendingSelectedWalletID, sendPage.sendingSelectedAccountID, sendPage.Contents)
 
 		multipagecomponents.UpdateAccountSelectorOnNotification(sendPage.selfSendingAccountBoxes, sendPage.selfSendingSelectedAccountBalanceLabel,
-			nil, app.multiWallet, sendPage.selfSendingSelectedWalletID, sendPage.selfSendingSelectedAccountID, sendPage.Contents)
+			nil, app.MultiWallet, sendPage.selfSendingSelectedWalletID, sendPage.selfSendingSelectedAccountID, sendPage.Contents)
 
 	} else if app.tabMenu.CurrentTabIndex() == 3 {
 		multipagecomponents.UpdateAccountSelectorOnNotification(receivePage.accountBoxes, receivePage.selectedAccountBalanceLabel,
-			nil, app.multiWallet, receivePage.selectedWalletID, receivePage.selectedAccountID, receivePage.Contents)
-
-	} else if app.tabMenu.CurrentTabIndex() == 4 {
-
+			nil, app.MultiWallet, receivePage.selectedWalletID, receivePage.selectedAccountID, receivePage.Contents)
 	}
 }
 
-func (app *multiWalletTxListener) OnBlockAttached(walletID int, blockHeight int32) {
-	// place all dynamic widgets in a function here, to be updated only when tabmenu is in view.
-	if app.tabMenu.CurrentTabIndex() == 0 {
-
-	} else if app.tabMenu.CurrentTabIndex() == 2 {
-
-	} else if app.tabMenu.CurrentTabIndex() == 3 {
-
-	} else if app.tabMenu.CurrentTabIndex() == 4 {
-
-	}
-}
-
-func (app *multiWalletTxListener) desktopNotifier(currentTransaction dcrlibwallet.Transaction) {
-	amount := dcrlibwallet.AmountCoin(currentTransaction.Amount)
+func (app *AppInterface) desktopNotifier(walletName string, tx events.Tx) {
+	amount := dcrlibwallet.AmountCoin(tx.Amount)
 	// remove trailing zeros from amount
-	if currentTransaction.Direction == 1 {
+	if tx.Direction == 1 {
 		var notification string
 
-		if app.multiWallet.OpenedWalletsCount() > 1 {
-			wallet := app.multiWallet.WalletWithID(currentTransaction.WalletID)
-			if wallet == nil {
+		if app.MultiWallet.OpenedWalletsCount() > 1 {
+			if walletName == "" {
 				return
 			}
 
-			notification = fmt.Sprintf("[%s] You have received %s DCR", wallet.Name, strconv.FormatFloat(amount, 'f', -1, 64))
+			notification = fmt.Sprintf("[%s] You have received %s DCR", walletName, strconv.FormatFloat(amount, 'f', -1, 64))
 		} else {
 
 			notification = fmt.Sprintf("You have received %s DCR", strconv.FormatFloat(amount, 'f', -1, 64))
@@ -141,9 +150,12 @@ func (app *multiWalletTxListener) desktopNotifier(currentTransaction dcrlibwalle
 }
 
 func (app *AppInterface) walletNotificationListener() {
+	walletEvents := events.NewBus()
+	walletEvents.Subscribe(app.walletEventReceived, events.TxReceived, events.TxConfirmed)
+
 	var dcrListener multiWalletTxListener
-	dcrListener.tabMenu = app.tabMenu
 	dcrListener.multiWallet = app.MultiWallet
+	dcrListener.walletEvents = walletEvents
 
 	err := app.MultiWallet.AddSyncProgressListener(&dcrListener, "")
 	if err != nil {
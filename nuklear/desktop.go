@@ -5,11 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"sync/atomic"
 
 	"github.com/aarzilli/nucular"
 	"github.com/aarzilli/nucular/rect"
 	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/addressbook"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/ticketbuyer"
+	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/nuklear/nuklog"
 	"github.com/raedahgroup/godcr/nuklear/styles"
 	"github.com/raedahgroup/godcr/nuklear/widgets"
@@ -22,22 +27,48 @@ const (
 )
 
 type Desktop struct {
+	ctx              context.Context
 	walletMiddleware app.WalletMiddleware
+	navPageList      []navPage
 	navPages         map[string]navPageHandler
 	currentPage      string
 	nextPage         string
 	pageChanged      bool
 	syncer           *Syncer
 	settings         *config.Settings
+	ticketBuyer      *ticketbuyer.TicketBuyer
+	addressBook      *addressbook.AddressBook
+
+	// each opened wallet is synced and buys tickets independently of the current wallet
+	wallets      *app.WalletManager
+	syncers      map[string]*Syncer
+	ticketBuyers map[string]*ticketbuyer.TicketBuyer
+
+	// walletDataChanged is set to 1 when the current wallet publishes new transactions, blocks or balance changes,
+	// so that the current page is reloaded on the next render if it displays such data
+	walletDataChanged int32
 }
 
-func LaunchApp(ctx context.Context, walletMiddleware app.WalletMiddleware, settings *config.Settings) error {
+// walletDataPages are the nav pages that are reloaded when the transactions or balance of the current wallet change.
+// Other pages are not reloaded as they may have forms that the user is filling.
+var walletDataPages = map[string]bool{
+	"overview": true,
+	"history":  true,
+	"accounts": true,
+}
+
+// LaunchApp opens the desktop app window for the wallets in walletManager, it returns when the window is closed.
+// The ticket buyer of each wallet in ticketBuyerPassphrases is started with the wallet's passphrase.
+func LaunchApp(ctx context.Context, walletManager *app.WalletManager, settings *config.Settings,
+	ticketBuyerConfig config.TicketBuyerConfig, ticketBuyerPassphrases map[string]string, addressBook *addressbook.AddressBook) error {
 	desktop := &Desktop{
-		walletMiddleware: walletMiddleware,
-		pageChanged:      true,
-		currentPage:      "overview",
-		syncer:           NewSyncer(),
-		settings:         settings,
+		ctx:          ctx,
+		currentPage:  "overview",
+		settings:     settings,
+		addressBook:  addressBook,
+		wallets:      walletManager,
+		syncers:      make(map[string]*Syncer),
+		ticketBuyers: make(map[string]*ticketbuyer.TicketBuyer),
 	}
 
 	// initialize master window and set style
@@ -45,21 +76,49 @@ func LaunchApp(ctx context.Context, walletMiddleware app.WalletMiddleware, setti
 	masterWindow := nucular.NewMasterWindowSize(nucular.WindowNoScrollbar, app.Name, windowSize, desktop.render)
 	masterWindow.SetStyle(styles.MasterWindowStyle())
 
+	for _, walletName := range walletManager.Names() {
+		wallet, _ := walletManager.Wallet(walletName)
+
+		// the ticket buyer is started on launch if enabled or from the staking page, repaint the window whenever its status changes
+		ticketBuyer := ticketbuyer.New(wallet, ticketBuyerConfig, func(_ ticketbuyer.Status) {
+			masterWindow.Changed()
+		})
+		defer ticketBuyer.Close()
+		if passphrase, ok := ticketBuyerPassphrases[walletName]; ok {
+			if err := ticketBuyer.Start(ctx, passphrase); err != nil {
+				return fmt.Errorf("error starting ticket buyer of wallet %s: %s", walletName, err.Error())
+			}
+		}
+
+		desktop.ticketBuyers[walletName] = ticketBuyer
+		desktop.syncers[walletName] = NewSyncer()
+
+		// repaint the window to show or hide the reconnecting message when the connection to the wallet drops or is restored
+		// and to reload the current page when the transactions or balance of the current wallet change
+		walletName := walletName
+		unsubscribe := wallet.Events().Subscribe(func(event events.Event) {
+			if event.Type != events.ConnectionChanged && walletManager.CurrentName() == walletName {
+				atomic.StoreInt32(&desktop.walletDataChanged, 1)
+			}
+			masterWindow.Changed()
+		}, events.TxReceived, events.TxConfirmed, events.BlockAttached, events.BalanceChanged, events.ConnectionChanged)
+		defer unsubscribe()
+	}
+
 	// initialize fonts for later use
 	err := styles.InitFonts()
 	if err != nil {
 		return err
 	}
 
-	// register nav page handlers
-	navPages := getNavPages()
-	desktop.navPages = make(map[string]navPageHandler, len(navPages))
-	for _, page := range navPages {
-		desktop.navPages[page.name] = page.handler
-	}
+	// register nav page handlers of the current wallet
+	desktop.useWallet(walletManager.CurrentName())
 
-	// start syncing in background
-	go desktop.syncer.startSyncing(walletMiddleware, masterWindow)
+	// start syncing all wallets in background
+	for _, walletName := range walletManager.Names() {
+		wallet, _ := walletManager.Wallet(walletName)
+		go desktop.syncers[walletName].startSyncing(wallet, masterWindow)
+	}
 
 	// draw master window
 	masterWindow.Main()
@@ -106,7 +165,29 @@ func (desktop *Desktop) renderNavWindow(window *nucular.Window, maxHeight int) {
 			styles.DecredLightBlueColor, widgets.CenterAlign)
 		navGroupWindow.AddHorizontalSpace(10)
 
-		for _, page := range getNavPages() {
+		// show a wallet switcher if more than one wallet is opened
+		if walletNames := desktop.wallets.Names(); len(walletNames) > 1 {
+			currentWalletIndex := 0
+			for i, walletName := range walletNames {
+				if walletName == desktop.wallets.CurrentName() {
+					currentWalletIndex = i
+				}
+			}
+
+			navGroupWindow.Row(widgets.EditorHeight).Dynamic(1)
+			selectedWalletIndex := navGroupWindow.ComboSimple(walletNames, currentWalletIndex, widgets.EditorHeight)
+			if selectedWalletIndex != currentWalletIndex {
+				desktop.switchWallet(window, walletNames[selectedWalletIndex])
+			}
+			navGroupWindow.AddHorizontalSpace(10)
+		}
+
+		if desktop.walletMiddleware.ConnectionState() == walletcore.ConnectionStateReconnecting {
+			navGroupWindow.AddColoredLabel("Reconnecting to wallet...", styles.DecredOrangeColor, widgets.CenterAlign)
+			navGroupWindow.AddHorizontalSpace(10)
+		}
+
+		for _, page := range desktop.navPageList {
 			if desktop.currentPage == page.name {
 				navGroupWindow.AddCurrentNavButton(page.label, func() {
 					desktop.changePage(window, page.name)
@@ -118,6 +199,15 @@ func (desktop *Desktop) renderNavWindow(window *nucular.Window, maxHeight int) {
 			}
 		}
 
+		// rescan the current wallet once it is synced
+		if desktop.syncer.isDoneSyncing() {
+			navGroupWindow.AddHorizontalSpace(10)
+			desktop.syncer.renderRescanStatus(navGroupWindow, func() {
+				desktop.syncer.startRescan(desktop.ctx, desktop.walletMiddleware, window.Master())
+			})
+			navGroupWindow.AddHorizontalSpace(10)
+		}
+
 		// add exit button
 		navGroupWindow.AddBigButton("Exit", func() {
 			go navGroupWindow.Master().Close()
@@ -140,6 +230,10 @@ func (desktop *Desktop) renderPageContentWindow(window *nucular.Window, maxWidth
 	if !desktop.syncer.isDoneSyncing() {
 		desktop.syncer.Render(window)
 	} else {
+		if atomic.CompareAndSwapInt32(&desktop.walletDataChanged, 1, 0) && walletDataPages[desktop.currentPage] {
+			desktop.pageChanged = true
+		}
+
 		handler := desktop.navPages[desktop.currentPage]
 		// ensure that the handler's BeforeRender function is called only once per page call
 		// as it initializes page variables
@@ -151,6 +245,31 @@ func (desktop *Desktop) renderPageContentWindow(window *nucular.Window, maxWidth
 	}
 }
 
+// useWallet makes the wallet named `walletName` the wallet used by all pages
+// and registers the nav page handlers for that wallet.
+func (desktop *Desktop) useWallet(walletName string) {
+	desktop.walletMiddleware, _ = desktop.wallets.Switch(walletName)
+	desktop.syncer = desktop.syncers[walletName]
+	desktop.ticketBuyer = desktop.ticketBuyers[walletName]
+
+	desktop.navPageList = getNavPages(desktop.walletMiddleware.IsWatchingOnlyWallet(), desktop.ticketBuyer, desktop.addressBook)
+	desktop.navPages = make(map[string]navPageHandler, len(desktop.navPageList))
+	for _, page := range desktop.navPageList {
+		desktop.navPages[page.name] = page.handler
+	}
+
+	// the current page may not be available for this wallet, e.g. the send page of a watch-only wallet
+	if _, isNavPage := desktop.navPages[desktop.currentPage]; !isNavPage {
+		desktop.currentPage = "overview"
+	}
+	desktop.pageChanged = true
+}
+
+func (desktop *Desktop) switchWallet(window *nucular.Window, walletName string) {
+	desktop.useWallet(walletName)
+	window.Master().Changed()
+}
+
 func (desktop *Desktop) changePage(window *nucular.Window, newPage string) {
 	desktop.nextPage = newPage
 	desktop.currentPage = newPage
@@ -10,9 +10,22 @@ require (
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0
 	github.com/raedahgroup/dcrlibwallet v1.0.1-0.20190807181808-37b6666fe764
 	github.com/raedahgroup/godcr/app v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/addressbook v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/events v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/paymenturi v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/txfilter v0.0.0-00010101000000-000000000000
 	github.com/skip2/go-qrcode v0.0.0-20190110000554-dc11ecdae0a9
 	golang.org/x/image v0.0.0-20190501045829-6d32002ffd75
 	golang.org/x/mobile v0.0.0-20190318164015-6bd122906c08
 )
 
-replace github.com/raedahgroup/godcr/app => ../app
+replace (
+	github.com/raedahgroup/godcr/app => ../app
+	github.com/raedahgroup/godcr/app/addressbook => ../app/addressbook
+	github.com/raedahgroup/godcr/app/events => ../app/events
+	github.com/raedahgroup/godcr/app/jsonfile => ../app/jsonfile
+	github.com/raedahgroup/godcr/app/paymenturi => ../app/paymenturi
+	github.com/raedahgroup/godcr/app/seedbackup => ../app/seedbackup
+	github.com/raedahgroup/godcr/app/txfilter => ../app/txfilter
+	github.com/raedahgroup/godcr/app/txlabels => ../app/txlabels
+)
@@ -2,7 +2,9 @@ package nuklear
 
 import (
 	"github.com/aarzilli/nucular"
+	"github.com/raedahgroup/godcr/app/addressbook"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/ticketbuyer"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/nuklear/pagehandlers"
 	"github.com/raedahgroup/godcr/nuklear/styles"
@@ -29,8 +31,10 @@ type navPageHandler interface {
 	Render(window *nucular.Window)
 }
 
-func getNavPages() []navPage {
-	return []navPage{
+// getNavPages returns the pages to display on the nav menu.
+// The send page is not returned for watch-only wallets as such wallets cannot spend.
+func getNavPages(watchingOnly bool, ticketBuyer *ticketbuyer.TicketBuyer, addressBook *addressbook.AddressBook) []navPage {
+	navPages := []navPage{
 		{
 			name:    "overview",
 			label:   "Overview",
@@ -41,11 +45,17 @@ func getNavPages() []navPage {
 			label:   "History",
 			handler: &pagehandlers.HistoryHandler{},
 		},
-		{
+	}
+
+	if !watchingOnly {
+		navPages = append(navPages, navPage{
 			name:    "send",
 			label:   "Send",
-			handler: &pagehandlers.SendHandler{},
-		},
+			handler: &pagehandlers.SendHandler{AddressBook: addressBook},
+		})
+	}
+
+	return append(navPages, []navPage{
 		{
 			name:    "receive",
 			label:   "Receive",
@@ -54,24 +64,24 @@ func getNavPages() []navPage {
 		{
 			name:    "staking",
 			label:   "Staking",
-			handler: &pagehandlers.StakingHandler{},
+			handler: &pagehandlers.StakingHandler{WatchingOnly: watchingOnly, TicketBuyer: ticketBuyer},
 		},
 		{
 			name:    "accounts",
 			label:   "Accounts",
-			handler: &pagehandlers.AccountsHandler{},
+			handler: &pagehandlers.AccountsHandler{WatchingOnly: watchingOnly},
 		},
 		{
 			name:    "security",
 			label:   "Security",
-			handler: &notImplementedNavPageHandler{"Security"},
+			handler: &pagehandlers.SecurityHandler{WatchingOnly: watchingOnly},
 		},
 		{
 			name:    "settings",
 			label:   "Settings",
 			handler: &notImplementedNavPageHandler{"Settings"},
 		},
-	}
+	}...)
 }
 
 type notImplementedNavPageHandler struct {
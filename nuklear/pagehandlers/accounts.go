@@ -21,6 +21,8 @@ import (
 
 const (
 	estimatedSettingsWindowHeight = 140
+	estimatedActionsWindowHeight  = 140
+	accountNameInputWidth         = 200
 	estimatedGroupWindowPadding   = 12
 	strokeHeight                  = 1
 	rectXPadding                  = 15
@@ -34,6 +36,9 @@ type account struct {
 }
 
 type AccountsHandler struct {
+	// WatchingOnly hides the create account form since watch-only wallets cannot create accounts
+	WatchingOnly bool
+
 	err                error
 	accounts           []utils.Account
 	wallet             walletcore.Wallet
@@ -42,6 +47,13 @@ type AccountsHandler struct {
 	networkHDPath      string
 	tickIcon           string
 	crossIcon          string
+
+	accountNameInputs map[uint32]*nucular.TextEditor
+	extendedPubKeys   map[uint32]*nucular.TextEditor
+
+	newAccountNameInput *nucular.TextEditor
+	isCreatingAccount   bool
+	createAccountError  error
 }
 
 func (handler *AccountsHandler) BeforeRender(wallet walletcore.Wallet, settings *config.Settings, refreshWindowDisplay func()) bool {
@@ -51,6 +63,14 @@ func (handler *AccountsHandler) BeforeRender(wallet walletcore.Wallet, settings
 	handler.isFetchingAccounts = false
 	handler.settings = settings
 
+	handler.accountNameInputs = make(map[uint32]*nucular.TextEditor)
+	handler.extendedPubKeys = make(map[uint32]*nucular.TextEditor)
+
+	handler.newAccountNameInput = &nucular.TextEditor{}
+	handler.newAccountNameInput.Flags = nucular.EditClipboard | nucular.EditSimple
+	handler.isCreatingAccount = false
+	handler.createAccountError = nil
+
 	if handler.tickIcon == "" {
 		handler.tickIcon = getTickIcon()
 	}
@@ -104,6 +124,11 @@ func (handler *AccountsHandler) Render(window *nucular.Window) {
 		if handler.isFetchingAccounts {
 			contentWindow.DisplayIsLoadingMessage()
 		}
+
+		if !handler.WatchingOnly {
+			contentWindow.AddHorizontalSpace(20)
+			handler.displayCreateAccountForm(contentWindow)
+		}
 	})
 }
 
@@ -141,11 +166,11 @@ func (handler *AccountsHandler) renderAccounts(window *widgets.Window) {
 		)
 
 		tableHeight := table.Height()
-		totalWindowPadding := estimatedGroupWindowPadding * 3
+		totalWindowPadding := estimatedGroupWindowPadding * 4
 
 		window.Row(30).Dynamic(1)
 		if window.TreePush(nucular.TreeNode, headerLabel, false) {
-			window.Row(tableHeight + estimatedSettingsWindowHeight + totalWindowPadding).Dynamic(1)
+			window.Row(tableHeight + estimatedActionsWindowHeight + estimatedSettingsWindowHeight + totalWindowPadding).Dynamic(1)
 			widgets.NoScrollGroupWindow(fmt.Sprintf("properties-window-%d", item.Account.Number), window.Window, func(mainWindow *widgets.Window) {
 				mainWindow.AddLabelWithFont("Properties", "LC", styles.BoldPageContentFont)
 
@@ -154,6 +179,11 @@ func (handler *AccountsHandler) renderAccounts(window *widgets.Window) {
 					table.Render(tableWindow)
 				})
 
+				mainWindow.Row(estimatedActionsWindowHeight + estimatedGroupWindowPadding).Dynamic(1)
+				widgets.GroupWindow(fmt.Sprintf("actions-window-%d", item.Account.Number), mainWindow.Window, 0, func(actionsWindow *widgets.Window) {
+					handler.displayAccountActions(item.Account, actionsWindow)
+				})
+
 				mainWindow.AddLabelWithFont("Wallet Settings", "LC", styles.BoldPageContentFont)
 				mainWindow.Row(estimatedSettingsWindowHeight + estimatedGroupWindowPadding).Dynamic(1)
 				widgets.GroupWindow(fmt.Sprintf("settings-window-%d", item.Account.Number), mainWindow.Window, 0, func(settingsWindow *widgets.Window) {
@@ -172,6 +202,131 @@ func (handler *AccountsHandler) renderAccounts(window *widgets.Window) {
 	}
 }
 
+func (handler *AccountsHandler) displayAccountActions(account *walletcore.Account, window *widgets.Window) {
+	accountNameInput, ok := handler.accountNameInputs[account.Number]
+	if !ok {
+		accountNameInput = &nucular.TextEditor{}
+		accountNameInput.Flags = nucular.EditClipboard | nucular.EditSimple
+		accountNameInput.Buffer = []rune(account.Name)
+		handler.accountNameInputs[account.Number] = accountNameInput
+	}
+
+	window.AddLabelWithFont("Rename Account", widgets.LeftCenterAlign, styles.BoldPageContentFont)
+	window.Row(widgets.EditorHeight).Static(accountNameInputWidth, window.ButtonWidth("Rename"))
+	window.AddEditorToCurrentRow(accountNameInput)
+	window.AddButtonToCurrentRow("Rename", func() {
+		handler.renameAccount(account, window)
+	})
+
+	window.AddLabelWithFont("Extended Public Key", widgets.LeftCenterAlign, styles.BoldPageContentFont)
+	if extendedPubKey, ok := handler.extendedPubKeys[account.Number]; ok {
+		window.Row(widgets.EditorHeight).Dynamic(1)
+		window.AddEditorToCurrentRow(extendedPubKey)
+	} else {
+		window.AddButton("Show Extended Public Key", func() {
+			handler.showExtendedPubKey(account, window)
+		})
+	}
+}
+
+func (handler *AccountsHandler) renameAccount(account *walletcore.Account, window *widgets.Window) {
+	defer window.Master().Changed()
+
+	newName := strings.TrimSpace(string(handler.accountNameInputs[account.Number].Buffer))
+	if newName == "" {
+		widgets.NewAlertWidget("Account name cannot be empty", true, window)
+		return
+	}
+
+	if err := handler.wallet.RenameAccount(account.Number, newName); err != nil {
+		widgets.NewAlertWidget(fmt.Sprintf("Error renaming account: %s", err.Error()), true, window)
+		return
+	}
+
+	account.Name = newName
+	widgets.NewAlertWidget("Account renamed successfully!", false, window)
+}
+
+func (handler *AccountsHandler) showExtendedPubKey(account *walletcore.Account, window *widgets.Window) {
+	defer window.Master().Changed()
+
+	extendedPubKey, err := handler.wallet.AccountExtendedPubKey(account.Number)
+	if err != nil {
+		widgets.NewAlertWidget(fmt.Sprintf("Error getting account extended public key: %s", err.Error()), true, window)
+		return
+	}
+
+	// display the key in a read-only editor so that it can be selected and copied
+	extendedPubKeyEditor := &nucular.TextEditor{}
+	extendedPubKeyEditor.Flags = nucular.EditClipboard | nucular.EditSelectable | nucular.EditReadOnly
+	extendedPubKeyEditor.Buffer = []rune(extendedPubKey)
+	handler.extendedPubKeys[account.Number] = extendedPubKeyEditor
+}
+
+func (handler *AccountsHandler) displayCreateAccountForm(contentWindow *widgets.Window) {
+	contentWindow.AddLabelWithFont("Create Account", widgets.LeftCenterAlign, styles.BoldPageContentFont)
+
+	contentWindow.Row(widgets.EditorHeight).Static(contentWindow.LabelWidth("Account Name"), accountNameInputWidth)
+	contentWindow.AddLabelsToCurrentRow(widgets.NewLabelTableCell("Account Name", widgets.LeftCenterAlign))
+	contentWindow.AddEditorToCurrentRow(handler.newAccountNameInput)
+
+	submitButtonText := "Create"
+	if handler.isCreatingAccount {
+		submitButtonText = "Creating..."
+	}
+	contentWindow.AddHorizontalSpace(10)
+	contentWindow.AddButton(submitButtonText, func() {
+		handler.validateAndCreateAccount(contentWindow.Window)
+	})
+
+	if handler.createAccountError != nil {
+		contentWindow.DisplayErrorMessage("Error creating account", handler.createAccountError)
+	}
+}
+
+func (handler *AccountsHandler) validateAndCreateAccount(window *nucular.Window) {
+	if handler.isCreatingAccount {
+		return
+	}
+
+	accountName := strings.TrimSpace(string(handler.newAccountNameInput.Buffer))
+	if accountName == "" {
+		handler.createAccountError = errors.New("account name cannot be empty")
+		window.Master().Changed()
+		return
+	}
+
+	passphraseChan := make(chan string)
+	widgets.NewPassphraseWidget().Get(window, passphraseChan)
+
+	go func() {
+		passphrase := <-passphraseChan
+		if passphrase != "" {
+			handler.createAccount(accountName, passphrase, window)
+		}
+	}()
+}
+
+func (handler *AccountsHandler) createAccount(accountName, passphrase string, window *nucular.Window) {
+	handler.isCreatingAccount = true
+	handler.createAccountError = nil
+	window.Master().Changed()
+
+	defer func() {
+		handler.isCreatingAccount = false
+		window.Master().Changed()
+	}()
+
+	if _, err := handler.wallet.NextAccount(accountName, passphrase); err != nil {
+		handler.createAccountError = err
+		return
+	}
+
+	// reload accounts to display the new account
+	handler.newAccountNameInput.Buffer = nil
+	handler.accounts = nil
+}
+
 func (handler *AccountsHandler) drawCustomCheckbox(account utils.Account, window *widgets.Window, bounds rect.Rect, commandBuffer *command.Buffer) {
 	accountVisibiltyRect, defaultAccountRect := drawRectangle(window, bounds, commandBuffer)
 
@@ -2,6 +2,7 @@ package pagehandlers
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aarzilli/nucular"
 	"github.com/decred/dcrd/dcrutil"
@@ -20,6 +21,12 @@ type HistoryHandler struct {
 	filterSelectorErr    error
 	currentFilterText    string
 
+	searchInput     *nucular.TextEditor
+	isShowingSearch bool
+
+	// isFilteringByCriteria is true if all txs matching the criteria set in the filter selector are loaded
+	isFilteringByCriteria bool
+
 	txCountForCurrentFilter int
 	currentPage             int
 	txPerPage               int
@@ -31,6 +38,7 @@ type HistoryHandler struct {
 	selectedTxDetails   *walletcore.Transaction
 	isFetchingTxDetails bool
 	fetchTxDetailsError error
+	txLabelInput        *nucular.TextEditor
 }
 
 func (handler *HistoryHandler) BeforeRender(wallet walletcore.Wallet, settings *config.Settings, refreshWindowDisplay func()) bool {
@@ -44,6 +52,11 @@ func (handler *HistoryHandler) BeforeRender(wallet walletcore.Wallet, settings *
 
 	handler.clearTxDetails()
 
+	handler.searchInput = &nucular.TextEditor{}
+	handler.searchInput.Flags = nucular.EditClipboard | nucular.EditSimple
+	handler.isShowingSearch = false
+	handler.isFilteringByCriteria = false
+
 	// fetch initial table data
 	handler.currentFilterText = "All"
 	handler.txCountForCurrentFilter, handler.fetchHistoryError = wallet.TransactionCount(nil)
@@ -56,18 +69,54 @@ func (handler *HistoryHandler) BeforeRender(wallet walletcore.Wallet, settings *
 
 	// set up the filter widget
 	handler.filterSelectorWidget, handler.filterSelectorErr = widgets.FilterSelectorWidget(wallet, func() {
-		selectedFilterText, txCountForSelectedFilter := handler.filterSelectorWidget.GetSelectedFilter()
-		if selectedFilterText != handler.currentFilterText {
-			handler.currentFilterText = selectedFilterText
-			handler.txCountForCurrentFilter = txCountForSelectedFilter
-			handler.transactions = nil
-			go handler.fetchTransactions(walletcore.BuildTransactionFilter(handler.currentFilterText))
-		}
+		handler.clearSearch()
+		go handler.reloadTransactions()
 	})
 
 	return true
 }
 
+// reloadTransactions displays the txs for the filter and criteria currently set in the filter selector.
+// If criteria is set, all matching txs are loaded at once since the tx index cannot filter by the criteria.
+func (handler *HistoryHandler) reloadTransactions() {
+	if handler.filterSelectorWidget == nil {
+		handler.transactions = nil
+		handler.fetchTransactions(nil)
+		return
+	}
+
+	selectedFilterText, txCountForSelectedFilter := handler.filterSelectorWidget.GetSelectedFilter()
+	handler.currentFilterText = selectedFilterText
+	handler.currentPage = 1
+	handler.transactions = nil
+
+	criteria, err := handler.filterSelectorWidget.GetCriteria()
+	if err != nil {
+		handler.fetchHistoryError = err
+		handler.txCountForCurrentFilter = 0
+		handler.refreshWindowDisplay()
+		return
+	}
+
+	handler.isFilteringByCriteria = !criteria.IsEmpty()
+	if !handler.isFilteringByCriteria {
+		handler.txCountForCurrentFilter = txCountForSelectedFilter
+		handler.fetchTransactions(walletcore.BuildTransactionFilter(handler.currentFilterText))
+		return
+	}
+
+	handler.isFetchingTransactions = true
+	handler.refreshWindowDisplay()
+
+	transactions, err := walletcore.FilterTransactionHistory(handler.wallet, handler.currentFilterText, criteria)
+	handler.fetchHistoryError = err
+	handler.transactions = transactions
+	handler.txCountForCurrentFilter = len(transactions)
+
+	handler.isFetchingTransactions = false
+	handler.refreshWindowDisplay()
+}
+
 func (handler *HistoryHandler) fetchTransactions(filter *txindex.ReadFilter) {
 	handler.isFetchingTransactions = true
 	handler.refreshWindowDisplay() // refresh display to show loading indicator
@@ -85,6 +134,35 @@ func (handler *HistoryHandler) fetchTransactions(filter *txindex.ReadFilter) {
 	handler.refreshWindowDisplay()
 }
 
+// searchTransactions replaces the displayed history with the transactions whose labels contain the text in the search input.
+func (handler *HistoryHandler) searchTransactions() {
+	query := strings.TrimSpace(string(handler.searchInput.Buffer))
+	if query == "" {
+		return
+	}
+
+	handler.isFetchingTransactions = true
+	handler.isShowingSearch = true
+	handler.currentPage = 1
+	handler.transactions = nil
+	handler.refreshWindowDisplay()
+
+	transactions, err := handler.wallet.SearchTransactions(query)
+	handler.fetchHistoryError = err
+	handler.transactions = transactions
+	handler.txCountForCurrentFilter = len(transactions)
+
+	handler.isFetchingTransactions = false
+	handler.refreshWindowDisplay()
+}
+
+// clearSearch empties the search input, the caller should reload the history for the current filter.
+func (handler *HistoryHandler) clearSearch() {
+	handler.searchInput.Buffer = nil
+	handler.isShowingSearch = false
+	handler.currentPage = 1
+}
+
 func (handler *HistoryHandler) Render(window *nucular.Window) {
 	if handler.selectedTxHash == "" {
 		handler.renderHistoryPage(window)
@@ -103,7 +181,11 @@ func (handler *HistoryHandler) renderHistoryPage(window *nucular.Window) {
 			contentWindow.DisplayErrorMessage("Error with filter selector", handler.filterSelectorErr)
 		}
 
-		if len(handler.transactions) == 0 {
+		handler.renderSearchInput(contentWindow)
+
+		if len(handler.transactions) == 0 && handler.isShowingSearch {
+			contentWindow.AddWrappedLabel("No transaction labels match your search", widgets.CenterAlign)
+		} else if len(handler.transactions) == 0 {
 			contentWindow.AddWrappedLabel("No transactions to display yet", widgets.CenterAlign)
 		} else {
 			handler.displayTransactions(contentWindow)
@@ -120,6 +202,20 @@ func (handler *HistoryHandler) renderHistoryPage(window *nucular.Window) {
 	})
 }
 
+func (handler *HistoryHandler) renderSearchInput(contentWindow *widgets.Window) {
+	contentWindow.Row(widgets.EditorHeight).Static(200, contentWindow.ButtonWidth("Search"), contentWindow.ButtonWidth("Clear"))
+	contentWindow.AddEditorToCurrentRow(handler.searchInput)
+	contentWindow.AddButtonToCurrentRow("Search", func() {
+		go handler.searchTransactions()
+	})
+	if handler.isShowingSearch {
+		contentWindow.AddButtonToCurrentRow("Clear", func() {
+			handler.clearSearch()
+			go handler.reloadTransactions()
+		})
+	}
+}
+
 func (handler *HistoryHandler) displayTransactions(contentWindow *widgets.Window) {
 	historyTable := widgets.NewTable()
 
@@ -131,6 +227,7 @@ func (handler *HistoryHandler) displayTransactions(contentWindow *widgets.Window
 		widgets.NewLabelTableCell("Amount", "LC"),
 		widgets.NewLabelTableCell("Fee", "LC"),
 		widgets.NewLabelTableCell("Type", "LC"),
+		widgets.NewLabelTableCell("Label", "LC"),
 		widgets.NewLabelTableCell("Hash", "LC"),
 	)
 
@@ -151,6 +248,7 @@ func (handler *HistoryHandler) displayTransactions(contentWindow *widgets.Window
 			widgets.NewLabelTableCell(dcrutil.Amount(tx.Amount).String(), "RC"),
 			widgets.NewLabelTableCell(dcrutil.Amount(tx.Fee).String(), "RC"),
 			widgets.NewLabelTableCell(tx.Type, "LC"),
+			widgets.NewLabelTableCell(tx.Label, "LC"),
 			widgets.NewLinkTableCell(tx.Hash, "Click to see transaction details", handler.gotoTransactionDetails),
 		)
 	}
@@ -185,7 +283,7 @@ func (handler *HistoryHandler) loadNextPage(window *widgets.Window) {
 	handler.currentPage = nextPage
 
 	nextPageTxOffset := (nextPage - 1) * handler.txPerPage
-	if nextPageTxOffset >= len(handler.transactions) {
+	if nextPageTxOffset >= len(handler.transactions) && !handler.isFilteringByCriteria && !handler.isShowingSearch {
 		// we've not loaded txs for this page
 		go handler.fetchTransactions(walletcore.BuildTransactionFilter(handler.currentFilterText))
 	}
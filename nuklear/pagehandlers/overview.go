@@ -10,19 +10,28 @@ import (
 )
 
 type OverviewHandler struct {
-	err      error
-	accounts []*walletcore.Account
-	wallet   walletcore.Wallet
+	err                error
+	accounts           []*walletcore.Account
+	wallet             walletcore.Wallet
+	seedBackupVerified bool
 }
 
 func (handler *OverviewHandler) BeforeRender(wallet walletcore.Wallet, settings *config.Settings, _ func()) bool {
 	handler.wallet = wallet
 	handler.accounts, handler.err = wallet.AccountsOverview(walletcore.DefaultRequiredConfirmations)
+	handler.seedBackupVerified = wallet.SeedBackupVerified()
 	return true
 }
 
 func (handler *OverviewHandler) Render(window *nucular.Window) {
 	widgets.PageContentWindowDefaultPadding("Overview", window, func(contentWindow *widgets.Window) {
+		if !handler.seedBackupVerified {
+			contentWindow.DisplayMessage("You have not verified that you have a backup of your wallet seed. "+
+				"Without the seed, you will not be able to restore your wallet if this device is lost. "+
+				"Verify your seed backup on the Security page.", styles.DecredOrangeColor)
+			contentWindow.AddHorizontalSpace(10)
+		}
+
 		contentWindow.AddLabelWithFont("Current Total Balance", widgets.LeftCenterAlign, styles.BoldPageContentFont)
 
 		if handler.err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/aarzilli/nucular/rect"
 	"github.com/atotto/clipboard"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/paymenturi"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/nuklear/nuklog"
 	"github.com/raedahgroup/godcr/nuklear/styles"
@@ -26,6 +27,12 @@ type ReceiveHandler struct {
 	generateAddressError  error
 	generatedAddress      string
 	refreshWindowDisplay func()
+
+	requestAmount       *nucular.TextEditor
+	requestLabel        *nucular.TextEditor
+	requestMessage      *nucular.TextEditor
+	paymentRequest      paymenturi.PaymentRequest
+	paymentRequestError error
 }
 
 func (handler *ReceiveHandler) BeforeRender(wallet walletcore.Wallet, settings *config.Settings, refreshWindowDisplay func()) bool {
@@ -33,6 +40,15 @@ func (handler *ReceiveHandler) BeforeRender(wallet walletcore.Wallet, settings *
 	handler.generateAddressError = nil
 	handler.generatedAddress = ""
 	handler.refreshWindowDisplay = refreshWindowDisplay
+	handler.paymentRequest = paymenturi.PaymentRequest{}
+	handler.paymentRequestError = nil
+
+	handler.requestAmount = &nucular.TextEditor{}
+	handler.requestAmount.Flags = nucular.EditClipboard | nucular.EditSimple
+	handler.requestLabel = &nucular.TextEditor{}
+	handler.requestLabel.Flags = nucular.EditClipboard | nucular.EditSimple
+	handler.requestMessage = &nucular.TextEditor{}
+	handler.requestMessage.Flags = nucular.EditClipboard | nucular.EditSimple
 
 	// first setup account selector widget
 	handler.accountSelectorWidget = widgets.AccountSelectorWidget("Account:", false, false, wallet, func() {
@@ -63,6 +79,9 @@ func (handler *ReceiveHandler) Render(window *nucular.Window) {
 		// draw account selection widget before rendering previously generated address
 		handler.accountSelectorWidget.Render(contentWindow)
 
+		contentWindow.AddHorizontalSpace(10)
+		handler.renderPaymentRequestForm(contentWindow)
+
 		// display error if there was an error the last time address generation was attempted
 		if handler.generateAddressError != nil {
 			contentWindow.DisplayErrorMessage("Address could not be generated", handler.generateAddressError)
@@ -81,8 +100,12 @@ func (handler *ReceiveHandler) RenderAddress(window *widgets.Window) {
 	qrCodeAddressHolderWidth += qrCodeAddressHolderHorizontalPadding
 	qrCodeAddressHolderHeight += window.SingleLineLabelHeight()
 
-	// generate qrcode
-	qrCode, err := qrcode.New(handler.generatedAddress, qrcode.Medium)
+	// generate qrcode, encoding the payment uri instead of the bare address if payment details were requested
+	qrCodeContent := handler.generatedAddress
+	if paymentURI := handler.paymentURI(); paymentURI != "" {
+		qrCodeContent = paymentURI
+	}
+	qrCode, err := qrcode.New(qrCodeContent, qrcode.Medium)
 	if err != nil {
 		// todo logs need to accept message to accompany errors
 		nuklog.LogError(err)
@@ -120,5 +143,49 @@ func (handler *ReceiveHandler) RenderAddress(window *widgets.Window) {
 		if addressClicked {
 			clipboard.WriteAll(handler.generatedAddress)
 		}
+
+		if qrCodeContent != handler.generatedAddress {
+			window.AddWrappedLabel(qrCodeContent, widgets.LeftCenterAlign)
+			window.AddButton("Copy payment URI", func() {
+				clipboard.WriteAll(qrCodeContent)
+			})
+		}
+	}
+}
+
+func (handler *ReceiveHandler) renderPaymentRequestForm(window *widgets.Window) {
+	window.AddLabel("Request Amount (optional)", widgets.LeftCenterAlign)
+	window.AddEditors(handler.requestAmount)
+	window.AddLabel("Label (optional)", widgets.LeftCenterAlign)
+	window.AddEditorsWithWidths([]int{300}, handler.requestLabel)
+	window.AddLabel("Message (optional)", widgets.LeftCenterAlign)
+	window.AddEditorsWithWidths([]int{300}, handler.requestMessage)
+
+	if handler.paymentRequestError != nil {
+		window.DisplayErrorMessage("Invalid payment request", handler.paymentRequestError)
+	}
+
+	window.AddButton("Update payment request", func() {
+		handler.paymentRequestError = nil
+		handler.paymentRequest = paymenturi.PaymentRequest{
+			Label:   string(handler.requestLabel.Buffer),
+			Message: string(handler.requestMessage.Buffer),
+		}
+
+		if amountStr := string(handler.requestAmount.Buffer); amountStr != "" {
+			handler.paymentRequest.Amount, handler.paymentRequestError = paymenturi.ParseAmount(amountStr)
+		}
+		handler.refreshWindowDisplay()
+	})
+}
+
+// paymentURI returns the payment uri for the generated address and requested payment details
+// or an empty string if no payment details were requested.
+func (handler *ReceiveHandler) paymentURI() string {
+	paymentRequest := handler.paymentRequest
+	if !paymentRequest.HasDetails() {
+		return ""
 	}
+	paymentRequest.Address = handler.generatedAddress
+	return paymentRequest.String()
 }
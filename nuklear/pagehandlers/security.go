@@ -0,0 +1,80 @@
+package pagehandlers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aarzilli/nucular"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/walletcore"
+	"github.com/raedahgroup/godcr/nuklear/styles"
+	"github.com/raedahgroup/godcr/nuklear/widgets"
+)
+
+const seedInputHeight = 60
+
+type SecurityHandler struct {
+	// WatchingOnly hides the seed backup verification form since watch-only wallets have no seed
+	WatchingOnly bool
+
+	wallet             walletcore.Wallet
+	seedBackupVerified bool
+	seedInput          *nucular.TextEditor
+	verifySeedError    error
+}
+
+func (handler *SecurityHandler) BeforeRender(wallet walletcore.Wallet, settings *config.Settings, _ func()) bool {
+	handler.wallet = wallet
+	handler.seedBackupVerified = wallet.SeedBackupVerified()
+	handler.verifySeedError = nil
+
+	handler.seedInput = &nucular.TextEditor{}
+	handler.seedInput.Flags = nucular.EditClipboard | nucular.EditBox
+	return true
+}
+
+func (handler *SecurityHandler) Render(window *nucular.Window) {
+	widgets.PageContentWindowDefaultPadding("Security", window, func(contentWindow *widgets.Window) {
+		if handler.WatchingOnly {
+			contentWindow.DisplayMessage("Watch-only wallets have no seed to back up", styles.GrayColor)
+			return
+		}
+
+		contentWindow.AddLabelWithFont("Verify Seed Backup", widgets.LeftCenterAlign, styles.BoldPageContentFont)
+		if handler.seedBackupVerified {
+			contentWindow.DisplayMessage("Your seed backup has been verified", styles.DecredGreenColor)
+		} else {
+			contentWindow.DisplayMessage("Your seed backup has not been verified", styles.DecredOrangeColor)
+		}
+
+		contentWindow.AddLabel("Enter your wallet seed to confirm that your backup of it is correct", widgets.LeftCenterAlign)
+		contentWindow.Row(seedInputHeight).Dynamic(1)
+		contentWindow.AddEditorToCurrentRow(handler.seedInput)
+
+		contentWindow.AddHorizontalSpace(10)
+		contentWindow.AddButton("Verify", func() {
+			handler.verifySeedBackup(contentWindow.Window)
+		})
+
+		if handler.verifySeedError != nil {
+			contentWindow.DisplayErrorMessage("Error verifying seed backup", handler.verifySeedError)
+		}
+	})
+}
+
+func (handler *SecurityHandler) verifySeedBackup(window *nucular.Window) {
+	defer window.Master().Changed()
+
+	seed := strings.TrimSpace(string(handler.seedInput.Buffer))
+	if seed == "" {
+		handler.verifySeedError = errors.New("seed cannot be empty")
+		return
+	}
+
+	handler.verifySeedError = handler.wallet.VerifySeedBackup(seed)
+	if handler.verifySeedError == nil {
+		handler.seedInput.Buffer = nil
+		handler.seedBackupVerified = true
+		widgets.NewAlertWidget("Seed backup verified", false, window)
+	}
+}
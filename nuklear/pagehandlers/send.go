@@ -8,6 +8,7 @@ import (
 	"github.com/aarzilli/nucular"
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"github.com/raedahgroup/godcr/app/addressbook"
 	"github.com/raedahgroup/godcr/app/config"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/nuklear/styles"
@@ -15,25 +16,34 @@ import (
 )
 
 const (
-	addressFieldWidth = 300
-	amountFieldWidth  = 150
-	sectionSpacing    = 20
+	addressFieldWidth       = 300
+	amountFieldWidth        = 150
+	coinSelectionFieldWidth = 150
+	sectionSpacing          = 20
 )
 
 type SendHandler struct {
+	AddressBook *addressbook.AddressBook
+
 	wallet               walletcore.Wallet
 	refreshWindowDisplay func()
 
 	spendUnconfirmed      bool
 	accountSelectorWidget *widgets.AccountSelector
 
+	feeRate    *nucular.TextEditor
+	feeRateErr string
+
 	selectCustomInputs  bool
+	coinSelectionIndex  int
 	isFetchingUTXOS     bool
 	utxosFetchError     error
 	utxos               []*utxoSelection
 	utxosSelectionError string
 
 	sendDestinations []*sendDestination
+	contacts         []addressbook.Contact
+	contactNames     []string
 
 	isSubmitting bool
 	sendErr      error
@@ -46,10 +56,11 @@ type utxoSelection struct {
 }
 
 type sendDestination struct {
-	address    *nucular.TextEditor
-	addressErr string
-	amount     *nucular.TextEditor
-	amountErr  string
+	contactIndex int
+	address      *nucular.TextEditor
+	addressErr   string
+	amount       *nucular.TextEditor
+	amountErr    string
 }
 
 func (handler *SendHandler) BeforeRender(wallet walletcore.Wallet, settings *config.Settings, refreshWindowDisplay func()) bool {
@@ -59,7 +70,18 @@ func (handler *SendHandler) BeforeRender(wallet walletcore.Wallet, settings *con
 	handler.spendUnconfirmed = false // todo should use the value in settings
 	handler.accountSelectorWidget = widgets.AccountSelectorWidget("From:", handler.spendUnconfirmed, true, wallet, nil)
 
+	handler.feeRate = &nucular.TextEditor{}
+	handler.feeRate.Flags = nucular.EditClipboard | nucular.EditSimple
+	handler.feeRate.Buffer = []rune(strconv.FormatInt(walletcore.DefaultFeeRate, 10))
+	handler.feeRateErr = ""
+
 	handler.selectCustomInputs = false
+	handler.coinSelectionIndex = 0
+	for index, strategy := range walletcore.CoinSelectionStrategies {
+		if strategy == settings.CoinSelection {
+			handler.coinSelectionIndex = index
+		}
+	}
 	handler.isFetchingUTXOS = false
 	handler.utxosFetchError = nil
 	handler.utxos = nil
@@ -68,6 +90,13 @@ func (handler *SendHandler) BeforeRender(wallet walletcore.Wallet, settings *con
 	handler.sendDestinations = nil
 	handler.addSendDestination(false)
 
+	// the first contact option is a prompt, selecting it leaves the address field unchanged
+	handler.contacts = handler.AddressBook.Contacts(wallet.NetType())
+	handler.contactNames = []string{"Select contact"}
+	for _, contact := range handler.contacts {
+		handler.contactNames = append(handler.contactNames, contact.Name)
+	}
+
 	handler.isSubmitting = false
 	handler.sendErr = nil
 	handler.successHash = ""
@@ -91,6 +120,14 @@ func (handler *SendHandler) Render(window *nucular.Window) {
 			}
 		})
 
+		/* FEE RATE SECTION */
+		contentWindow.AddHorizontalSpace(sectionSpacing) // add space before drawing the fee rate section
+		contentWindow.AddLabel("Fee Rate (atoms/kB)", widgets.LeftCenterAlign)
+		contentWindow.AddEditorsWithWidths([]int{amountFieldWidth}, handler.feeRate)
+		if handler.feeRateErr != "" {
+			contentWindow.AddColoredLabel(handler.feeRateErr, styles.DecredOrangeColor, widgets.LeftCenterAlign)
+		}
+
 		/* CUSTOM INPUTS SECTION */
 		contentWindow.AddHorizontalSpace(sectionSpacing) // add space before drawing the custom inputs section
 		contentWindow.AddCheckbox("Select Custom Inputs", &handler.selectCustomInputs, handler.fetchCustomInputsCheck)
@@ -99,6 +136,18 @@ func (handler *SendHandler) Render(window *nucular.Window) {
 		} else if handler.utxosFetchError != nil {
 			contentWindow.DisplayErrorMessage("Unable to load inputs", handler.utxosFetchError)
 		} else if handler.utxos != nil {
+			selectInputsPrompt := "Select inputs by"
+			selectInputsButtonText := "Select Automatically"
+			contentWindow.Row(widgets.ButtonHeight).Static(
+				contentWindow.LabelWidth(selectInputsPrompt),
+				coinSelectionFieldWidth,
+				contentWindow.ButtonWidth(selectInputsButtonText),
+			)
+			contentWindow.Label(selectInputsPrompt, widgets.LeftCenterAlign)
+			handler.coinSelectionIndex = contentWindow.ComboSimple(walletcore.CoinSelectionStrategies,
+				handler.coinSelectionIndex, widgets.EditorHeight)
+			contentWindow.AddButtonToCurrentRow(selectInputsButtonText, handler.selectCustomInputsAutomatically)
+
 			utxosTable := widgets.NewTable()
 
 			// add table header using nav font
@@ -113,10 +162,14 @@ func (handler *SendHandler) Render(window *nucular.Window) {
 			for _, utxo := range handler.utxos {
 				receiveTime := time.Unix(utxo.utxo.ReceiveTime, 0).Format(time.RFC1123)
 				confirmations := strconv.Itoa(int(utxo.utxo.Confirmations))
+				address := utxo.utxo.Address
+				if utxo.utxo.Locked {
+					address += " (frozen)"
+				}
 
 				utxosTable.AddRow(
 					widgets.NewCheckboxTableCell("", &utxo.selected, handler.calculateInputsPercentage),
-					widgets.NewLabelTableCell(utxo.utxo.Address, widgets.LeftCenterAlign),
+					widgets.NewLabelTableCell(address, widgets.LeftCenterAlign),
 					widgets.NewLabelTableCell(utxo.utxo.Amount.String(), widgets.LeftCenterAlign),
 					widgets.NewLabelTableCell(receiveTime, widgets.LeftCenterAlign),
 					widgets.NewLabelTableCell(confirmations, widgets.LeftCenterAlign),
@@ -140,6 +193,16 @@ func (handler *SendHandler) Render(window *nucular.Window) {
 		)
 		// add destination fields
 		for _, destination := range handler.sendDestinations {
+			if len(handler.contacts) > 0 {
+				contentWindow.Row(widgets.EditorHeight).Static(addressFieldWidth)
+				contactIndex := contentWindow.ComboSimple(handler.contactNames, destination.contactIndex, widgets.EditorHeight)
+				if contactIndex != destination.contactIndex {
+					destination.contactIndex = contactIndex
+					if contactIndex > 0 {
+						destination.address.Buffer = []rune(handler.contacts[contactIndex-1].Address)
+					}
+				}
+			}
 			contentWindow.AddEditorsWithWidths(columnWidths, destination.address, destination.amount)
 
 			// add errors if exist
@@ -271,6 +334,46 @@ func (handler *SendHandler) fetchCustomInputs() {
 }
 
 // todo this should prolly be implemented as is done in the web interface
+// selectCustomInputsAutomatically uses the selected coin selection strategy to pick the custom inputs
+// that can pay for the current send destinations.
+func (handler *SendHandler) selectCustomInputsAutomatically() {
+	handler.utxosSelectionError = ""
+	defer handler.refreshWindowDisplay()
+
+	sendDestinations, err := handler.getSendDestinations()
+	if err != nil {
+		handler.utxosSelectionError = fmt.Sprintf("Enter valid send amounts to select inputs: %s", err.Error())
+		return
+	}
+
+	feeRate, err := strconv.ParseInt(string(handler.feeRate.Buffer), 10, 64)
+	if err != nil {
+		handler.utxosSelectionError = "Enter a valid fee rate to select inputs"
+		return
+	}
+
+	utxos := make([]*walletcore.UnspentOutput, len(handler.utxos))
+	for index, utxo := range handler.utxos {
+		utxos[index] = utxo.utxo
+	}
+
+	coinSelection := walletcore.CoinSelectionStrategies[handler.coinSelectionIndex]
+	selectedUtxos, _, err := walletcore.SelectCoins(coinSelection, utxos, sendDestinations, feeRate)
+	if err != nil {
+		handler.utxosSelectionError = err.Error()
+		return
+	}
+
+	selectedOutputKeys := make(map[string]bool, len(selectedUtxos))
+	for _, utxo := range selectedUtxos {
+		selectedOutputKeys[utxo.OutputKey] = true
+	}
+	for _, utxo := range handler.utxos {
+		utxo.selected = selectedOutputKeys[utxo.utxo.OutputKey]
+	}
+	handler.calculateInputsPercentage()
+}
+
 func (handler *SendHandler) calculateInputsPercentage() {
 
 }
@@ -285,8 +388,17 @@ func (handler *SendHandler) validateForm() bool {
 		destination.amountErr, destination.addressErr = "", ""
 	}
 	handler.utxosSelectionError = ""
+	handler.feeRateErr = ""
 	handler.refreshWindowDisplay()
 
+	if feeRate, err := strconv.ParseInt(string(handler.feeRate.Buffer), 10, 64); err != nil {
+		handler.feeRateErr = "This is not a valid fee rate"
+		isClean = false
+	} else if err = walletcore.ValidateFeeRate(feeRate); err != nil {
+		handler.feeRateErr = err.Error()
+		isClean = false
+	}
+
 	totalSendAmount := 0.0
 	for _, destination := range handler.sendDestinations {
 		address := string(destination.address.Buffer)
@@ -370,20 +482,15 @@ func (handler *SendHandler) submit(passphrase string, window *widgets.Window) {
 		handler.refreshWindowDisplay()
 	}()
 
-	sendDestinations := make([]txhelper.TransactionDestination, len(handler.sendDestinations))
-	for index := range handler.sendDestinations {
-		amount, err := strconv.ParseFloat(string(handler.sendDestinations[index].amount.Buffer), 64)
-		if err != nil {
-			handler.sendErr = err
-			return
-		}
-
-		sendDestinations[index] = txhelper.TransactionDestination{
-			Address: string(handler.sendDestinations[index].address.Buffer),
-			Amount:  amount,
-		}
+	sendDestinations, err := handler.getSendDestinations()
+	if err != nil {
+		handler.sendErr = err
+		return
 	}
 
+	// fee rate was checked in validateForm
+	feeRate, _ := strconv.ParseInt(string(handler.feeRate.Buffer), 10, 64)
+
 	accountNumber := handler.accountSelectorWidget.GetSelectedAccountNumber()
 	var requiredConfirmations int32 = walletcore.DefaultRequiredConfirmations
 	if handler.spendUnconfirmed {
@@ -399,7 +506,8 @@ func (handler *SendHandler) submit(passphrase string, window *widgets.Window) {
 			return
 		}
 
-		changeAmount, err := txhelper.EstimateChange(len(handler.utxos), int64(totalInputAmount), sendDestinations, []string{changeAddress})
+		changeAmount, err := walletcore.EstimateChange(len(utxos), int64(totalInputAmount), sendDestinations,
+			[]string{changeAddress}, feeRate)
 		if err != nil {
 			handler.sendErr = err
 			return
@@ -411,10 +519,11 @@ func (handler *SendHandler) submit(passphrase string, window *widgets.Window) {
 		}}
 
 		handler.successHash, handler.sendErr = handler.wallet.SendFromUTXOs(accountNumber, requiredConfirmations, utxos,
-			sendDestinations, changeDestinations, passphrase)
+			sendDestinations, changeDestinations, feeRate, passphrase)
 	} else {
+		coinSelection := walletcore.CoinSelectionStrategies[handler.coinSelectionIndex]
 		handler.successHash, handler.sendErr = handler.wallet.SendFromAccount(accountNumber, requiredConfirmations,
-			sendDestinations, passphrase)
+			sendDestinations, feeRate, coinSelection, passphrase)
 	}
 
 	if handler.successHash != "" {
@@ -424,6 +533,22 @@ func (handler *SendHandler) submit(passphrase string, window *widgets.Window) {
 	}
 }
 
+func (handler *SendHandler) getSendDestinations() ([]txhelper.TransactionDestination, error) {
+	sendDestinations := make([]txhelper.TransactionDestination, len(handler.sendDestinations))
+	for index := range handler.sendDestinations {
+		amount, err := strconv.ParseFloat(string(handler.sendDestinations[index].amount.Buffer), 64)
+		if err != nil {
+			return nil, err
+		}
+
+		sendDestinations[index] = txhelper.TransactionDestination{
+			Address: string(handler.sendDestinations[index].address.Buffer),
+			Amount:  amount,
+		}
+	}
+	return sendDestinations, nil
+}
+
 func (handler *SendHandler) getUTXOSAndSelectedAmount() (utxos []string, totalInputAmount dcrutil.Amount) {
 	for _, utxo := range handler.utxos {
 		if utxo.selected {
@@ -440,6 +565,9 @@ func (handler *SendHandler) resetForm(window *widgets.Window) {
 		true, handler.wallet, nil)
 	handler.accountSelectorWidget.Render(window)
 
+	handler.feeRate.Buffer = []rune(strconv.FormatInt(walletcore.DefaultFeeRate, 10))
+	handler.feeRateErr = ""
+
 	handler.selectCustomInputs = false
 	handler.isFetchingUTXOS = false
 	handler.utxosFetchError = nil
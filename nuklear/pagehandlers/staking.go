@@ -9,23 +9,46 @@ import (
 	"github.com/aarzilli/nucular"
 	"github.com/raedahgroup/dcrlibwallet"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/ticketbuyer"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/nuklear/styles"
 	"github.com/raedahgroup/godcr/nuklear/widgets"
 )
 
-const numTicketsInputWidth = 50
+const (
+	numTicketsInputWidth      = 50
+	feeRateInputWidth         = 100
+	ticketStatusSelectorWidth = 120
+)
 
 type StakingHandler struct {
+	// WatchingOnly hides the purchase ticket form since watch-only wallets cannot purchase tickets
+	WatchingOnly bool
+	// TicketBuyer is started and stopped from this page and keeps running when the page is left
+	TicketBuyer *ticketbuyer.TicketBuyer
+
 	wallet walletcore.Wallet
 
 	stakeInfoFetchError error
 	stakeInfo           *walletcore.StakeInfo
 
+	ticketStatusOptions       []string
+	selectedTicketStatusIndex int
+	ticketsFetchError         error
+	tickets                   []*walletcore.Ticket
+
 	spendUnconfirmed      bool
 	accountSelector       *widgets.AccountSelector
 	numTicketsInput       *nucular.TextEditor
 	numTicketsInputErrStr string
+	feeRateInput          *nucular.TextEditor
+	feeRateInputErrStr    string
+
+	isRevokingTickets    bool
+	revokeTicketsError   error
+	revokedTicketsStatus string
+
+	ticketBuyerStartError error
 
 	isPurchasingTickets    bool
 	purchasedTicketsHashes []string
@@ -44,10 +67,24 @@ func (handler *StakingHandler) BeforeRender(wallet walletcore.Wallet, settings *
 		refreshWindowDisplay()
 	}()
 
+	handler.ticketStatusOptions = append([]string{"All"}, walletcore.TicketStatuses...)
+	handler.selectedTicketStatusIndex = 0
+	handler.tickets = nil
+	handler.ticketsFetchError = nil
+	go handler.fetchTickets(refreshWindowDisplay)
+
 	handler.spendUnconfirmed = false // todo should use the value in settings
 	handler.accountSelector = widgets.AccountSelectorWidget("From:", handler.spendUnconfirmed, true, wallet, nil)
 	handler.numTicketsInput = &nucular.TextEditor{}
 	handler.numTicketsInput.Flags = nucular.EditClipboard | nucular.EditSimple
+	handler.feeRateInput = &nucular.TextEditor{}
+	handler.feeRateInput.Flags = nucular.EditClipboard | nucular.EditSimple
+
+	handler.isRevokingTickets = false
+	handler.revokeTicketsError = nil
+	handler.revokedTicketsStatus = ""
+
+	handler.ticketBuyerStartError = nil
 
 	handler.isPurchasingTickets = false
 	handler.purchasedTicketsHashes = nil
@@ -62,7 +99,19 @@ func (handler *StakingHandler) Render(window *nucular.Window) {
 	widgets.PageContentWindowDefaultPadding("Staking", window, func(contentWindow *widgets.Window) {
 		handler.displayStakeInfo(contentWindow)
 		contentWindow.AddHorizontalSpace(20)
-		handler.displayPurchaseTicketForm(contentWindow)
+		handler.displayTickets(contentWindow)
+		contentWindow.AddHorizontalSpace(20)
+		if !handler.WatchingOnly && handler.stakeInfo != nil && (handler.stakeInfo.Missed > 0 || handler.stakeInfo.Expired > 0) {
+			handler.displayRevokeTickets(contentWindow)
+			contentWindow.AddHorizontalSpace(20)
+		}
+		if handler.WatchingOnly {
+			contentWindow.DisplayMessage("Tickets cannot be purchased with a watch-only wallet", styles.GrayColor)
+		} else {
+			handler.displayTicketBuyer(contentWindow)
+			contentWindow.AddHorizontalSpace(20)
+			handler.displayPurchaseTicketForm(contentWindow)
+		}
 	})
 }
 
@@ -111,6 +160,173 @@ func (handler *StakingHandler) displayStakeInfo(contentWindow *widgets.Window) {
 	}
 }
 
+func (handler *StakingHandler) fetchTickets(refreshWindowDisplay func()) {
+	filter := &walletcore.TicketFilter{}
+	if handler.selectedTicketStatusIndex > 0 {
+		filter.Statuses = []string{handler.ticketStatusOptions[handler.selectedTicketStatusIndex]}
+	}
+
+	handler.tickets, handler.ticketsFetchError = handler.wallet.Tickets(context.Background(), filter)
+	refreshWindowDisplay()
+}
+
+func (handler *StakingHandler) displayTickets(contentWindow *widgets.Window) {
+	contentWindow.AddLabelWithFont("Tickets", widgets.LeftCenterAlign, styles.BoldPageContentFont)
+
+	statusLabel := "Status:"
+	contentWindow.Row(widgets.EditorHeight).Static(contentWindow.LabelWidth(statusLabel), ticketStatusSelectorWidth)
+	contentWindow.Label(statusLabel, widgets.LeftCenterAlign)
+	selectedIndex := contentWindow.ComboSimple(handler.ticketStatusOptions, handler.selectedTicketStatusIndex, widgets.EditorHeight)
+	if selectedIndex != handler.selectedTicketStatusIndex {
+		handler.selectedTicketStatusIndex = selectedIndex
+		go handler.fetchTickets(contentWindow.Master().Changed)
+	}
+
+	if handler.ticketsFetchError != nil {
+		contentWindow.DisplayErrorMessage("Error fetching tickets", handler.ticketsFetchError)
+		return
+	}
+	if len(handler.tickets) == 0 {
+		contentWindow.DisplayMessage("No tickets found", styles.GrayColor)
+		return
+	}
+
+	ticketsTable := widgets.NewTable()
+	ticketsTable.AddRowWithFont(styles.NavFont,
+		widgets.NewLabelTableCell("Hash", widgets.LeftCenterAlign),
+		widgets.NewLabelTableCell("Purchase Height", widgets.LeftCenterAlign),
+		widgets.NewLabelTableCell("Price", widgets.LeftCenterAlign),
+		widgets.NewLabelTableCell("Status", widgets.LeftCenterAlign),
+		widgets.NewLabelTableCell("Spender", widgets.LeftCenterAlign),
+		widgets.NewLabelTableCell("Reward", widgets.LeftCenterAlign),
+	)
+
+	for _, ticket := range handler.tickets {
+		purchaseHeight := "Unmined"
+		if ticket.PurchaseHeight >= 0 {
+			purchaseHeight = strconv.Itoa(int(ticket.PurchaseHeight))
+		}
+
+		var spenderHash, reward string
+		if ticket.SpenderHash != "" {
+			spenderHash = ticket.SpenderHash[:10] + "..."
+			reward = ticket.Reward.String()
+		}
+
+		ticketsTable.AddRow(
+			widgets.NewLabelTableCell(ticket.Hash[:10]+"...", widgets.LeftCenterAlign),
+			widgets.NewLabelTableCell(purchaseHeight, widgets.LeftCenterAlign),
+			widgets.NewLabelTableCell(ticket.Price.String(), widgets.LeftCenterAlign),
+			widgets.NewLabelTableCell(ticket.Status, widgets.LeftCenterAlign),
+			widgets.NewLabelTableCell(spenderHash, widgets.LeftCenterAlign),
+			widgets.NewLabelTableCell(reward, widgets.LeftCenterAlign),
+		)
+	}
+
+	ticketsTable.Render(contentWindow)
+}
+
+func (handler *StakingHandler) displayRevokeTickets(contentWindow *widgets.Window) {
+	contentWindow.AddLabelWithFont("Revoke Tickets", widgets.LeftCenterAlign, styles.BoldPageContentFont)
+	contentWindow.AddWrappedLabel(fmt.Sprintf("%d missed and %d expired ticket(s). Revoke them to return the locked funds to your wallet.",
+		handler.stakeInfo.Missed, handler.stakeInfo.Expired), widgets.LeftCenterAlign)
+
+	revokeButtonText := "Revoke"
+	if handler.isRevokingTickets {
+		revokeButtonText = "Revoking..."
+	}
+	contentWindow.AddButton(revokeButtonText, func() {
+		if handler.isRevokingTickets {
+			return
+		}
+
+		passphraseChan := make(chan string)
+		widgets.NewPassphraseWidget().Get(contentWindow.Window, passphraseChan)
+
+		go func() {
+			passphrase := <-passphraseChan
+			if passphrase != "" {
+				handler.revokeTickets(passphrase, contentWindow.Window)
+			}
+		}()
+	})
+
+	if handler.revokeTicketsError != nil {
+		contentWindow.DisplayErrorMessage("Error revoking tickets", handler.revokeTicketsError)
+	} else if handler.revokedTicketsStatus != "" {
+		contentWindow.AddColoredLabel(handler.revokedTicketsStatus, styles.DecredGreenColor, widgets.LeftCenterAlign)
+	}
+}
+
+func (handler *StakingHandler) revokeTickets(passphrase string, window *nucular.Window) {
+	handler.isRevokingTickets = true
+	handler.revokeTicketsError = nil
+	handler.revokedTicketsStatus = ""
+	window.Master().Changed()
+
+	defer func() {
+		handler.isRevokingTickets = false
+		window.Master().Changed()
+	}()
+
+	handler.revokeTicketsError = handler.wallet.RevokeTickets(context.Background(), passphrase)
+	if handler.revokeTicketsError == nil {
+		handler.revokedTicketsStatus = "Missed and expired tickets revoked successfully"
+	}
+}
+
+func (handler *StakingHandler) displayTicketBuyer(contentWindow *widgets.Window) {
+	contentWindow.AddLabelWithFont("Ticket Buyer", widgets.LeftCenterAlign, styles.BoldPageContentFont)
+
+	ticketBuyerConfig := handler.TicketBuyer.Config()
+	contentWindow.AddWrappedLabel(fmt.Sprintf("Buys up to %d ticket(s) per block from account %d while keeping %v DCR in the account.",
+		ticketBuyerConfig.MaxPerBlock, ticketBuyerConfig.SourceAccount, ticketBuyerConfig.BalanceToMaintain), widgets.LeftCenterAlign)
+	if ticketBuyerConfig.MaxPrice > 0 {
+		contentWindow.AddWrappedLabel(fmt.Sprintf("Tickets priced above %v DCR are not bought.", ticketBuyerConfig.MaxPrice),
+			widgets.LeftCenterAlign)
+	}
+	if ticketBuyerConfig.VSPHost != "" {
+		contentWindow.AddWrappedLabel(fmt.Sprintf("Tickets are bought through %s.", ticketBuyerConfig.VSPHost), widgets.LeftCenterAlign)
+	}
+
+	status := handler.TicketBuyer.Status()
+	if status.Running {
+		contentWindow.AddLabel(fmt.Sprintf("Status: Running, tickets purchased: %d", status.TicketsPurchased), widgets.LeftCenterAlign)
+	} else {
+		contentWindow.AddLabel(fmt.Sprintf("Status: Stopped, tickets purchased: %d", status.TicketsPurchased), widgets.LeftCenterAlign)
+	}
+	if status.LastBlock > 0 {
+		contentWindow.AddWrappedLabel(fmt.Sprintf("Block %d: %s", status.LastBlock, status.LastDecision), widgets.LeftCenterAlign)
+	} else if status.LastDecision != "" {
+		contentWindow.AddWrappedLabel(status.LastDecision, widgets.LeftCenterAlign)
+	}
+
+	if status.Running {
+		contentWindow.AddButton("Stop", func() {
+			handler.TicketBuyer.Stop()
+		})
+	} else {
+		contentWindow.AddButton("Start", func() {
+			passphraseChan := make(chan string)
+			widgets.NewPassphraseWidget().Get(contentWindow.Window, passphraseChan)
+
+			go func() {
+				passphrase := <-passphraseChan
+				if passphrase != "" {
+					handler.ticketBuyerStartError = handler.TicketBuyer.Start(context.Background(), passphrase)
+					contentWindow.Master().Changed()
+				}
+			}()
+		})
+	}
+
+	if handler.ticketBuyerStartError != nil {
+		contentWindow.DisplayErrorMessage("Error starting ticket buyer", handler.ticketBuyerStartError)
+	} else if status.Error != "" {
+		contentWindow.DisplayErrorMessage("Ticket buyer error", errors.New(status.Error))
+	}
+}
+
 func (handler *StakingHandler) displayPurchaseTicketForm(contentWindow *widgets.Window) {
 	contentWindow.AddLabelWithFont("Purchase Ticket", widgets.LeftCenterAlign, styles.BoldPageContentFont)
 
@@ -131,6 +347,13 @@ func (handler *StakingHandler) displayPurchaseTicketForm(contentWindow *widgets.
 		contentWindow.DisplayMessage(handler.numTicketsInputErrStr, styles.DecredOrangeColor)
 	}
 
+	contentWindow.Row(widgets.EditorHeight).Static(contentWindow.LabelWidth("Fee Rate (atoms/kB)"), feeRateInputWidth)
+	contentWindow.AddLabelsToCurrentRow(widgets.NewLabelTableCell("Fee Rate (atoms/kB)", widgets.LeftCenterAlign))
+	contentWindow.AddEditorToCurrentRow(handler.feeRateInput)
+	if handler.feeRateInputErrStr != "" {
+		contentWindow.DisplayMessage(handler.feeRateInputErrStr, styles.DecredOrangeColor)
+	}
+
 	submitButtonText := "Purchase"
 	if handler.isPurchasingTickets {
 		submitButtonText = "Purchasing..."
@@ -159,9 +382,19 @@ func (handler *StakingHandler) validateAndSubmit(window *nucular.Window) {
 		return
 	}
 
+	handler.numTicketsInputErrStr = ""
+	handler.feeRateInputErrStr = ""
+	if feeRate, err := strconv.ParseInt(string(handler.feeRateInput.Buffer), 10, 64); err != nil {
+		handler.feeRateInputErrStr = "This is not a valid fee rate"
+	} else if err = walletcore.ValidateFeeRate(feeRate); err != nil {
+		handler.feeRateInputErrStr = err.Error()
+	}
+
 	if string(handler.numTicketsInput.Buffer) == "" {
 		handler.numTicketsInputErrStr = "Please specify the number of tickets to purchase"
 		window.Master().Changed()
+	} else if handler.feeRateInputErrStr != "" {
+		window.Master().Changed()
 	} else {
 		passphraseChan := make(chan string)
 		widgets.NewPassphraseWidget().Get(window, passphraseChan)
@@ -193,6 +426,12 @@ func (handler *StakingHandler) submit(passphrase string, window *nucular.Window)
 		return
 	}
 
+	feeRate, sendErr := strconv.ParseInt(string(handler.feeRateInput.Buffer), 10, 64)
+	if sendErr != nil {
+		handler.purchaseTicketsError = sendErr
+		return
+	}
+
 	sourceAccount := handler.accountSelector.GetSelectedAccountNumber()
 
 	requiredConfirmations := walletcore.DefaultRequiredConfirmations
@@ -205,6 +444,8 @@ func (handler *StakingHandler) submit(passphrase string, window *nucular.Window)
 		Passphrase:            []byte(passphrase),
 		NumTickets:            uint32(numTickets),
 		Account:               uint32(sourceAccount),
+		TxFee:                 feeRate,
+		TicketFee:             feeRate,
 	}
 
 	ticketHashes, sendErr := handler.wallet.PurchaseTicket(context.Background(), request)
@@ -229,5 +470,8 @@ func (handler *StakingHandler) resetPurchaseTicketsForm() {
 	handler.numTicketsInput.Buffer = []rune{'1'}
 	handler.numTicketsInputErrStr = ""
 
+	handler.feeRateInput.Buffer = []rune(strconv.FormatInt(walletcore.DefaultFeeRate, 10))
+	handler.feeRateInputErrStr = ""
+
 	handler.isPurchasingTickets = false
 }
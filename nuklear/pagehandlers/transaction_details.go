@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image/color"
 	"strconv"
+	"strings"
 
 	"github.com/aarzilli/nucular"
 	"github.com/decred/dcrd/dcrutil"
@@ -20,6 +21,7 @@ func (handler *HistoryHandler) clearTxDetails() {
 	handler.selectedTxDetails = nil
 	handler.isFetchingTxDetails = false
 	handler.fetchTxDetailsError = nil
+	handler.txLabelInput = nil
 }
 
 func (handler *HistoryHandler) gotoTransactionDetails(txHash string, window *widgets.Window) {
@@ -32,6 +34,11 @@ func (handler *HistoryHandler) renderTransactionDetailsPage(window *nucular.Wind
 		handler.isFetchingTxDetails = true
 		go func() {
 			handler.selectedTxDetails, handler.fetchTxDetailsError = handler.wallet.GetTransaction(handler.selectedTxHash)
+			if handler.selectedTxDetails != nil {
+				handler.txLabelInput = &nucular.TextEditor{}
+				handler.txLabelInput.Flags = nucular.EditClipboard | nucular.EditSimple
+				handler.txLabelInput.Buffer = []rune(handler.selectedTxDetails.Label)
+			}
 			handler.isFetchingTxDetails = false
 			window.Master().Changed()
 		}()
@@ -106,6 +113,10 @@ func (handler *HistoryHandler) displayTransactionDetails(contentWindow *widgets.
 		widgets.NewLabelTableCell("Date", "LC"),
 		widgets.NewLabelTableCell(fmt.Sprintf("%s UTC", handler.selectedTxDetails.LongTime), "LC"),
 	)
+	txDetailsTable.AddRow(
+		widgets.NewLabelTableCell("Label", "LC"),
+		widgets.NewLabelTableCell(handler.selectedTxDetails.Label, "LC"),
+	)
 
 	txInputsTable := widgets.NewTable()
 	txInputsTable.AddRowWithFont(styles.NavFont,
@@ -140,7 +151,8 @@ func (handler *HistoryHandler) displayTransactionDetails(contentWindow *widgets.
 	}
 
 	// calculate additionally used horizontal space
-	hSpace := (dividerHeight * 2) + (widgets.TableRowHeight * 3) // 2 horizontal spaces + 3 lines of text (2 table headers, 1 breadcrumb)
+	// 3 horizontal spaces + 4 lines of text (3 section headers, 1 breadcrumb) + 1 label editor
+	hSpace := (dividerHeight * 3) + (widgets.TableRowHeight * 4) + widgets.EditorHeight
 
 	contentWindow.Window.Row(handler.calculateTxDetailsPageHeight(txDetailsTable.Height(), txInputsTable.Height(), txOutputsTable.Height(), hSpace)).Static(730)
 	widgets.NoScrollGroupWindow("tx-details-group-1", contentWindow.Window, func(window *widgets.Window) {
@@ -169,9 +181,36 @@ func (handler *HistoryHandler) displayTransactionDetails(contentWindow *widgets.
 		window.AddLabelWithFont("Outputs", "LC", styles.BoldPageContentFont)
 
 		txOutputsTable.Render(window)
+
+		window.AddHorizontalSpace(dividerHeight)
+		window.AddLabelWithFont("Label", "LC", styles.BoldPageContentFont)
+		window.Row(widgets.EditorHeight).Static(300, window.ButtonWidth("Save Label"))
+		window.AddEditorToCurrentRow(handler.txLabelInput)
+		window.AddButtonToCurrentRow("Save Label", func() {
+			handler.saveTxLabel(window)
+		})
 	})
 }
 
+func (handler *HistoryHandler) saveTxLabel(window *widgets.Window) {
+	defer window.Master().Changed()
+
+	label := string(handler.txLabelInput.Buffer)
+	if err := handler.wallet.SetTransactionLabel(handler.selectedTxHash, label); err != nil {
+		widgets.NewAlertWidget(fmt.Sprintf("Error saving transaction label: %s", err.Error()), true, window)
+		return
+	}
+
+	handler.selectedTxDetails.Label = strings.TrimSpace(label)
+	// the label column of the history table needs to show the new label too
+	for _, tx := range handler.transactions {
+		if tx.Hash == handler.selectedTxHash {
+			tx.Label = handler.selectedTxDetails.Label
+		}
+	}
+	widgets.NewAlertWidget("Transaction label saved", false, window)
+}
+
 func (handler *HistoryHandler) calculateTxDetailsPageHeight(tableHeights ...int) int {
 	var totalTableHeight int
 
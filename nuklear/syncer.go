@@ -1,12 +1,15 @@
 package nuklear
 
 import (
+	"context"
 	"fmt"
 	"image"
+	"sync"
 
 	"github.com/aarzilli/nucular"
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
 	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/nuklear/styles"
 	"github.com/raedahgroup/godcr/nuklear/widgets"
 )
@@ -18,6 +21,13 @@ type Syncer struct {
 	showDetails        bool
 	status             defaultsynclistener.SyncStatus
 	syncError          error
+
+	// rescan state, cancelRescan is nil if no rescan is in progress
+	rescanMu         sync.Mutex
+	cancelRescan     context.CancelFunc
+	rescanPercentage int
+	rescanReport     string
+	rescanError      error
 }
 
 func NewSyncer() *Syncer {
@@ -79,8 +89,10 @@ func (s *Syncer) startSyncing(walletMiddleware app.WalletMiddleware, masterWindo
 			s.report = append(s.report, fmt.Sprintf("%d%% through step 3 of 3.", progressReport.HeadersFetchProgress))
 		}
 
-		// show peer count last
-		if progressReport.ConnectedPeers == 1 {
+		// show peer count last, dcrlibwallet reports -1 peers for wallets that sync with a dcrd node over rpc
+		if progressReport.ConnectedPeers < 0 {
+			s.report = append(s.report, fmt.Sprintf("Syncing with dcrd on %s.", walletMiddleware.NetType()))
+		} else if progressReport.ConnectedPeers == 1 {
 			s.report = append(s.report, fmt.Sprintf("Syncing with %d peer on %s.", progressReport.ConnectedPeers, walletMiddleware.NetType()))
 		} else {
 			s.report = append(s.report, fmt.Sprintf("Syncing with %d peers on %s.", progressReport.ConnectedPeers, walletMiddleware.NetType()))
@@ -90,6 +102,74 @@ func (s *Syncer) startSyncing(walletMiddleware app.WalletMiddleware, masterWindo
 	})
 }
 
+// startRescan rescans the blockchain in the background, repainting the window as the rescan progresses.
+func (s *Syncer) startRescan(ctx context.Context, walletMiddleware app.WalletMiddleware, masterWindow nucular.MasterWindow) {
+	s.rescanMu.Lock()
+	if s.cancelRescan != nil {
+		s.rescanMu.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancelRescan = cancel
+	s.rescanPercentage = 0
+	s.rescanReport = "Starting rescan..."
+	s.rescanError = nil
+	s.rescanMu.Unlock()
+
+	go func() {
+		defer cancel()
+
+		err := walletMiddleware.RescanBlockChain(ctx, func(progress walletcore.RescanProgress) {
+			s.rescanMu.Lock()
+			s.rescanPercentage = int(progress.Percentage())
+			s.rescanReport = fmt.Sprintf("Scanned %d of %d blocks.", progress.CurrentHeight, progress.TotalHeight)
+			s.rescanMu.Unlock()
+			masterWindow.Changed()
+		})
+
+		s.rescanMu.Lock()
+		s.cancelRescan = nil
+		if err != nil && err != context.Canceled {
+			s.rescanError = err
+		}
+		s.rescanMu.Unlock()
+		masterWindow.Changed()
+	}()
+}
+
+func (s *Syncer) stopRescan() {
+	s.rescanMu.Lock()
+	defer s.rescanMu.Unlock()
+	if s.cancelRescan != nil {
+		s.cancelRescan()
+	}
+}
+
+// renderRescanStatus draws the progress of a rescan in progress with a button to cancel it,
+// or a button to start a rescan if no rescan is in progress.
+func (s *Syncer) renderRescanStatus(window *widgets.Window, startRescan func()) {
+	s.rescanMu.Lock()
+	rescanning := s.cancelRescan != nil
+	rescanPercentage := s.rescanPercentage
+	rescanReport := s.rescanReport
+	rescanError := s.rescanError
+	s.rescanMu.Unlock()
+
+	if !rescanning {
+		if rescanError != nil {
+			window.AddWrappedLabelWithColor(fmt.Sprintf("Rescan failed: %s", rescanError.Error()), widgets.CenterAlign,
+				styles.DecredOrangeColor)
+		}
+		window.AddBigButton("Rescan Blockchain", startRescan)
+		return
+	}
+
+	window.AddLabel(fmt.Sprintf("Rescanning %d%%", rescanPercentage), widgets.CenterAlign)
+	window.AddProgressBar(&rescanPercentage, 100)
+	window.AddWrappedLabel(rescanReport, widgets.CenterAlign)
+	window.AddBigButton("Cancel Rescan", s.stopRescan)
+}
+
 func (s *Syncer) isDoneSyncing() bool {
 	return s.status == defaultsynclistener.SyncStatusSuccess
 }
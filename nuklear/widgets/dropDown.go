@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aarzilli/nucular"
 	"github.com/aarzilli/nucular/label"
+	"github.com/raedahgroup/godcr/app/txfilter"
 	"github.com/raedahgroup/godcr/app/walletcore"
 )
 
@@ -14,18 +16,42 @@ type FilterSelector struct {
 	txCountForAllFilters   map[string]int
 	selectedFilterIndex    int
 	selectionChanged       func()
+
+	// editors for the criteria that further narrow down the txs for the selected filter
+	fromDateInput   *nucular.TextEditor
+	toDateInput     *nucular.TextEditor
+	minAmountInput  *nucular.TextEditor
+	maxAmountInput  *nucular.TextEditor
+	addressInput    *nucular.TextEditor
+	accountInput    *nucular.TextEditor
+	hashPrefixInput *nucular.TextEditor
 }
 
 const (
 	defaultFilterSelectorWidth = 120
 	filterSelectorHeight       = 25
+	criteriaInputWidth         = 110
+	wideCriteriaInputWidth     = 300
 )
 
+func newCriteriaInput() *nucular.TextEditor {
+	input := &nucular.TextEditor{}
+	input.Flags = nucular.EditClipboard | nucular.EditSimple
+	return input
+}
+
 func FilterSelectorWidget(wallet walletcore.Wallet, selectionChanged func()) (*FilterSelector, error) {
 	filterSelector := &FilterSelector{
 		selectionChanged:     selectionChanged,
 		wallet:               wallet,
 		txCountForAllFilters: make(map[string]int),
+		fromDateInput:        newCriteriaInput(),
+		toDateInput:          newCriteriaInput(),
+		minAmountInput:       newCriteriaInput(),
+		maxAmountInput:       newCriteriaInput(),
+		addressInput:         newCriteriaInput(),
+		accountInput:         newCriteriaInput(),
+		hashPrefixInput:      newCriteriaInput(),
 	}
 
 	for _, filter := range walletcore.TransactionFilters {
@@ -54,6 +80,68 @@ func (filterSelector *FilterSelector) Render(window *Window) {
 
 	// render actual dropdown to second column
 	filterSelector.makeDropDown(window)
+
+	filterSelector.renderCriteriaInputs(window)
+}
+
+func (filterSelector *FilterSelector) renderCriteriaInputs(window *Window) {
+	if len(filterSelector.filterSelectionOptions) == 0 {
+		// no txs to filter
+		return
+	}
+
+	window.Row(EditorHeight).Static(
+		window.LabelWidth("From (YYYY-MM-DD)"), criteriaInputWidth,
+		window.LabelWidth("To"), criteriaInputWidth,
+		window.LabelWidth("Min DCR"), criteriaInputWidth,
+		window.LabelWidth("Max DCR"), criteriaInputWidth,
+	)
+	window.Label("From (YYYY-MM-DD)", LeftCenterAlign)
+	window.AddEditorToCurrentRow(filterSelector.fromDateInput)
+	window.Label("To", LeftCenterAlign)
+	window.AddEditorToCurrentRow(filterSelector.toDateInput)
+	window.Label("Min DCR", LeftCenterAlign)
+	window.AddEditorToCurrentRow(filterSelector.minAmountInput)
+	window.Label("Max DCR", LeftCenterAlign)
+	window.AddEditorToCurrentRow(filterSelector.maxAmountInput)
+
+	window.Row(EditorHeight).Static(
+		window.LabelWidth("Address"), wideCriteriaInputWidth,
+		window.LabelWidth("Account"), criteriaInputWidth,
+		window.LabelWidth("Hash"), criteriaInputWidth,
+		window.ButtonWidth("Apply"), window.ButtonWidth("Clear"),
+	)
+	window.Label("Address", LeftCenterAlign)
+	window.AddEditorToCurrentRow(filterSelector.addressInput)
+	window.Label("Account", LeftCenterAlign)
+	window.AddEditorToCurrentRow(filterSelector.accountInput)
+	window.Label("Hash", LeftCenterAlign)
+	window.AddEditorToCurrentRow(filterSelector.hashPrefixInput)
+	window.AddButtonToCurrentRow("Apply", func() {
+		if filterSelector.selectionChanged != nil {
+			filterSelector.selectionChanged()
+		}
+	})
+	window.AddButtonToCurrentRow("Clear", func() {
+		for _, input := range filterSelector.criteriaInputs() {
+			input.Buffer = nil
+		}
+		if filterSelector.selectionChanged != nil {
+			filterSelector.selectionChanged()
+		}
+	})
+}
+
+func (filterSelector *FilterSelector) criteriaInputs() []*nucular.TextEditor {
+	return []*nucular.TextEditor{
+		filterSelector.fromDateInput,
+		filterSelector.toDateInput,
+		filterSelector.minAmountInput,
+		filterSelector.maxAmountInput,
+		filterSelector.addressInput,
+		filterSelector.accountInput,
+		filterSelector.hashPrefixInput,
+	}
 }
 
 // makeDropDown is adapted from nucular's Window.ComboSimple
@@ -81,6 +169,19 @@ func (filterSelector *FilterSelector) makeDropDown(window *Window) {
 	}
 }
 
+// GetCriteria returns the criteria entered to further narrow down the txs for the selected filter.
+func (filterSelector *FilterSelector) GetCriteria() (*txfilter.Criteria, error) {
+	return txfilter.ParseCriteria(txfilter.CriteriaInput{
+		From:       string(filterSelector.fromDateInput.Buffer),
+		To:         string(filterSelector.toDateInput.Buffer),
+		MinAmount:  string(filterSelector.minAmountInput.Buffer),
+		MaxAmount:  string(filterSelector.maxAmountInput.Buffer),
+		Address:    string(filterSelector.addressInput.Buffer),
+		Account:    string(filterSelector.accountInput.Buffer),
+		HashPrefix: string(filterSelector.hashPrefixInput.Buffer),
+	})
+}
+
 // GetSelectedFilter returns the name of the selected filter and the tx count for the filter.
 func (filterSelector *FilterSelector) GetSelectedFilter() (string, int) {
 	selectedOption := filterSelector.filterSelectionOptions[filterSelector.selectedFilterIndex]
@@ -5,9 +5,26 @@ go 1.12
 require (
 	github.com/atotto/clipboard v0.1.2
 	github.com/decred/dcrd/dcrutil v1.2.0
+	github.com/decred/dcrwallet/errors v1.0.1
 	github.com/decred/slog v1.0.0
 	github.com/gdamore/tcell v1.1.1
 	github.com/raedahgroup/dcrlibwallet v1.1.1-0.20190928085114-bcc6e6b7769a
+	github.com/raedahgroup/godcr/app/addressbook v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/events v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/paymenturi v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/seedbackup v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/txfilter v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/txlabels v0.0.0-00010101000000-000000000000
 	github.com/rivo/tview v0.0.0-20190113120821-e5e361b9d790
 	github.com/skip2/go-qrcode v0.0.0-20190110000554-dc11ecdae0a9
 )
+
+replace (
+	github.com/raedahgroup/godcr/app/addressbook => ../app/addressbook
+	github.com/raedahgroup/godcr/app/events => ../app/events
+	github.com/raedahgroup/godcr/app/jsonfile => ../app/jsonfile
+	github.com/raedahgroup/godcr/app/paymenturi => ../app/paymenturi
+	github.com/raedahgroup/godcr/app/seedbackup => ../app/seedbackup
+	github.com/raedahgroup/godcr/app/txfilter => ../app/txfilter
+	github.com/raedahgroup/godcr/app/txlabels => ../app/txlabels
+)
@@ -3,6 +3,7 @@ package pages
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/gdamore/tcell"
@@ -40,15 +41,33 @@ func accountsPage() tview.Primitive {
 	hideAccount := primitives.NewCheckbox("Hide this account (Account balance will be ignored): ")
 	defaultAccount := primitives.NewCheckbox("Default account (Make this account default for all outgoing and incoming transactions): ")
 
+	renameAccountForm := primitives.NewForm(false)
+	renameAccountForm.SetBorderPadding(0, 0, 0, 0)
+
+	createAccountPages := tview.NewPages()
+	createAccountForm := primitives.NewForm(false)
+	createAccountForm.SetBorderPadding(0, 0, 0, 0)
+	createAccountPages.AddPage("form", createAccountForm, true, true)
+
 	displayAccountsTable := func() {
 		accountPage.RemoveItem(accountPropertiesTable)
 		accountPage.RemoveItem(hideAccount)
 		accountPage.RemoveItem(defaultAccount)
+		accountPage.RemoveItem(renameAccountForm)
+		accountPage.RemoveItem(createAccountPages)
 
 		titleTextView.SetText("Accounts")
-		commonPageData.hintTextView.SetText("TIP: Use ARROW UP/DOWN to select an account,\nENTER to view details, ESC to return to navigation menu")
+		if commonPageData.watchingOnly {
+			commonPageData.hintTextView.SetText("TIP: Use ARROW UP/DOWN to select an account,\nENTER to view details, ESC to return to navigation menu")
+		} else {
+			commonPageData.hintTextView.SetText("TIP: Use ARROW UP/DOWN to select an account, ENTER to view details,\nTAB to create a new account, ESC to return to navigation menu")
+		}
 
 		accountPage.AddItem(accountsTable, 0, 1, true)
+		// watch-only wallets cannot create accounts
+		if !commonPageData.watchingOnly {
+			accountPage.AddItem(createAccountPages, 5, 0, false)
+		}
 		commonPageData.app.SetFocus(accountsTable)
 	}
 
@@ -58,11 +77,65 @@ func accountsPage() tview.Primitive {
 		}
 	})
 
+	accountsTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyTab && !commonPageData.watchingOnly {
+			commonPageData.app.SetFocus(createAccountForm)
+			return nil
+		}
+
+		return event
+	})
+
 	accounts, err := commonPageData.wallet.GetAccountsRaw(0)
 	if err != nil {
 		displayMessage(err.Error())
 	}
 
+	// reloadAccounts fetches the wallet accounts again after an account is created or renamed
+	reloadAccounts := func() {
+		accounts, err = commonPageData.wallet.GetAccountsRaw(0)
+		if err != nil {
+			displayMessage(err.Error())
+			return
+		}
+
+		accountsTable.Clear()
+		displayWalletAcccounts(accounts.Acc, accountsTable)
+	}
+
+	var newAccountName string
+	createAccountForm.AddInputField("New Account Name:", "", 20, nil, func(text string) {
+		newAccountName = text
+	})
+
+	createAccountForm.AddButton("Create", func() {
+		if strings.TrimSpace(newAccountName) == "" {
+			displayMessage("Error: please specify a name for the new account")
+			return
+		}
+
+		helpers.RequestSpendingPassphrase(createAccountPages, func(passphrase string) {
+			commonPageData.app.SetFocus(createAccountForm)
+
+			err := commonPageData.wallet.NextAccount(strings.TrimSpace(newAccountName), []byte(passphrase))
+			if err != nil {
+				displayMessage(fmt.Sprintf("Error creating account: %s", err.Error()))
+				return
+			}
+
+			displayMessage("")
+			createAccountForm.ClearFields()
+			reloadAccounts()
+			commonPageData.app.SetFocus(accountsTable)
+		}, func() {
+			commonPageData.app.SetFocus(createAccountForm)
+		})
+	})
+
+	createAccountForm.SetCancelFunc(func() {
+		commonPageData.app.SetFocus(accountsTable)
+	})
+
 	// method for showing account details when an account is selected from the accounts table
 	var selectedAccount *dcrlibwallet.Account
 	accountsTable.SetSelectedFunc(func(row, column int) {
@@ -70,8 +143,11 @@ func accountsPage() tview.Primitive {
 		selectedRow := row - 1
 		selectedAccount = accounts.Acc[selectedRow]
 
+		accountPage.RemoveItem(createAccountPages)
+		displayMessage("")
+
 		titleTextView.SetText("Account Details")
-		commonPageData.hintTextView.SetText("TIP: Use TAB key to switch between checkbox, \nBACKSPACE to retun to accounts page, ESC to return to navigation menu")
+		commonPageData.hintTextView.SetText("TIP: Use TAB key to switch between checkboxes and the rename form, \nBACKSPACE to retun to accounts page, ESC to return to navigation menu")
 
 		accountPage.AddItem(accountPropertiesTable, 9, 0, true)
 		commonPageData.app.SetFocus(hideAccount)
@@ -83,8 +159,34 @@ func accountsPage() tview.Primitive {
 
 		accountPage.AddItem(hideAccount, 2, 0, false)
 		accountPage.AddItem(defaultAccount, 2, 0, false)
+
+		renameAccountForm.Clear(true)
+		newName := selectedAccount.Name
+		renameAccountForm.AddInputField("Account Name:", newName, 20, nil, func(text string) {
+			newName = text
+		})
+		renameAccountForm.AddButton("Rename", func() {
+			if strings.TrimSpace(newName) == "" {
+				displayMessage("Error: account name cannot be empty")
+				return
+			}
+
+			err := commonPageData.wallet.RenameAccount(selectedAccount.Number, strings.TrimSpace(newName))
+			if err != nil {
+				displayMessage(fmt.Sprintf("Error renaming account: %s", err.Error()))
+				return
+			}
+
+			selectedAccount.Name = strings.TrimSpace(newName)
+			displayAccountsDetails(commonPageData.wallet.NetType(), selectedAccount, accountPropertiesTable)
+			displayMessage("")
+			reloadAccounts()
+		})
+		accountPage.AddItem(renameAccountForm, 4, 0, false)
 	})
 
+	renameAccountForm.SetCancelFunc(displayAccountsTable)
+
 	// handler for returning back to accounts page
 	accountPropertiesTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		if event.Key() == tcell.KeyEscape || event.Key() == tcell.KeyBackspace || event.Key() == tcell.KeyBackspace2 {
@@ -122,7 +224,7 @@ func accountsPage() tview.Primitive {
 		}
 
 		if event.Key() == tcell.KeyTab {
-			commonPageData.app.SetFocus(hideAccount)
+			commonPageData.app.SetFocus(renameAccountForm)
 			return nil
 		}
 
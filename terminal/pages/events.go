@@ -0,0 +1,50 @@
+package pages
+
+import (
+	"encoding/json"
+
+	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/events"
+)
+
+// walletEvents carries the tx and block notifications of the wallet to the pages that display wallet data.
+var walletEvents = events.NewBus()
+
+// walletEventPublisher publishes the tx and block notifications of dcrlibwallet on walletEvents.
+type walletEventPublisher struct{}
+
+func (publisher walletEventPublisher) OnTransaction(transaction string) {
+	var tx dcrlibwallet.Transaction
+	if err := json.Unmarshal([]byte(transaction), &tx); err != nil {
+		commonPageData.log.Errorf("Error reading tx notification: %v", err)
+		return
+	}
+
+	walletEvents.Publish(events.Event{
+		Type: events.TxReceived,
+		Data: events.Tx{
+			Hash:        tx.Hash,
+			Amount:      tx.Amount,
+			Fee:         tx.Fee,
+			Direction:   tx.Direction,
+			BlockHeight: tx.BlockHeight,
+		},
+	})
+	walletEvents.Publish(events.Event{Type: events.BalanceChanged})
+}
+
+func (publisher walletEventPublisher) OnTransactionConfirmed(hash string, height int32) {
+	walletEvents.Publish(events.Event{
+		Type: events.TxConfirmed,
+		Data: events.Tx{Hash: hash, BlockHeight: height},
+	})
+	walletEvents.Publish(events.Event{Type: events.BalanceChanged})
+}
+
+// OnBlockAttached converts the block timestamp from the nanoseconds reported by dcrlibwallet to seconds.
+func (publisher walletEventPublisher) OnBlockAttached(height int32, timestamp int64) {
+	walletEvents.Publish(events.Event{
+		Type: events.BlockAttached,
+		Data: events.Block{Height: height, Timestamp: timestamp / 1e9},
+	})
+}
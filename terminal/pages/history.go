@@ -8,6 +8,7 @@ import (
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/gdamore/tcell"
 	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/txfilter"
 	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/primitives"
 	"github.com/rivo/tview"
@@ -28,12 +29,18 @@ var historyPageData struct {
 	messageTextView   *primitives.TextView
 
 	txFilterDropDown        *primitives.Form
+	txCriteriaForm          *primitives.Form
 	historyTable            *tview.Table
 	transactionDetailsTable *tview.Table
 
 	currentTxFilter           int32
 	activeFiltersWithTxCounts map[int32]int
 	displayedTxs              []*dcrlibwallet.Transaction
+
+	// txCriteriaInput holds the text entered in the criteria form,
+	// txCriteria is the criteria that the displayed txs match
+	txCriteriaInput txfilter.CriteriaInput
+	txCriteria      *txfilter.Criteria
 }
 
 func historyPage() tview.Primitive {
@@ -53,9 +60,14 @@ func historyPage() tview.Primitive {
 
 	historyPageData.messageTextView = primitives.WordWrappedTextView("")
 
+	historyPageData.txCriteriaInput = txfilter.CriteriaInput{}
+	historyPageData.txCriteria = nil
+
 	historyPageData.txFilterDropDown = prepareTxFilterDropDown()
 	if historyPageData.txFilterDropDown != nil {
 		historyPageData.pageContentHolder.AddItem(historyPageData.txFilterDropDown, 2, 0, false)
+		historyPageData.txCriteriaForm = prepareTxCriteriaForm()
+		historyPageData.pageContentHolder.AddItem(historyPageData.txCriteriaForm, 4, 0, false)
 	} else {
 		commonPageData.app.SetFocus(historyPageData.pageContentHolder)
 		return historyPageData.pageContentHolder
@@ -132,7 +144,7 @@ func prepareTxFilterDropDown() *primitives.Form {
 			return nil
 		}
 		if event.Key() == tcell.KeyTab {
-			commonPageData.app.SetFocus(historyPageData.historyTable)
+			commonPageData.app.SetFocus(historyPageData.txCriteriaForm)
 			return nil
 		}
 		return event
@@ -141,6 +153,53 @@ func prepareTxFilterDropDown() *primitives.Form {
 	return txFilterDropDown
 }
 
+// prepareTxCriteriaForm creates the form for narrowing down the txs of the selected filter by date, amount, address,
+// account and hash. The criteria is applied when the Apply button is pressed.
+func prepareTxCriteriaForm() *primitives.Form {
+	criteriaForm := primitives.NewForm(false)
+	criteriaForm.SetHorizontal(true)
+	criteriaForm.SetBorderPadding(0, 0, 0, 0)
+
+	input := &historyPageData.txCriteriaInput
+	criteriaForm.AddInputField("From:", "", 11, nil, func(text string) { input.From = text })
+	criteriaForm.AddInputField("To:", "", 11, nil, func(text string) { input.To = text })
+	criteriaForm.AddInputField("Min DCR:", "", 10, nil, func(text string) { input.MinAmount = text })
+	criteriaForm.AddInputField("Max DCR:", "", 10, nil, func(text string) { input.MaxAmount = text })
+	criteriaForm.AddInputField("Address:", "", 36, nil, func(text string) { input.Address = text })
+	criteriaForm.AddInputField("Account:", "", 12, nil, func(text string) { input.Account = text })
+	criteriaForm.AddInputField("Hash:", "", 12, nil, func(text string) { input.HashPrefix = text })
+
+	criteriaForm.AddButton("Apply", func() {
+		criteria, err := txfilter.ParseCriteria(historyPageData.txCriteriaInput)
+		if err != nil {
+			displayMessage(err.Error(), MessageKindError)
+			return
+		}
+
+		historyPageData.txCriteria = criteria
+		resetHistoryTable()
+		commonPageData.app.SetFocus(historyPageData.historyTable)
+		go fetchAndDisplayTransactions(0, historyPageData.currentTxFilter)
+	})
+
+	criteriaForm.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			commonPageData.clearAllPageContent()
+			return nil
+		}
+		return event
+	})
+
+	return criteriaForm
+}
+
+// resetHistoryTable removes all displayed txs so that txs can be reloaded from the first page.
+func resetHistoryTable() {
+	historyPageData.displayedTxs = nil
+	historyPageData.historyTable.Clear()
+	historyPageData.historyTable.SetSelectionChangedFunc(nil)
+}
+
 func prepareHistoryTable() *tview.Table {
 	historyTable := tview.NewTable().
 		SetBorders(false).
@@ -175,6 +234,7 @@ func prepareHistoryTable() *tview.Table {
 
 		historyPageData.pageContentHolder.RemoveItem(historyTable)
 		historyPageData.pageContentHolder.RemoveItem(historyPageData.txFilterDropDown)
+		historyPageData.pageContentHolder.RemoveItem(historyPageData.txCriteriaForm)
 
 		historyPageData.titleTextView.SetText("Transaction Details")
 		commonPageData.hintTextView.SetText("TIP: Use ARROW UP/DOWN to scroll, \nBACKSPACE to view History page, ESC to return to navigation menu")
@@ -197,6 +257,7 @@ func prepareTxDetailsTable() *tview.Table {
 	transactionDetailsTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		if event.Key() == tcell.KeyEscape || event.Key() == tcell.KeyBackspace || event.Key() == tcell.KeyBackspace2 {
 			historyPageData.pageContentHolder.AddItem(historyPageData.txFilterDropDown, 2, 0, false)
+			historyPageData.pageContentHolder.AddItem(historyPageData.txCriteriaForm, 4, 0, false)
 
 			historyPageData.pageContentHolder.RemoveItem(historyPageData.transactionDetailsTable)
 
@@ -222,12 +283,17 @@ func fetchAndDisplayTransactions(txOffset int, filter int32) {
 	if filter != historyPageData.currentTxFilter {
 		// filter changed, reset data
 		txOffset = 0
-		historyPageData.displayedTxs = nil
 		historyPageData.currentTxFilter = filter
-		historyPageData.historyTable.Clear()
+		resetHistoryTable()
 	}
 
-	txns, err := commonPageData.wallet.GetTransactionsRaw(int32(txOffset), txPerPage, historyPageData.currentTxFilter)
+	var txns []*dcrlibwallet.Transaction
+	var err error
+	if historyPageData.txCriteria.IsEmpty() {
+		txns, err = commonPageData.wallet.GetTransactionsRaw(int32(txOffset), txPerPage, historyPageData.currentTxFilter)
+	} else {
+		txns, err = fetchTransactionsMatchingCriteria(historyPageData.currentTxFilter, historyPageData.txCriteria)
+	}
 	if err != nil {
 		displayMessage(err.Error(), MessageKindError)
 		return
@@ -281,13 +347,28 @@ func fetchAndDisplayTransactions(txOffset int, filter int32) {
 				SetExpansion(1)
 			historyPageData.historyTable.SetCell(nextRowIndex, 4, typeCell)
 
+			labelCell := tview.NewTableCell(commonPageData.txLabels.Label(tx.Hash)).
+				SetAlign(tview.AlignLeft).
+				SetMaxWidth(3).
+				SetExpansion(1)
+			historyPageData.historyTable.SetCell(nextRowIndex, 5, labelCell)
+
 			historyPageData.displayedTxs = append(historyPageData.displayedTxs, tx)
 		}
 
 		// clear loading message text
-		displayMessage("", MessageKindInfo)
+		if len(historyPageData.displayedTxs) == 0 {
+			displayMessage("No transactions match the filters", MessageKindInfo)
+		} else {
+			displayMessage("", MessageKindInfo)
+		}
 	})
 
+	if !historyPageData.txCriteria.IsEmpty() {
+		// all txs matching the criteria are displayed at once
+		return
+	}
+
 	totalTxCountForCurrentFilter := historyPageData.activeFiltersWithTxCounts[historyPageData.currentTxFilter]
 	if len(historyPageData.displayedTxs) < totalTxCountForCurrentFilter {
 		// set or reset selection changed listener to load more data when the table is almost scrolled to the end
@@ -303,6 +384,55 @@ func fetchAndDisplayTransactions(txOffset int, filter int32) {
 	return
 }
 
+// fetchTransactionsMatchingCriteria reads the txs for `filter` a page at a time and returns all that match `criteria`.
+// The tx index cannot filter txs by the criteria, so all matching txs are loaded at once.
+func fetchTransactionsMatchingCriteria(filter int32, criteria *txfilter.Criteria) ([]*dcrlibwallet.Transaction, error) {
+	var matchingTxs []*dcrlibwallet.Transaction
+	var offset int32
+	for {
+		txns, err := commonPageData.wallet.GetTransactionsRaw(offset, txPerPage, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range txns {
+			if criteria.IsBeforeRange(tx.Timestamp) {
+				// txs are read newest first, so the remaining txs are also before the date range
+				return matchingTxs, nil
+			}
+			if criteria.Match(txFilterFields(tx)) {
+				matchingTxs = append(matchingTxs, tx)
+			}
+		}
+
+		if int32(len(txns)) < txPerPage {
+			return matchingTxs, nil
+		}
+		offset += int32(len(txns))
+	}
+}
+
+// txFilterFields returns the fields of `tx` that txfilter criteria are checked against.
+func txFilterFields(tx *dcrlibwallet.Transaction) txfilter.Tx {
+	fields := txfilter.Tx{
+		Hash:      tx.Hash,
+		Timestamp: tx.Timestamp,
+		Amount:    tx.Amount,
+	}
+	for _, txIn := range tx.Inputs {
+		if txIn.AccountNumber != -1 {
+			fields.Accounts = append(fields.Accounts, txIn.AccountName)
+		}
+	}
+	for _, txOut := range tx.Outputs {
+		fields.Addresses = append(fields.Addresses, txOut.Address)
+		if txOut.AccountNumber != -1 {
+			fields.Accounts = append(fields.Accounts, txOut.AccountName)
+		}
+	}
+	return fields
+}
+
 func displayTxDetails(tx *dcrlibwallet.Transaction, transactionDetailsTable *tview.Table) {
 	transactionDetailsTable.SetCellSimple(0, 0, "Hash")
 	transactionDetailsTable.SetCellSimple(1, 0, "Confirmations")
@@ -313,6 +443,7 @@ func displayTxDetails(tx *dcrlibwallet.Transaction, transactionDetailsTable *tvi
 	transactionDetailsTable.SetCellSimple(6, 0, "Direction")
 	transactionDetailsTable.SetCellSimple(7, 0, "Fee")
 	transactionDetailsTable.SetCellSimple(8, 0, "Fee Rate")
+	transactionDetailsTable.SetCellSimple(9, 0, "Label")
 
 	var confirmations int32 = 0
 	if tx.BlockHeight != -1 {
@@ -328,6 +459,7 @@ func displayTxDetails(tx *dcrlibwallet.Transaction, transactionDetailsTable *tvi
 	transactionDetailsTable.SetCellSimple(6, 1, dcrlibwallet.TransactionDirectionName(tx.Direction))
 	transactionDetailsTable.SetCellSimple(7, 1, dcrutil.Amount(tx.Fee).String())
 	transactionDetailsTable.SetCellSimple(8, 1, fmt.Sprintf("%s/kB", dcrutil.Amount(tx.FeeRate)))
+	transactionDetailsTable.SetCellSimple(9, 1, commonPageData.txLabels.Label(tx.Hash))
 
 	// calculate max number of digits after decimal point for inputs and outputs
 	inputsAndOutputsAmount := make([]int64, 0, len(tx.Inputs)+len(tx.Outputs))
@@ -344,7 +476,7 @@ func displayTxDetails(tx *dcrlibwallet.Transaction, transactionDetailsTable *tvi
 		return helpers.FormatAmountDisplay(amount, maxDecimalPlacesForInputsAndOutputsAmounts)
 	}
 
-	transactionDetailsTable.SetCellSimple(9, 0, "-Inputs-")
+	transactionDetailsTable.SetCellSimple(10, 0, "-Inputs-")
 	for _, txIn := range tx.Inputs {
 		row := transactionDetailsTable.GetRowCount()
 		transactionDetailsTable.SetCell(row, 0, tview.NewTableCell(formatAmount(txIn.Amount)).SetAlign(tview.AlignRight))
@@ -6,6 +6,7 @@ import (
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/gdamore/tcell"
 	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/events"
 	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/primitives"
 	"github.com/rivo/tview"
@@ -14,12 +15,20 @@ import (
 func overviewPage() tview.Primitive {
 	overviewPage := tview.NewFlex().SetDirection(tview.FlexRow)
 
-	renderBalance(overviewPage)
+	if !seedBackupVerified() {
+		warningTextView := primitives.WordWrappedTextView("You have not verified that you have a backup of your wallet seed. " +
+			"Without the seed, you will not be able to restore your wallet if this device is lost. " +
+			"Verify your seed backup on the Security page.")
+		warningTextView.SetTextColor(helpers.DecredOrangeColor)
+		overviewPage.AddItem(warningTextView, 3, 0, false)
+	}
+
+	reloadBalance := renderBalance(overviewPage)
 
 	// single line space between balance and recent activity section
 	overviewPage.AddItem(nil, 1, 0, false)
 
-	renderRecentActivity(overviewPage)
+	reloadRecentActivity := renderRecentActivity(overviewPage)
 
 	// single line space between recent activity and sync section
 	overviewPage.AddItem(nil, 1, 0, false)
@@ -28,53 +37,103 @@ func overviewPage() tview.Primitive {
 
 	commonPageData.hintTextView.SetText("TIP: Scroll recent activity table with ARROW KEYS. Return to navigation menu with ESC")
 
-	overviewPage.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyEscape || event.Key() == tcell.KeyBackspace || event.Key() == tcell.KeyBackspace2 {
-			commonPageData.clearAllPageContent()
-			return nil
-		}
-		return event
-	})
+	overviewPage.SetInputCapture(handleOverviewKeys)
 
 	commonPageData.app.SetFocus(overviewPage)
 
+	// show new txs, confirmations and balance changes as the wallet notifies them
+	reloadOnWalletEvents(func() {
+		reloadBalance()
+		reloadRecentActivity()
+	})
+
 	return overviewPage
 }
 
-func renderBalance(overviewPage *tview.Flex) {
+func handleOverviewKeys(event *tcell.EventKey) *tcell.EventKey {
+	if event.Key() == tcell.KeyEscape || event.Key() == tcell.KeyBackspace || event.Key() == tcell.KeyBackspace2 {
+		commonPageData.clearAllPageContent()
+		return nil
+	}
+	return handleRescanKeys(event)
+}
+
+// unsubscribeOverview stops reloading the overview page that was displayed last, before another overview page is displayed.
+var unsubscribeOverview func()
+
+// reloadOnWalletEvents calls `reload` on the ui goroutine when the wallet notifies new txs or blocks.
+// Only the overview page displayed last is reloaded.
+func reloadOnWalletEvents(reload func()) {
+	if unsubscribeOverview != nil {
+		unsubscribeOverview()
+	}
+	unsubscribeOverview = walletEvents.Subscribe(func(_ events.Event) {
+		commonPageData.app.QueueUpdateDraw(reload)
+	}, events.TxReceived, events.BlockAttached)
+}
+
+// renderBalance displays the balance of the wallet and returns a function that reloads it.
+func renderBalance(overviewPage *tview.Flex) (reload func()) {
 	balanceTitleTextView := primitives.NewLeftAlignedTextView("Balance")
 	overviewPage.AddItem(balanceTitleTextView, 2, 0, false)
 
-	accounts, err := commonPageData.wallet.GetAccountsRaw(dcrlibwallet.DefaultRequiredConfirmations)
-	if err != nil {
-		errorTextView := primitives.NewCenterAlignedTextView(err.Error()).SetTextColor(helpers.DecredOrangeColor)
-		overviewPage.AddItem(errorTextView, 2, 0, false)
-		return
-	}
+	balanceTextView := primitives.NewLeftAlignedTextView("")
+	overviewPage.AddItem(balanceTextView, 2, 0, false)
 
-	var totalBalance, spendableBalance dcrutil.Amount
-	for _, account := range accounts.Acc {
-		totalBalance += dcrutil.Amount(account.Balance.Total)
-		spendableBalance += dcrutil.Amount(account.Balance.Total)
-	}
+	reload = func() {
+		accounts, err := commonPageData.wallet.GetAccountsRaw(dcrlibwallet.DefaultRequiredConfirmations)
+		if err != nil {
+			balanceTextView.SetText(err.Error()).SetTextAlign(tview.AlignCenter).SetTextColor(helpers.DecredOrangeColor)
+			return
+		}
 
-	var balance string
-	if totalBalance != spendableBalance {
-		balance = fmt.Sprintf("Total %s (Spendable %s)", totalBalance.String(), spendableBalance.String())
-	} else {
-		balance = totalBalance.String()
+		var totalBalance, spendableBalance dcrutil.Amount
+		for _, account := range accounts.Acc {
+			totalBalance += dcrutil.Amount(account.Balance.Total)
+			spendableBalance += dcrutil.Amount(account.Balance.Total)
+		}
+
+		var balance string
+		if totalBalance != spendableBalance {
+			balance = fmt.Sprintf("Total %s (Spendable %s)", totalBalance.String(), spendableBalance.String())
+		} else {
+			balance = totalBalance.String()
+		}
+
+		balanceTextView.SetText(balance).SetTextAlign(tview.AlignLeft).SetTextColor(tcell.ColorWhite)
 	}
 
-	balanceTextView := primitives.NewLeftAlignedTextView(balance)
-	overviewPage.AddItem(balanceTextView, 2, 0, false)
+	reload()
+	return reload
 }
 
-func renderRecentActivity(overviewPage *tview.Flex) {
+// renderRecentActivity displays the 5 most recent txs of the wallet and returns a function that reloads them.
+func renderRecentActivity(overviewPage *tview.Flex) (reload func()) {
 	overviewPage.AddItem(primitives.NewLeftAlignedTextView("-Recent Activity-").SetTextColor(helpers.DecredLightBlueColor), 1, 0, false)
 
+	// the status message or txs table are displayed in a separate flex so that
+	// they remain above the sync section when they are replaced on reload
+	recentActivity := tview.NewFlex().SetDirection(tview.FlexRow)
+	// recentActivity receives the key events of the page when it has the focus but no txs table to pass it to
+	recentActivity.SetInputCapture(handleOverviewKeys)
+	overviewPage.AddItem(recentActivity, 0, 1, true)
+
 	statusTextView := primitives.NewCenterAlignedTextView("")
+
+	historyTable := primitives.NewTable()
+	historyTable.SetBorders(false).SetFixed(1, 0)
+	historyTable.SetInputCapture(handleRescanKeys)
+	historyTable.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			commonPageData.clearAllPageContent()
+		}
+	})
+
+	var tableDisplayed bool
 	displayMessage := func(message string, error bool) {
-		overviewPage.RemoveItem(statusTextView)
+		recentActivity.RemoveItem(historyTable)
+		recentActivity.RemoveItem(statusTextView)
+		tableDisplayed = false
 		if message != "" {
 			if error {
 				statusTextView.SetTextColor(helpers.DecredOrangeColor)
@@ -83,28 +142,38 @@ func renderRecentActivity(overviewPage *tview.Flex) {
 			}
 
 			statusTextView.SetText(message)
-			overviewPage.AddItem(statusTextView, 2, 0, false)
+			recentActivity.AddItem(statusTextView, 2, 0, false)
 		}
 	}
 
-	displayMessage("Fetching data...", false)
-
-	txns, err := commonPageData.wallet.GetTransactionsRaw(0, 5, dcrlibwallet.TxFilterAll)
-	if err != nil {
-		// updating an element on the page from a goroutine, use tviewApp.QueueUpdateDraw
-		commonPageData.app.QueueUpdateDraw(func() {
+	reload = func() {
+		txns, err := commonPageData.wallet.GetTransactionsRaw(0, 5, dcrlibwallet.TxFilterAll)
+		if err != nil {
 			displayMessage(err.Error(), true)
-		})
-		return
-	}
+			return
+		}
 
-	if len(txns) == 0 {
-		displayMessage("No activity yet", false)
-		return
+		if len(txns) == 0 {
+			displayMessage("No activity yet", false)
+			return
+		}
+
+		displayRecentTxs(historyTable, txns)
+
+		if !tableDisplayed {
+			recentActivity.RemoveItem(statusTextView)
+			recentActivity.AddItem(historyTable, 0, 1, true)
+			tableDisplayed = true
+		}
 	}
 
-	historyTable := primitives.NewTable()
-	historyTable.SetBorders(false).SetFixed(1, 0)
+	displayMessage("Fetching data...", false)
+	reload()
+	return reload
+}
+
+func displayRecentTxs(historyTable *primitives.Table, txns []*dcrlibwallet.Transaction) {
+	historyTable.Clear()
 
 	// historyTable header
 	historyTable.SetHeaderCell(0, 0, "Date (UTC)")
@@ -163,16 +232,6 @@ func renderRecentActivity(overviewPage *tview.Flex) {
 			SetExpansion(1)
 		historyTable.SetCell(nextRowIndex, 4, typeCell)
 	}
-
-	historyTable.SetDoneFunc(func(key tcell.Key) {
-		if key == tcell.KeyEscape {
-			commonPageData.clearAllPageContent()
-		}
-	})
-
-	overviewPage.RemoveItem(statusTextView)
-	overviewPage.AddItem(historyTable, 0, 1, true)
-	commonPageData.app.SetFocus(historyTable)
 }
 
 func renderSyncStatus(overviewPage *tview.Flex) {
@@ -180,6 +239,7 @@ func renderSyncStatus(overviewPage *tview.Flex) {
 
 	if commonPageData.wallet.IsSynced() {
 		overviewPage.AddItem(primitives.NewCenterAlignedTextView("Wallet is synced."), 1, 0, false)
+		renderRescanStatus(overviewPage)
 		return
 	}
 
@@ -191,6 +251,7 @@ type syncProgressListener struct {
 	updateViews       []*primitives.TextView
 	peerCountTextView *primitives.TextView
 	errorTextView     *primitives.TextView
+	synced            bool
 }
 
 func (listener *syncProgressListener) start() {
@@ -245,7 +306,13 @@ func (listener *syncProgressListener) OnAddressDiscoveryProgress(addressDiscover
 	listener.updateUI(report)
 }
 
+// OnHeadersRescanProgress and OnSyncCompleted are also called by dcrlibwallet for rescans started after the wallet is synced,
+// those are reported by rescanStatus.
 func (listener *syncProgressListener) OnHeadersRescanProgress(headersRescanProgress *dcrlibwallet.HeadersRescanProgressReport) {
+	if listener.synced {
+		return
+	}
+
 	var report = []string{
 		fmt.Sprintf("%d%% completed, %s remaining.", headersRescanProgress.TotalSyncProgress,
 			dcrlibwallet.CalculateTotalTimeRemaining(headersRescanProgress.TotalTimeRemainingSeconds)),
@@ -259,6 +326,11 @@ func (listener *syncProgressListener) OnHeadersRescanProgress(headersRescanProgr
 }
 
 func (listener *syncProgressListener) OnSyncCompleted() {
+	if listener.synced {
+		return
+	}
+	listener.synced = true
+
 	// remove previous update views and error view
 	commonPageData.app.QueueUpdateDraw(func() {
 		for _, view := range listener.updateViews {
@@ -268,6 +340,7 @@ func (listener *syncProgressListener) OnSyncCompleted() {
 		listener.overviewPage.RemoveItem(listener.errorTextView)
 
 		listener.overviewPage.AddItem(primitives.NewCenterAlignedTextView("Wallet is synced."), 1, 0, false)
+		renderRescanStatus(listener.overviewPage)
 	})
 }
 
@@ -1,8 +1,14 @@
 package pages
 
 import (
+	"github.com/decred/dcrwallet/errors"
 	"github.com/decred/slog"
+	"github.com/gdamore/tcell"
 	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/addressbook"
+	"github.com/raedahgroup/godcr/app/seedbackup"
+	"github.com/raedahgroup/godcr/app/txlabels"
+	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/primitives"
 	"github.com/rivo/tview"
 )
@@ -17,18 +23,28 @@ var commonPageData struct {
 	app                 *tview.Application
 	log                 slog.Logger
 	wallet              *dcrlibwallet.LibWallet
+	addressBook         *addressbook.AddressBook
+	txLabels            *txlabels.Store
+	seedBackup          *seedbackup.Store
+	watchingOnly        bool
 	hintTextView        *primitives.TextView
 	clearAllPageContent func()
 }
 
-func Setup(app *tview.Application, log slog.Logger, dcrlw *dcrlibwallet.LibWallet,
-	hintTextView *primitives.TextView, clearAllPageContent func()) {
+func Setup(app *tview.Application, log slog.Logger, dcrlw *dcrlibwallet.LibWallet, addressBook *addressbook.AddressBook,
+	txLabels *txlabels.Store, seedBackup *seedbackup.Store, hintTextView *primitives.TextView, clearAllPageContent func()) {
 
 	commonPageData.app = app
 	commonPageData.log = log
 	commonPageData.wallet = dcrlw
+	commonPageData.addressBook = addressBook
+	commonPageData.txLabels = txLabels
+	commonPageData.seedBackup = seedBackup
+	commonPageData.watchingOnly = isWatchingOnlyWallet(dcrlw)
 	commonPageData.hintTextView = hintTextView
 	commonPageData.clearAllPageContent = clearAllPageContent
+
+	dcrlw.TransactionNotification(walletEventPublisher{})
 }
 
 func All() []*page {
@@ -44,3 +60,38 @@ func All() []*page {
 		{Name: "Exit", Shortcut: 'e', Content: ExitPage},
 	}
 }
+
+// isWatchingOnlyWallet checks if the opened wallet was created from an extended public key.
+// dcrwallet rejects attempts to change the private passphrase of a watching-only wallet before validating the passphrase.
+// Using the same value for the old and new passphrase ensures that a regular wallet is not modified.
+func isWatchingOnlyWallet(dcrlw *dcrlibwallet.LibWallet) bool {
+	err := dcrlw.ChangePrivatePassphrase([]byte("watching-only-probe"), []byte("watching-only-probe"))
+	return errors.Is(errors.WatchingOnly, err)
+}
+
+// seedBackupVerified returns true if the user has confirmed having a backup of the wallet's seed.
+// Watching-only wallets have no seed and are always considered verified.
+func seedBackupVerified() bool {
+	return commonPageData.watchingOnly || commonPageData.seedBackup.Verified()
+}
+
+// watchingOnlyPage is displayed instead of pages that require the wallet's private keys.
+func watchingOnlyPage(title, message string) tview.Primitive {
+	body := tview.NewFlex().SetDirection(tview.FlexRow)
+
+	body.AddItem(primitives.NewLeftAlignedTextView(title), 2, 1, false)
+
+	body.AddItem(primitives.NewLeftAlignedTextView(message).SetTextColor(helpers.DecredOrangeColor), 0, 1, false)
+
+	body.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			commonPageData.clearAllPageContent()
+			return nil
+		}
+
+		return event
+	})
+
+	commonPageData.app.SetFocus(body)
+	return body
+}
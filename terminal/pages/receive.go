@@ -5,6 +5,7 @@ import (
 
 	"github.com/gdamore/tcell"
 	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/paymenturi"
 	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/primitives"
 	"github.com/rivo/tview"
@@ -46,6 +47,9 @@ func receivePage() tview.Primitive {
 	addressTextView := primitives.NewCenterAlignedTextView("").
 		SetTextColor(helpers.DecredLightBlueColor)
 
+	// optional payment request details, encoded with the address in a payment uri when set
+	var paymentRequest paymenturi.PaymentRequest
+
 	generateAndDisplayAddress := func(accountNumber int32, newAddress bool) {
 		// clear previously generated address or displayed error before generating new one
 		body.RemoveItem(qrCodeTextView)
@@ -57,9 +61,9 @@ func receivePage() tview.Primitive {
 		var err error
 
 		if newAddress {
-			address, qr, err = generateNewAddressAndQrCode(accountNumber)
+			address, qr, err = generateNewAddressAndQrCode(accountNumber, paymentRequest)
 		} else {
-			address, qr, err = generateAddressAndQrCode(accountNumber)
+			address, qr, err = generateAddressAndQrCode(accountNumber, paymentRequest)
 		}
 
 		if err != nil {
@@ -82,6 +86,36 @@ func receivePage() tview.Primitive {
 		accountNumbers[index] = account.Number
 	}
 
+	var accountNumber int32 = accounts.Acc[0].Number
+
+	requestForm := primitives.NewForm(false)
+	requestForm.SetBorderPadding(0, 0, 0, 0)
+	requestForm.SetLabelColor(helpers.DecredLightBlueColor)
+	requestForm.SetCancelFunc(commonPageData.clearAllPageContent)
+
+	var requestAmount string
+	requestForm.AddInputField("Request Amount (optional):", "", 20, nil, func(text string) {
+		requestAmount = text
+	})
+	requestForm.AddInputField("Label (optional):", "", 30, nil, func(text string) {
+		paymentRequest.Label = text
+	})
+	requestForm.AddInputField("Message (optional):", "", 40, nil, func(text string) {
+		paymentRequest.Message = text
+	})
+	requestForm.AddButton("Show payment request", func() {
+		paymentRequest.Amount = 0
+		if requestAmount != "" {
+			amount, err := paymenturi.ParseAmount(requestAmount)
+			if err != nil {
+				displayErrorMessage(fmt.Sprintf("Error: %s", err.Error()))
+				return
+			}
+			paymentRequest.Amount = amount
+		}
+		generateAndDisplayAddress(accountNumber, false)
+	})
+
 	formButton := primitives.NewForm(false)
 	formButton.SetBorderPadding(0, 0, 0, 0)
 	formButton.SetCancelFunc(commonPageData.clearAllPageContent)
@@ -100,6 +134,7 @@ func receivePage() tview.Primitive {
 
 		generateAdressFunc(formButton)
 		body.AddItem(singleAccountTextView, 2, 1, true)
+		body.AddItem(requestForm, 8, 0, false)
 
 		singleAccountTextView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 			if event.Key() == tcell.KeyTab {
@@ -110,6 +145,15 @@ func receivePage() tview.Primitive {
 		})
 
 		formButton.GetButton(0).SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == tcell.KeyTab {
+				commonPageData.app.SetFocus(requestForm)
+				return nil
+			}
+
+			return event
+		})
+
+		requestForm.GetButton(0).SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 			if event.Key() == tcell.KeyTab {
 				commonPageData.app.SetFocus(singleAccountTextView)
 				return nil
@@ -132,7 +176,6 @@ func receivePage() tview.Primitive {
 		formDropdown.SetLabelColor(helpers.DecredLightBlueColor)
 		formDropdown.SetCancelFunc(commonPageData.clearAllPageContent)
 
-		var accountNumber int32
 		formDropdown.AddDropDown("Source Account: ", accountNames, 0, func(option string, optionIndex int) {
 			accountNumber = accountNumbers[optionIndex]
 			generateAndDisplayAddress(accountNumber, false)
@@ -145,6 +188,7 @@ func receivePage() tview.Primitive {
 		generateAdressFunc(formButton)
 
 		body.AddItem(formDropdown, 2, 0, true)
+		body.AddItem(requestForm, 8, 0, false)
 		commonPageData.hintTextView.SetText("TIP: Select Preferred Account and hit ENTER to generate Address, \n" +
 			"Move around with TAB, ESC to return to navigation menu")
 
@@ -158,6 +202,15 @@ func receivePage() tview.Primitive {
 		})
 
 		formButton.GetButton(0).SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == tcell.KeyTab {
+				commonPageData.app.SetFocus(requestForm)
+				return nil
+			}
+
+			return event
+		})
+
+		requestForm.GetButton(0).SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 			if event.Key() == tcell.KeyTab {
 				commonPageData.app.SetFocus(formDropdown)
 				return nil
@@ -168,19 +221,19 @@ func receivePage() tview.Primitive {
 	}
 
 	// always generate and display address for the first account, even if there are multiple accounts
-	generateAndDisplayAddress(accounts.Acc[0].Number, false)
+	generateAndDisplayAddress(accountNumber, false)
 
 	commonPageData.app.SetFocus(body)
 	return body
 }
 
-func generateAddressAndQrCode(accountNumber int32) (string, *qrcode.QRCode, error) {
+func generateAddressAndQrCode(accountNumber int32, paymentRequest paymenturi.PaymentRequest) (string, *qrcode.QRCode, error) {
 	generatedAddress, err := commonPageData.wallet.CurrentAddress(accountNumber)
 	if err != nil {
 		return "", nil, err
 	}
 
-	qrCode, err := generateQrcode(generatedAddress)
+	qrCode, err := generateQrcode(qrCodeContent(generatedAddress, paymentRequest))
 	if err != nil {
 		return "", nil, err
 	}
@@ -188,13 +241,13 @@ func generateAddressAndQrCode(accountNumber int32) (string, *qrcode.QRCode, erro
 	return generatedAddress, qrCode, nil
 }
 
-func generateNewAddressAndQrCode(accountNumber int32) (string, *qrcode.QRCode, error) {
+func generateNewAddressAndQrCode(accountNumber int32, paymentRequest paymenturi.PaymentRequest) (string, *qrcode.QRCode, error) {
 	generatedAddress, err := commonPageData.wallet.NextAddress(accountNumber)
 	if err != nil {
 		return "", nil, err
 	}
 
-	qrCode, err := generateQrcode(generatedAddress)
+	qrCode, err := generateQrcode(qrCodeContent(generatedAddress, paymentRequest))
 	if err != nil {
 		return "", nil, err
 	}
@@ -211,3 +264,12 @@ func generateQrcode(generatedAddress string) (*qrcode.QRCode, error) {
 
 	return qr, nil
 }
+
+// qrCodeContent returns the payment uri requesting the specified details if any is set, otherwise the bare address.
+func qrCodeContent(address string, paymentRequest paymenturi.PaymentRequest) string {
+	if !paymentRequest.HasDetails() {
+		return address
+	}
+	paymentRequest.Address = address
+	return paymentRequest.String()
+}
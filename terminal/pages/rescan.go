@@ -0,0 +1,127 @@
+package pages
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gdamore/tcell"
+	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/terminal/helpers"
+	"github.com/raedahgroup/godcr/terminal/primitives"
+	"github.com/rivo/tview"
+)
+
+// rescanStatus shows the progress of blockchain rescans in the sync status section of the overview page.
+// The dcrlibwallet version used by the terminal reports rescan progress to the sync progress listeners,
+// so one rescanStatus is registered as a sync progress listener for the app
+// and it updates the text view of the overview page that is currently displayed.
+// That dcrlibwallet version cannot cancel a rescan and does not report rescan errors,
+// a rescan that failed is only noticed when the next rescan is started.
+type rescanStatus struct {
+	mu         sync.Mutex
+	textView   *primitives.TextView
+	rescanning bool
+}
+
+var (
+	rescan               = &rescanStatus{}
+	registerRescanStatus sync.Once
+)
+
+func renderRescanStatus(overviewPage *tview.Flex) {
+	registerRescanStatus.Do(func() {
+		commonPageData.wallet.AddSyncProgressListener(rescan, "terminal-rescan")
+	})
+
+	textView := primitives.NewCenterAlignedTextView("")
+	overviewPage.AddItem(textView, 1, 0, false)
+
+	rescan.mu.Lock()
+	rescan.textView = textView
+	rescan.mu.Unlock()
+
+	if rescan.isRunning() {
+		textView.SetText("Rescanning blockchain.")
+	} else {
+		textView.SetText("Press R to rescan the blockchain.")
+	}
+}
+
+// handleRescanKeys starts a rescan when R is pressed.
+// It is used as input capture by the focusable primitives of the overview page.
+func handleRescanKeys(event *tcell.EventKey) *tcell.EventKey {
+	if !commonPageData.wallet.IsSynced() {
+		return event
+	}
+
+	switch event.Rune() {
+	case 'r', 'R':
+		// dcrlibwallet refuses to start a rescan while another one is running,
+		// this also restarts rescans that ended with an error without reporting it.
+		if err := commonPageData.wallet.RescanBlocks(); err != nil {
+			rescan.showStatus(fmt.Sprintf("Rescan failed to start: %s", err.Error()), true)
+			return nil
+		}
+
+		rescan.mu.Lock()
+		rescan.rescanning = true
+		rescan.mu.Unlock()
+		rescan.showStatus("Rescan started.", false)
+		return nil
+	}
+
+	return event
+}
+
+func (status *rescanStatus) isRunning() bool {
+	status.mu.Lock()
+	defer status.mu.Unlock()
+	return status.rescanning
+}
+
+func (status *rescanStatus) OnHeadersRescanProgress(progress *dcrlibwallet.HeadersRescanProgressReport) {
+	if !status.isRunning() {
+		return
+	}
+	status.showStatus(fmt.Sprintf("Rescanning %d%%, scanned %d of %d blocks.", progress.RescanProgress,
+		progress.CurrentRescanHeight, progress.TotalHeadersToScan), false)
+}
+
+// OnSyncCompleted is also called by dcrlibwallet when a rescan completes.
+func (status *rescanStatus) OnSyncCompleted() {
+	status.mu.Lock()
+	wasRescanning := status.rescanning
+	status.rescanning = false
+	status.mu.Unlock()
+
+	if wasRescanning {
+		status.showStatus("Rescan completed. Press R to rescan again.", false)
+	}
+}
+
+func (status *rescanStatus) OnPeerConnectedOrDisconnected(int32)                             {}
+func (status *rescanStatus) OnHeadersFetchProgress(*dcrlibwallet.HeadersFetchProgressReport) {}
+func (status *rescanStatus) OnAddressDiscoveryProgress(*dcrlibwallet.AddressDiscoveryProgressReport) {
+}
+func (status *rescanStatus) OnSyncCanceled(bool)           {}
+func (status *rescanStatus) OnSyncEndedWithError(error)    {}
+func (status *rescanStatus) Debug(*dcrlibwallet.DebugInfo) {}
+
+func (status *rescanStatus) showStatus(text string, isError bool) {
+	status.mu.Lock()
+	textView := status.textView
+	status.mu.Unlock()
+
+	if textView == nil {
+		return
+	}
+
+	commonPageData.app.QueueUpdateDraw(func() {
+		textView.SetText(text)
+		if isError {
+			textView.SetTextColor(helpers.DecredOrangeColor)
+		} else {
+			textView.SetTextColor(tcell.ColorWhite)
+		}
+	})
+}
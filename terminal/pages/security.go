@@ -1,6 +1,10 @@
 package pages
 
 import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
 	"github.com/gdamore/tcell"
 	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/primitives"
@@ -8,21 +12,184 @@ import (
 )
 
 func securityPage() tview.Primitive {
+	// parent flexbox layout container to hold other primitives
 	body := tview.NewFlex().SetDirection(tview.FlexRow)
 
-	body.AddItem(primitives.NewLeftAlignedTextView("Security"), 2, 1, false)
+	body.AddItem(primitives.NewLeftAlignedTextView("Security"), 2, 0, false)
+
+	messageTextView := primitives.WordWrappedTextView("")
 
-	body.AddItem(primitives.NewLeftAlignedTextView("Security page coming soon").SetTextColor(helpers.DecredGreenColor), 0, 1, false)
+	clearMessage := func() {
+		body.RemoveItem(messageTextView)
+	}
 
-	body.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyEscape {
-			commonPageData.clearAllPageContent()
-			return nil
+	displayMessage := func(message string, error bool) {
+		clearMessage()
+		messageTextView.SetText(message)
+		if error {
+			messageTextView.SetTextColor(helpers.DecredOrangeColor)
+		} else {
+			messageTextView.SetTextColor(helpers.DecredGreenColor)
 		}
+		body.AddItem(messageTextView, 3, 0, false)
+	}
 
-		return event
-	})
+	signVerifyMessagePages, signVerifyMessageForm := signVerifyMessageForm(displayMessage, clearMessage)
+
+	// watch-only wallets have no seed to back up
+	if !commonPageData.watchingOnly {
+		seedBackupForm := verifySeedBackupForm(displayMessage, clearMessage)
+		body.AddItem(tview.NewTextView().SetText("-Verify Seed Backup-").SetTextColor(helpers.DecredLightBlueColor), 2, 0, false)
+		body.AddItem(seedBackupForm, 5, 0, true)
+
+		// TAB from the last button of either form moves to the other form
+		seedBackupForm.GetButton(0).SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == tcell.KeyTab {
+				commonPageData.app.SetFocus(signVerifyMessageForm)
+				return nil
+			}
+			return event
+		})
+		signVerifyMessageForm.GetButton(signVerifyMessageForm.GetButtonCount() - 1).SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == tcell.KeyTab {
+				commonPageData.app.SetFocus(seedBackupForm)
+				return nil
+			}
+			return event
+		})
+	}
+
+	body.AddItem(tview.NewTextView().SetText("-Sign/Verify Message-").SetTextColor(helpers.DecredLightBlueColor), 2, 0, false)
+	body.AddItem(signVerifyMessagePages, 0, 1, commonPageData.watchingOnly)
 
 	commonPageData.app.SetFocus(body)
+
+	commonPageData.hintTextView.SetText("TIP: Move around with TAB and SHIFT+TAB. ESC to return to navigation menu")
+
 	return body
 }
+
+// verifySeedBackupForm returns a form for entering the wallet seed to confirm that the user has a backup of it.
+// The seed is checked against the record saved when the wallet was created by godcr.
+func verifySeedBackupForm(displayMessage func(message string, error bool), clearMessage func()) *primitives.Form {
+	form := primitives.NewForm(true)
+	form.SetBorderPadding(0, 0, 0, 0)
+
+	status := "Status: not verified"
+	if seedBackupVerified() {
+		status = "Status: verified"
+	}
+	statusTextView := primitives.NewLeftAlignedTextView(status)
+	form.AddFormItem(primitives.NewTextViewFormItem(statusTextView, 20, 1, false))
+
+	var seed string
+	form.AddInputField("Seed:", "", 90, nil, func(text string) {
+		seed = text
+	})
+
+	form.AddButton("Verify Seed", func() {
+		if strings.TrimSpace(seed) == "" {
+			displayMessage("Error: please enter your wallet seed", true)
+			return
+		}
+
+		// a wallet created without godcr has no seed record to check against
+		if !commonPageData.seedBackup.HasSeedHash() {
+			displayMessage("Error: this wallet was not created with godcr, "+
+				"verify its seed backup with godcr-cli verifyseed, godcr-web or godcr-nuklear", true)
+			return
+		}
+
+		if !commonPageData.seedBackup.MatchesSeed(seed) {
+			displayMessage("Error: the seed entered is not the seed of this wallet", true)
+			return
+		}
+
+		if err := commonPageData.seedBackup.SetVerified(); err != nil {
+			displayMessage(fmt.Sprintf("Error verifying seed backup: %s", err.Error()), true)
+			return
+		}
+
+		form.ClearFields()
+		statusTextView.SetText("Status: verified")
+		displayMessage("Seed backup verified", false)
+	})
+
+	form.SetCancelFunc(commonPageData.clearAllPageContent)
+
+	return form
+}
+
+// signVerifyMessageForm returns a form for signing a message with the private key of a wallet address,
+// or verifying that a message was signed with the private key of an address.
+// The signature field is only used when verifying a message.
+func signVerifyMessageForm(displayMessage func(message string, error bool), clearMessage func()) (*tview.Pages, *primitives.Form) {
+	pages := tview.NewPages()
+
+	form := primitives.NewForm(true)
+	form.SetBorderPadding(0, 0, 0, 0)
+	pages.AddPage("form", form, true, true)
+
+	var address, message, signature string
+	form.AddInputField("Address:", "", 40, nil, func(text string) {
+		address = text
+	})
+	form.AddInputField("Message:", "", 60, nil, func(text string) {
+		message = text
+	})
+	form.AddInputField("Signature:", "", 90, nil, func(text string) {
+		signature = text
+	})
+
+	// watch-only wallets have no private keys to sign messages with
+	if !commonPageData.watchingOnly {
+		form.AddButton("Sign", func() {
+			if address == "" || message == "" {
+				displayMessage("Error: please specify the address and message to sign", true)
+				return
+			}
+
+			helpers.RequestSpendingPassphrase(pages, func(passphrase string) {
+				commonPageData.app.SetFocus(form)
+
+				signatureBytes, err := commonPageData.wallet.SignMessage([]byte(passphrase), address, message)
+				if err != nil {
+					displayMessage(fmt.Sprintf("Error signing message: %s", err.Error()), true)
+					return
+				}
+
+				displayMessage(fmt.Sprintf("Signature: %s", base64.StdEncoding.EncodeToString(signatureBytes)), false)
+			}, func() {
+				commonPageData.app.SetFocus(form)
+			})
+		})
+	}
+
+	form.AddButton("Verify", func() {
+		if address == "" || message == "" || signature == "" {
+			displayMessage("Error: please specify the address, message and signature to verify", true)
+			return
+		}
+
+		valid, err := commonPageData.wallet.VerifyMessage(address, message, signature)
+		if err != nil {
+			displayMessage(fmt.Sprintf("Error verifying message: %s", err.Error()), true)
+			return
+		}
+
+		if valid {
+			displayMessage("Valid signature", false)
+		} else {
+			displayMessage("Invalid signature", true)
+		}
+	})
+
+	form.AddButton("Clear", func() {
+		form.ClearFields()
+		clearMessage()
+	})
+
+	form.SetCancelFunc(commonPageData.clearAllPageContent)
+
+	return pages, form
+}
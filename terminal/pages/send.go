@@ -11,6 +11,10 @@ import (
 )
 
 func sendPage() tview.Primitive {
+	if commonPageData.watchingOnly {
+		return watchingOnlyPage("Sending Decred", "Funds cannot be sent from a watch-only wallet")
+	}
+
 	pages := tview.NewPages()
 
 	body := tview.NewFlex().SetDirection(tview.FlexRow)
@@ -48,6 +52,21 @@ func sendPage() tview.Primitive {
 		destination = text
 	})
 
+	// selecting a contact fills the destination address field with the contact's address
+	contacts := commonPageData.addressBook.Contacts(commonPageData.wallet.NetType())
+	if len(contacts) > 0 {
+		destinationInputField := form.GetFormItem(form.GetFormItemsCount() - 1).(*tview.InputField)
+		contactNames := make([]string, len(contacts))
+		for i, contact := range contacts {
+			contactNames[i] = contact.Name
+		}
+		form.AddDropDown("Contact:", contactNames, -1, func(_ string, optionIndex int) {
+			if optionIndex >= 0 {
+				destinationInputField.SetText(contacts[optionIndex].Address)
+			}
+		})
+	}
+
 	var amount string
 	form.AddInputField("Amount:", "", 20, nil, func(text string) {
 		amount = text
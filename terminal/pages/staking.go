@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/gdamore/tcell"
 	"github.com/raedahgroup/dcrlibwallet"
 	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/primitives"
@@ -44,13 +46,37 @@ func stakingPage() tview.Primitive {
 		body.AddItem(stakeInfo, 3, 0, false)
 	}
 
-	body.AddItem(tview.NewTextView().SetText("-Purchase Ticket-").SetTextColor(helpers.DecredLightBlueColor), 2, 0, false)
-	purchaseTicket, err := purchaseTicketForm(displayMessage, clearMessage)
+	body.AddItem(tview.NewTextView().SetText("-Tickets-").SetTextColor(helpers.DecredLightBlueColor), 2, 0, false)
+	tickets, err := ticketsTable()
 	if err != nil {
-		errorText := fmt.Sprintf("Error setting up purchase form: %s", err.Error())
-		displayMessage(errorText, true)
+		body.AddItem(primitives.NewLeftAlignedTextView(fmt.Sprintf("Error fetching tickets: %s", err.Error())).
+			SetTextColor(helpers.DecredOrangeColor), 2, 0, false)
+	} else if tickets.GetRowCount() == 1 {
+		body.AddItem(primitives.NewLeftAlignedTextView("No tickets found"), 2, 0, false)
+	} else {
+		body.AddItem(tickets, maxDisplayedTickets+1, 0, false)
+	}
+
+	body.AddItem(tview.NewTextView().SetText("-Purchase Ticket-").SetTextColor(helpers.DecredLightBlueColor), 2, 0, false)
+	if commonPageData.watchingOnly {
+		// there's no purchase form to return to the navigation menu from, so listen for ESC on the page
+		body.AddItem(primitives.NewLeftAlignedTextView("Tickets cannot be purchased with a watch-only wallet").
+			SetTextColor(helpers.DecredOrangeColor), 2, 0, false)
+		body.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == tcell.KeyEscape {
+				commonPageData.clearAllPageContent()
+				return nil
+			}
+			return event
+		})
 	} else {
-		body.AddItem(purchaseTicket, 0, 1, true)
+		purchaseTicket, err := purchaseTicketForm(displayMessage, clearMessage)
+		if err != nil {
+			errorText := fmt.Sprintf("Error setting up purchase form: %s", err.Error())
+			displayMessage(errorText, true)
+		} else {
+			body.AddItem(purchaseTicket, 0, 1, true)
+		}
 	}
 
 	commonPageData.app.SetFocus(body)
@@ -69,9 +95,98 @@ func stakeInfoFlex() (*primitives.TextView, error) {
 	}
 
 	stakingReport := fmt.Sprintf("Mempool: %d  Immature: %d  Live: %d", stakeInfo.OwnMempoolTix, stakeInfo.Immature, stakeInfo.Live)
+	// dcrlibwallet cannot create revocations, point the user to the cli which can revoke tickets over dcrwallet rpc
+	if stakeInfo.Missed > 0 || stakeInfo.Expired > 0 {
+		stakingReport += fmt.Sprintf("\n%d missed and %d expired ticket(s) need to be revoked. "+
+			"Use the cli `revoketickets` command with a dcrwallet rpc connection to revoke them.", stakeInfo.Missed, stakeInfo.Expired)
+	}
 	return primitives.NewLeftAlignedTextView(stakingReport), nil
 }
 
+// maxDisplayedTickets is the number of ticket rows shown on the staking page, most recent tickets first.
+const maxDisplayedTickets = 8
+
+// ticketsTable lists the wallet's tickets with their statuses, prices and the vote or revocation that spent them.
+func ticketsTable() (*tview.Table, error) {
+	table := tview.NewTable().SetBorders(false).SetFixed(1, 0)
+
+	headerCell := func(text string) *tview.TableCell {
+		return tview.NewTableCell(text).SetAlign(tview.AlignLeft).SetSelectable(false).SetExpansion(1)
+	}
+	table.SetCell(0, 0, headerCell("Hash"))
+	table.SetCell(0, 1, headerCell("Height"))
+	table.SetCell(0, 2, headerCell("Price"))
+	table.SetCell(0, 3, headerCell("Status"))
+	table.SetCell(0, 4, headerCell("Spender"))
+	table.SetCell(0, 5, headerCell("Reward"))
+
+	// unmined tickets are not associated with any block and cannot be read by dcrlibwallet,
+	// so only read tickets mined up to the current best block.
+	bestBlock := commonPageData.wallet.GetBestBlock()
+	if bestBlock <= 0 {
+		return table, nil
+	}
+
+	ticketInfos, err := readTickets(bestBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(ticketInfos))
+	for _, ticketInfo := range ticketInfos {
+		var price, reward dcrutil.Amount
+		for _, output := range ticketInfo.Ticket.MyOutputs {
+			if output.Index == 0 {
+				price = output.Amount
+			}
+		}
+
+		var spenderHash, rewardText string
+		if spender := ticketInfo.Spender; spender != nil {
+			spenderHash = spender.Hash.String()[:10] + "..."
+			for _, output := range spender.MyOutputs {
+				reward += output.Amount
+			}
+			for _, input := range spender.MyInputs {
+				reward -= input.PreviousAmount
+			}
+			rewardText = reward.String()
+		}
+
+		rows = append(rows, []string{
+			ticketInfo.Ticket.Hash.String()[:10] + "...",
+			strconv.Itoa(int(ticketInfo.BlockHeight)),
+			price.String(),
+			ticketInfo.Status,
+			spenderHash,
+			rewardText,
+		})
+	}
+
+	// tickets are read oldest first, display the most recent tickets
+	for i := len(rows) - 1; i >= 0 && len(rows)-i <= maxDisplayedTickets; i-- {
+		row := table.GetRowCount()
+		for column, text := range rows[i] {
+			table.SetCell(row, column, tview.NewTableCell(text).SetAlign(tview.AlignLeft).SetExpansion(1))
+		}
+	}
+
+	return table, nil
+}
+
+// readTickets returns the wallet's tickets mined up to `endHeight`, oldest first.
+// dcrlibwallet dereferences the spender of every ticket it reads, which is nil for tickets that are not yet spent.
+// That panic is returned as an error instead of crashing the terminal, the wallet db transaction is rolled back by dcrwallet.
+func readTickets(endHeight int32) (ticketInfos []*dcrlibwallet.TicketInfo, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("dcrlibwallet cannot read unspent tickets: %v", r)
+		}
+	}()
+
+	return commonPageData.wallet.GetTicketsForBlockHeightRange(0, endHeight, 0)
+}
+
 func purchaseTicketForm(displayMessage func(message string, error bool), clearMessage func()) (*tview.Pages, error) {
 	pages := tview.NewPages()
 
@@ -3,10 +3,14 @@ package terminal
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/decred/slog"
 	"github.com/gdamore/tcell"
 	"github.com/raedahgroup/dcrlibwallet"
+	"github.com/raedahgroup/godcr/app/addressbook"
+	"github.com/raedahgroup/godcr/app/seedbackup"
+	"github.com/raedahgroup/godcr/app/txlabels"
 	"github.com/raedahgroup/godcr/terminal/helpers"
 	"github.com/raedahgroup/godcr/terminal/pages"
 	"github.com/raedahgroup/godcr/terminal/primitives"
@@ -22,6 +26,9 @@ type terminalUI struct {
 	hintTextView      *primitives.TextView
 	log               slog.Logger
 	dcrlw             *dcrlibwallet.LibWallet
+	addressBook       *addressbook.AddressBook
+	txLabels          *txlabels.Store
+	seedBackup        *seedbackup.Store
 }
 
 func LaunchUserInterface(appDisplayName, appDataDir, netType string) {
@@ -36,6 +43,25 @@ func LaunchUserInterface(appDisplayName, appDataDir, netType string) {
 		log: logger,
 	}
 
+	tui.addressBook, err = addressbook.Load(appDataDir)
+	if err != nil {
+		tui.log.Errorf("Error loading address book: %v", err)
+		return
+	}
+
+	// labels are saved in the wallet directory, where other interfaces using dcrlibwallet also look for them
+	tui.txLabels, err = txlabels.Load(filepath.Join(appDataDir, netType, txlabels.FileName))
+	if err != nil {
+		tui.log.Errorf("Error loading transaction labels: %v", err)
+		return
+	}
+
+	tui.seedBackup, err = seedbackup.Load(filepath.Join(appDataDir, netType, seedbackup.FileName))
+	if err != nil {
+		tui.log.Errorf("Error loading seed backup status: %v", err)
+		return
+	}
+
 	tui.dcrlw, err = dcrlibwallet.NewLibWallet(appDataDir, "", netType)
 	if err != nil {
 		tui.log.Errorf("Initialization error: %v", err)
@@ -75,7 +101,7 @@ func LaunchUserInterface(appDisplayName, appDataDir, netType string) {
 	tui.app.SetRoot(tui.rootGridLayout, true)
 
 	// app is ready, pass necessary variables to pages pkg and display first page
-	pages.Setup(tui.app, tui.log, tui.dcrlw, tui.hintTextView, tui.clearPageContent)
+	pages.Setup(tui.app, tui.log, tui.dcrlw, tui.addressBook, tui.txLabels, tui.seedBackup, tui.hintTextView, tui.clearPageContent)
 	firstPageContent := pages.All()[0].Content()
 	tui.removeNavMenuFocus()
 	tui.setPageContent(firstPageContent)
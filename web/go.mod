@@ -11,11 +11,22 @@ require (
 	github.com/gorilla/websocket v1.2.0
 	github.com/raedahgroup/dcrlibwallet v1.0.1-0.20190807181808-37b6666fe764
 	github.com/raedahgroup/godcr/app v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/addressbook v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/events v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/paymenturi v0.0.0-00010101000000-000000000000
+	github.com/raedahgroup/godcr/app/txfilter v0.0.0-00010101000000-000000000000
 	github.com/raedahgroup/godcr/cli v0.0.0-00010101000000-000000000000
 	github.com/skip2/go-qrcode v0.0.0-20190110000554-dc11ecdae0a9
 )
 
 replace (
 	github.com/raedahgroup/godcr/app => ../app
+	github.com/raedahgroup/godcr/app/addressbook => ../app/addressbook
+	github.com/raedahgroup/godcr/app/events => ../app/events
+	github.com/raedahgroup/godcr/app/jsonfile => ../app/jsonfile
+	github.com/raedahgroup/godcr/app/paymenturi => ../app/paymenturi
+	github.com/raedahgroup/godcr/app/seedbackup => ../app/seedbackup
+	github.com/raedahgroup/godcr/app/txfilter => ../app/txfilter
+	github.com/raedahgroup/godcr/app/txlabels => ../app/txlabels
 	github.com/raedahgroup/godcr/cli => ../cli
 )
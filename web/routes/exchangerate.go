@@ -0,0 +1,69 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/raedahgroup/godcr/app/conversion"
+	"github.com/raedahgroup/godcr/web/weblog"
+)
+
+// exchangeRateTimeout limits how long a page waits for the exchange rate before showing it as unavailable.
+const exchangeRateTimeout = 5 * time.Second
+
+// fiatCurrency returns the fiat currency set in settings or the default currency if none is set.
+func (routes *Routes) fiatCurrency() string {
+	if currency := conversion.NormalizeCurrency(routes.settings.FiatCurrency); currency != "" {
+		return currency
+	}
+	return conversion.DefaultFiatCurrency
+}
+
+// exchangeRateSource returns the cached exchange rate source set in settings, or nil if currency conversion is disabled.
+// The source is created on first use and re-created when the source settings change, so rates are cached across page loads.
+func (routes *Routes) exchangeRateSource() (conversion.RateSource, error) {
+	routes.rateSourceMu.Lock()
+	defer routes.rateSourceMu.Unlock()
+
+	sourceConfig := routes.settings.CurrencyConverter + "|" + routes.settings.ExchangeRatesFile
+	if routes.rateSourceConfig == sourceConfig {
+		return routes.rateSource, nil
+	}
+
+	rateSource, err := conversion.NewRateSource(routes.settings.CurrencyConverter, routes.settings.ExchangeRatesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	routes.rateSource = rateSource
+	routes.rateSourceConfig = sourceConfig
+	return rateSource, nil
+}
+
+// addExchangeRateData sets the fiat currency and the value of 1 DCR in that currency in the page data,
+// the rate is N/A if it cannot be fetched. Nothing is set if currency conversion is disabled.
+func (routes *Routes) addExchangeRateData(data map[string]interface{}) (exchangeRate float64, ok bool) {
+	rateSource, err := routes.exchangeRateSource()
+	if err != nil {
+		weblog.LogError(fmt.Errorf("error setting up exchange rate source: %s", err.Error()))
+	}
+	if rateSource == nil {
+		return 0, false
+	}
+
+	data["fiatCurrency"] = routes.fiatCurrency()
+
+	ctx, cancel := context.WithTimeout(routes.ctx, exchangeRateTimeout)
+	defer cancel()
+
+	exchangeRate, err = rateSource.DcrRate(ctx, routes.fiatCurrency())
+	if err != nil {
+		weblog.LogError(fmt.Errorf("error fetching exchange rate from %s: %s", rateSource.Name(), err.Error()))
+		data["exchangeRate"] = "N/A"
+		return 0, false
+	}
+
+	data["exchangeRate"] = fmt.Sprintf("%.8f", exchangeRate)
+	return exchangeRate, true
+}
@@ -1,19 +1,24 @@
 package routes
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"math"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/go-chi/chi"
 	"github.com/raedahgroup/dcrlibwallet"
-	"github.com/raedahgroup/dcrlibwallet/txhelper"
 	"github.com/raedahgroup/dcrlibwallet/txindex"
 	"github.com/raedahgroup/godcr/app/config"
-	"github.com/raedahgroup/godcr/app/conversion/bitrex"
+	"github.com/raedahgroup/godcr/app/conversion"
+	"github.com/raedahgroup/godcr/app/paymenturi"
+	"github.com/raedahgroup/godcr/app/taxreport"
+	"github.com/raedahgroup/godcr/app/txfilter"
 	"github.com/raedahgroup/godcr/app/utils"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/web/weblog"
@@ -60,7 +65,8 @@ func (routes *Routes) overviewPage(res http.ResponseWriter, req *http.Request) {
 	req.ParseForm()
 
 	data := map[string]interface{}{
-		"accounts": accounts,
+		"accounts":           accounts,
+		"seedBackupVerified": routes.walletMiddleware.SeedBackupVerified(),
 	}
 
 	txns, err := routes.walletMiddleware.TransactionHistory(0, 5, nil)
@@ -70,10 +76,23 @@ func (routes *Routes) overviewPage(res http.ResponseWriter, req *http.Request) {
 
 	data["transactions"] = txns
 
+	if exchangeRate, ok := routes.addExchangeRateData(data); ok {
+		var totalBalance float64
+		for _, account := range accounts {
+			totalBalance += account.Balance.Total.ToCoin()
+		}
+		data["fiatBalance"] = fmt.Sprintf("%.2f", totalBalance*exchangeRate)
+	}
+
 	routes.renderPage("overview.html", data, res)
 }
 
 func (routes *Routes) sendPage(res http.ResponseWriter, req *http.Request) {
+	if routes.walletMiddleware.IsWatchingOnlyWallet() {
+		routes.renderError("Funds cannot be sent from a watch-only wallet", res)
+		return
+	}
+
 	accounts, err := routes.walletMiddleware.AccountsOverview(walletcore.DefaultRequiredConfirmations)
 	if err != nil {
 		routes.renderError(fmt.Sprintf("Error fetching accounts: %s", err.Error()), res)
@@ -83,17 +102,13 @@ func (routes *Routes) sendPage(res http.ResponseWriter, req *http.Request) {
 	data := map[string]interface{}{
 		"accounts":              accounts,
 		"spendUnconfirmedFunds": routes.settings.SpendUnconfirmed,
+		"feeRateOptions":        walletcore.FeeRateOptions,
+		"coinSelection":         routes.settings.CoinSelection,
+		"coinSelectionOptions":  walletcore.CoinSelectionStrategies,
+		"contacts":              routes.addressBook.Contacts(routes.walletMiddleware.NetType()),
 	}
 
-	if routes.settings.CurrencyConverter == "bitrex" {
-		exchangeRate, err := bitrex.DcrToUsd(1)
-		if err != nil {
-			weblog.LogError(fmt.Errorf("error fetching exchange rate: %s", err.Error()))
-			data["exchangeRate"] = "N/A"
-		} else {
-			data["exchangeRate"] = fmt.Sprintf("%.8f", exchangeRate)
-		}
-	}
+	routes.addExchangeRateData(data)
 
 	routes.renderPage("send.html", data, res)
 }
@@ -121,7 +136,8 @@ func (routes *Routes) maxSendAmount(res http.ResponseWriter, req *http.Request)
 		}
 	}
 
-	changeAmount, err := txhelper.EstimateMaxSendAmount(len(payload.utxos), int64(payload.totalInputAmount), payload.sendDestinations)
+	changeAmount, err := walletcore.EstimateMaxSendAmount(len(payload.utxos), int64(payload.totalInputAmount), payload.sendDestinations,
+		payload.feeRate)
 	if err != nil {
 		data["error"] = fmt.Sprintf("Error in estimating max send amount: %s", err.Error())
 	} else {
@@ -138,12 +154,30 @@ func (routes *Routes) validateAddress(res http.ResponseWriter, req *http.Request
 		data["error"] = fmt.Errorf("error in parsing request: %s", err.Error())
 	}
 
-	address := req.FormValue("address")
+	address := strings.TrimSpace(req.FormValue("address"))
 	if address == "" {
 		data["error"] = "Address cannot be empty"
 		return
 	}
 
+	// a pasted payment uri is returned with its details so the send form can be filled in
+	if paymenturi.IsPaymentURI(address) {
+		paymentRequest, err := walletcore.ParsePaymentURI(routes.walletMiddleware, address)
+		if err != nil {
+			data["error"] = err.Error()
+			return
+		}
+
+		data["valid"] = true
+		data["paymentRequest"] = map[string]interface{}{
+			"address": paymentRequest.Address,
+			"amount":  dcrutil.Amount(paymentRequest.Amount).ToCoin(),
+			"label":   paymentRequest.Label,
+			"message": paymentRequest.Message,
+		}
+		return
+	}
+
 	valid, err := routes.walletMiddleware.ValidateAddress(address)
 	if err != nil {
 		data["error"] = fmt.Sprintf("Cannot validate address: %s", err.Error())
@@ -177,7 +211,7 @@ func (routes *Routes) getFeeAndSize(res http.ResponseWriter, req *http.Request)
 		}
 	}
 
-	fee, err := utils.EstimateFee(len(payload.utxos), payload.sendDestinations)
+	fee, err := utils.EstimateFee(len(payload.utxos), payload.sendDestinations, payload.feeRate)
 	if err != nil {
 		data["error"] = fmt.Sprintf("Cannot get summary, trying to get estimated fee failed: %s", err.Error())
 		return
@@ -206,10 +240,14 @@ func (routes *Routes) submitSendTxForm(res http.ResponseWriter, req *http.Reques
 	var txHash string
 	if payload.useCustom {
 		txHash, err = routes.walletMiddleware.SendFromUTXOs(payload.sourceAccount, payload.requiredConfirmations, payload.utxos,
-			payload.sendDestinations, payload.changeDestinations, payload.passphrase)
+			payload.sendDestinations, payload.changeDestinations, payload.feeRate, payload.passphrase)
 	} else {
+		coinSelection := payload.coinSelection
+		if coinSelection == "" {
+			coinSelection = routes.settings.CoinSelection
+		}
 		txHash, err = routes.walletMiddleware.SendFromAccount(payload.sourceAccount, payload.requiredConfirmations,
-			payload.sendDestinations, payload.passphrase)
+			payload.sendDestinations, payload.feeRate, coinSelection, payload.passphrase)
 	}
 
 	if err != nil {
@@ -218,8 +256,6 @@ func (routes *Routes) submitSendTxForm(res http.ResponseWriter, req *http.Reques
 	}
 
 	data["txHash"] = txHash
-
-	routes.sendWsBalance()
 }
 
 func (routes *Routes) receivePage(res http.ResponseWriter, req *http.Request) {
@@ -234,7 +270,7 @@ func (routes *Routes) receivePage(res http.ResponseWriter, req *http.Request) {
 	}
 
 	// don't generate new address by default, return previous unused address if it exists
-	data = routes.generateAddress(data, accounts[0].Number, false)
+	data = routes.generateAddress(data, accounts[0].Number, false, paymenturi.PaymentRequest{})
 	routes.renderPage("receive.html", data, res)
 }
 
@@ -250,11 +286,26 @@ func (routes *Routes) generateReceiveAddress(res http.ResponseWriter, req *http.
 		return
 	}
 
+	// the qr code encodes a payment uri if an amount, label or message is requested
+	paymentRequest := paymenturi.PaymentRequest{
+		Label:   strings.TrimSpace(req.URL.Query().Get("label")),
+		Message: strings.TrimSpace(req.URL.Query().Get("message")),
+	}
+	paymentRequest.Amount, err = paymenturi.ParseAmount(req.URL.Query().Get("amount"))
+	if err != nil {
+		data["success"] = false
+		data["errorMessage"] = err.Error()
+		return
+	}
+
 	generateNewAddress := req.URL.Query().Get("new") == "yes"
-	data = routes.generateAddress(data, uint32(accountNumber), generateNewAddress)
+	data = routes.generateAddress(data, uint32(accountNumber), generateNewAddress, paymentRequest)
 }
 
-func (routes *Routes) generateAddress(data map[string]interface{}, accountNumber uint32, generateNewAddress bool) map[string]interface{} {
+// generateAddress sets the receive address of the account and its qr code in `data`.
+// If `paymentRequest` has an amount, label or message, the qr code encodes a payment uri for the address instead.
+func (routes *Routes) generateAddress(data map[string]interface{}, accountNumber uint32, generateNewAddress bool,
+	paymentRequest paymenturi.PaymentRequest) map[string]interface{} {
 	var address string
 	var err error
 	if generateNewAddress {
@@ -269,7 +320,14 @@ func (routes *Routes) generateAddress(data map[string]interface{}, accountNumber
 		return data
 	}
 
-	png, err := qrcode.Encode(address, qrcode.Medium, 256)
+	qrContent := address
+	if paymentRequest.HasDetails() {
+		paymentRequest.Address = address
+		qrContent = paymentRequest.String()
+		data["paymentURI"] = qrContent
+	}
+
+	png, err := qrcode.Encode(qrContent, qrcode.Medium, 256)
 	if err != nil {
 		data["success"] = false
 		data["errorMessage"] = err.Error()
@@ -313,6 +371,73 @@ func (routes *Routes) getUnspentOutputs(res http.ResponseWriter, req *http.Reque
 	data["message"] = utxos
 }
 
+func (routes *Routes) lockUnspent(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	outputKey := req.FormValue("output-key")
+	if outputKey == "" {
+		data["success"] = false
+		data["message"] = "Output key is required"
+		return
+	}
+
+	lock, err := strconv.ParseBool(req.FormValue("lock"))
+	if err != nil {
+		data["success"] = false
+		data["message"] = "Invalid value for lock"
+		return
+	}
+
+	err = routes.walletMiddleware.LockUnspent([]string{outputKey}, lock)
+	if err != nil {
+		data["success"] = false
+		data["message"] = err.Error()
+		return
+	}
+
+	data["success"] = true
+}
+
+func (routes *Routes) selectCoins(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	payload, err := retrieveSendPagePayload(req, routes.walletMiddleware.GenerateNewAddress)
+	if err != nil {
+		data["success"] = false
+		data["message"] = err.Error()
+		return
+	}
+
+	coinSelection := payload.coinSelection
+	if coinSelection == "" {
+		coinSelection = routes.settings.CoinSelection
+	}
+
+	utxos, err := routes.walletMiddleware.UnspentOutputs(payload.sourceAccount, 0, payload.requiredConfirmations)
+	if err != nil {
+		data["success"] = false
+		data["message"] = err.Error()
+		return
+	}
+
+	selectedUtxos, _, err := walletcore.SelectCoins(coinSelection, utxos, payload.sendDestinations, payload.feeRate)
+	if err != nil {
+		data["success"] = false
+		data["message"] = err.Error()
+		return
+	}
+
+	selectedUtxoKeys := make([]string, len(selectedUtxos))
+	for i, utxo := range selectedUtxos {
+		selectedUtxoKeys[i] = utxo.OutputKey
+	}
+
+	data["success"] = true
+	data["message"] = selectedUtxoKeys
+}
+
 func (routes *Routes) getRandomChangeOutputs(res http.ResponseWriter, req *http.Request) {
 	data := map[string]interface{}{}
 	defer renderJSON(data, res)
@@ -337,7 +462,8 @@ func (routes *Routes) getRandomChangeOutputs(res http.ResponseWriter, req *http.
 	}
 
 	changeOutputDestinations, err := walletcore.GetChangeDestinationsWithRandomAmounts(routes.walletMiddleware,
-		int(nChangeOutputs), int64(payload.totalInputAmount), payload.sourceAccount, len(payload.utxos), payload.sendDestinations)
+		int(nChangeOutputs), int64(payload.totalInputAmount), payload.sourceAccount, len(payload.utxos), payload.sendDestinations,
+		payload.feeRate)
 	if err != nil {
 		data["error"] = err.Error()
 		return
@@ -378,9 +504,17 @@ func (routes *Routes) historyPage(res http.ResponseWriter, req *http.Request) {
 		pageToLoad = 1
 	}
 
+	// filters are read from the url so that filtered views can be bookmarked
+	selectedFilter := req.FormValue("filter")
+	criteria, err := historyFilterCriteria(req)
+	if err != nil {
+		routes.renderError(fmt.Sprintf("Cannot load history page: %s", err.Error()), res)
+		return
+	}
+
 	var txPerPage int32 = walletcore.TransactionHistoryCountPerPage
 	offset := (int32(pageToLoad) - 1) * txPerPage
-	txns, err := routes.walletMiddleware.TransactionHistory(offset, txPerPage, nil)
+	txns, filteredTxCount, err := routes.filteredTransactionHistory(offset, txPerPage, selectedFilter, criteria)
 	if err != nil {
 		routes.renderError(fmt.Sprintf("Error fetching history: %s", err.Error()), res)
 		return
@@ -391,12 +525,17 @@ func (routes *Routes) historyPage(res http.ResponseWriter, req *http.Request) {
 		"txs":                      txns,
 		"currentPage":              int(pageToLoad),
 		"previousPage":             int(pageToLoad - 1),
-		"totalPages":               int(math.Ceil(float64(allTxCount) / float64(txPerPage))),
+		"totalPages":               int(math.Ceil(float64(filteredTxCount) / float64(txPerPage))),
 		"transactionTotalCount":    allTxCount,
+		"filteredTxCount":          filteredTxCount,
+		"selectedFilter":           selectedFilter,
+		"criteria":                 historyFilterFormValues(req),
+		"txFilters":                filters,
+		"exportFormats":            walletcore.HistoryExportFormats,
 	}
 
 	totalTxLoaded := int(offset) + len(txns)
-	if totalTxLoaded < allTxCount {
+	if totalTxLoaded < filteredTxCount {
 		data["nextPage"] = int(pageToLoad + 1)
 	}
 
@@ -407,16 +546,10 @@ func (routes *Routes) getNextHistoryPage(res http.ResponseWriter, req *http.Requ
 	data := map[string]interface{}{}
 	defer renderJSON(data, res)
 
-	filter := txindex.Filter()
-	if selectedFilter := req.FormValue("filter"); selectedFilter != "" {
-		filter = walletcore.BuildTransactionFilter(selectedFilter)
-	}
-
-	allTxCount, allTxCountErr := routes.walletMiddleware.TransactionCount(filter)
-	if allTxCountErr != nil {
+	criteria, err := historyFilterCriteria(req)
+	if err != nil {
 		data["success"] = false
-		data["message"] = fmt.Sprintf("Cannot load history page. Error getting total transaction count: %s",
-			allTxCountErr.Error())
+		data["message"] = err.Error()
 		return
 	}
 
@@ -433,7 +566,7 @@ func (routes *Routes) getNextHistoryPage(res http.ResponseWriter, req *http.Requ
 	var txPerPage int32 = walletcore.TransactionHistoryCountPerPage
 	offset := (int32(pageToLoad) - 1) * txPerPage
 
-	txns, err := routes.walletMiddleware.TransactionHistory(offset, txPerPage, filter)
+	txns, allTxCount, err := routes.filteredTransactionHistory(offset, txPerPage, req.FormValue("filter"), criteria)
 	if err != nil {
 		data["success"] = false
 		data["message"] = err.Error()
@@ -452,6 +585,117 @@ func (routes *Routes) getNextHistoryPage(res http.ResponseWriter, req *http.Requ
 	}
 }
 
+// filteredTransactionHistory returns `count` txs from `offset` that match the tx filter named `filterName` and `criteria`
+// and the total number of matching txs. If no criteria is set, txs are read directly from the tx index.
+func (routes *Routes) filteredTransactionHistory(offset, count int32, filterName string, criteria *txfilter.Criteria) ([]*walletcore.Transaction, int, error) {
+	if criteria.IsEmpty() {
+		filter := txindex.Filter()
+		if filterName != "" {
+			filter = walletcore.BuildTransactionFilter(filterName)
+		}
+
+		txCount, err := routes.walletMiddleware.TransactionCount(filter)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error getting total transaction count: %s", err.Error())
+		}
+		txns, err := routes.walletMiddleware.TransactionHistory(offset, count, filter)
+		return txns, txCount, err
+	}
+
+	txns, err := walletcore.FilterTransactionHistory(routes.walletMiddleware, filterName, criteria)
+	if err != nil {
+		return nil, 0, err
+	}
+	txCount := len(txns)
+	if int(offset) >= txCount {
+		return nil, txCount, nil
+	}
+	end := int(offset + count)
+	if end > txCount {
+		end = txCount
+	}
+	return txns[offset:end], txCount, nil
+}
+
+// historyFilterCriteria reads the txfilter criteria set in the query parameters of `req`.
+func historyFilterCriteria(req *http.Request) (*txfilter.Criteria, error) {
+	return txfilter.ParseCriteria(txfilter.CriteriaInput{
+		From:       req.FormValue("from"),
+		To:         req.FormValue("to"),
+		MinAmount:  req.FormValue("min_amount"),
+		MaxAmount:  req.FormValue("max_amount"),
+		Address:    req.FormValue("address"),
+		Account:    req.FormValue("account"),
+		HashPrefix: req.FormValue("hash"),
+	})
+}
+
+// historyFilterFormValues returns the history filter values in the query parameters of `req`, to fill the filter form.
+func historyFilterFormValues(req *http.Request) map[string]string {
+	formValues := make(map[string]string)
+	for _, fieldName := range []string{"from", "to", "min_amount", "max_amount", "address", "account", "hash"} {
+		formValues[fieldName] = req.FormValue(fieldName)
+	}
+	return formValues
+}
+
+func (routes *Routes) searchHistory(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	query := strings.TrimSpace(req.FormValue("query"))
+	if query == "" {
+		data["success"] = false
+		data["message"] = "Search text is required"
+		return
+	}
+
+	txns, err := routes.walletMiddleware.SearchTransactions(query)
+	if err != nil {
+		data["success"] = false
+		data["message"] = err.Error()
+		return
+	}
+
+	data["success"] = true
+	data["txs"] = txns
+	data["transactionTotalCount"] = len(txns)
+}
+
+func (routes *Routes) exportHistory(res http.ResponseWriter, req *http.Request) {
+	req.ParseForm()
+	format := req.FormValue("format")
+	if format == "" {
+		format = walletcore.HistoryExportFormatCSV
+	}
+
+	criteria, err := historyFilterCriteria(req)
+	if err != nil {
+		routes.renderError(fmt.Sprintf("Cannot export history: %s", err.Error()), res)
+		return
+	}
+
+	// write the export to a buffer first so that an error page can be shown if the export fails
+	var export bytes.Buffer
+	_, err = walletcore.ExportTransactionHistory(routes.walletMiddleware, &export, format, req.FormValue("filter"), criteria)
+	if err != nil {
+		routes.renderError(fmt.Sprintf("Cannot export history: %s", err.Error()), res)
+		return
+	}
+
+	contentTypes := map[string]string{
+		walletcore.HistoryExportFormatCSV:  "text/csv",
+		walletcore.HistoryExportFormatJSON: "application/json",
+		walletcore.HistoryExportFormatOFX:  "application/x-ofx",
+	}
+	res.Header().Set("Content-Type", contentTypes[format])
+	res.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=godcr-history.%s", format))
+	res.Header().Set("Content-Length", strconv.Itoa(export.Len()))
+	if _, err = export.WriteTo(res); err != nil {
+		weblog.LogError(fmt.Errorf("error writing history export: %s", err.Error()))
+	}
+}
+
 func (routes *Routes) transactionDetailsPage(res http.ResponseWriter, req *http.Request) {
 	hash := chi.URLParam(req, "hash")
 	tx, err := routes.walletMiddleware.GetTransaction(hash)
@@ -480,6 +724,26 @@ func (routes *Routes) transactionDetailsPage(res http.ResponseWriter, req *http.
 	routes.renderPage("transaction_details.html", data, res)
 }
 
+func (routes *Routes) labelTransaction(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	txHash := req.FormValue("hash")
+	if txHash == "" {
+		data["error"] = "Transaction hash is required"
+		return
+	}
+
+	label := strings.TrimSpace(req.FormValue("label"))
+	err := routes.walletMiddleware.SetTransactionLabel(txHash, label)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Error labeling transaction: %s", err.Error())
+		return
+	}
+
+	data["label"] = label
+}
+
 func (routes *Routes) stakingPage(res http.ResponseWriter, req *http.Request) {
 	stakeInfo, err := routes.walletMiddleware.StakeInfo(routes.ctx)
 	if err != nil {
@@ -499,11 +763,29 @@ func (routes *Routes) stakingPage(res http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	ticketStatus := req.FormValue("status")
+	ticketFilter := &walletcore.TicketFilter{}
+	if ticketStatus != "" {
+		ticketFilter.Statuses = []string{ticketStatus}
+	}
+
+	tickets, err := routes.walletMiddleware.Tickets(routes.ctx, ticketFilter)
+	if err != nil {
+		routes.renderError(fmt.Sprintf("Error fetching tickets: %s", err.Error()), res)
+		return
+	}
+
 	data := map[string]interface{}{
 		"stakeinfo":             stakeInfo,
 		"accounts":              accounts,
 		"ticketPrice":           dcrutil.Amount(ticketPrice).ToCoin(),
 		"spendUnconfirmedFunds": routes.settings.SpendUnconfirmed,
+		"feeRateOptions":        walletcore.FeeRateOptions,
+		"tickets":               tickets,
+		"ticketStatuses":        walletcore.TicketStatuses,
+		"selectedTicketStatus":  ticketStatus,
+		"ticketBuyerConfig":     routes.ticketBuyer.Config(),
+		"ticketBuyerStatus":     routes.ticketBuyer.Status(),
 	}
 
 	routes.renderPage("staking.html", data, res)
@@ -538,11 +820,27 @@ func (routes *Routes) submitPurchaseTicketsForm(res http.ResponseWriter, req *ht
 		requiredConfirmations = 0
 	}
 
+	feeRate := walletcore.DefaultFeeRate
+	if feeRateStr := req.FormValue("fee-rate"); feeRateStr != "" {
+		if feeRate, err = strconv.ParseInt(feeRateStr, 10, 64); err != nil {
+			data["success"] = false
+			data["message"] = fmt.Sprintf("invalid fee rate: %s", feeRateStr)
+			return
+		}
+	}
+	if err = walletcore.ValidateFeeRate(feeRate); err != nil {
+		data["success"] = false
+		data["message"] = err.Error()
+		return
+	}
+
 	request := dcrlibwallet.PurchaseTicketsRequest{
 		RequiredConfirmations: uint32(requiredConfirmations),
 		Passphrase:            []byte(walletPassphrase),
 		NumTickets:            uint32(numTickets),
 		Account:               uint32(sourceAccount),
+		TxFee:                 feeRate,
+		TicketFee:             feeRate,
 	}
 
 	ticketHashes, err := routes.walletMiddleware.PurchaseTicket(routes.ctx, request)
@@ -560,8 +858,49 @@ func (routes *Routes) submitPurchaseTicketsForm(res http.ResponseWriter, req *ht
 
 	data["success"] = true
 	data["message"] = ticketHashes
+}
+
+func (routes *Routes) revokeTickets(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	walletPassphrase := req.FormValue("wallet-passphrase")
+
+	err := routes.walletMiddleware.RevokeTickets(routes.ctx, walletPassphrase)
+	if err != nil {
+		data["success"] = false
+		data["message"] = err.Error()
+		return
+	}
 
-	routes.sendWsBalance()
+	data["success"] = true
+}
+
+func (routes *Routes) startTicketBuyer(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	walletPassphrase := req.FormValue("wallet-passphrase")
+
+	err := routes.ticketBuyer.Start(routes.ctx, walletPassphrase)
+	if err != nil {
+		data["success"] = false
+		data["message"] = err.Error()
+		return
+	}
+
+	data["success"] = true
+	data["message"] = routes.ticketBuyer.Status()
+}
+
+func (routes *Routes) stopTicketBuyer(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	routes.ticketBuyer.Stop()
+
+	data["success"] = true
+	data["message"] = routes.ticketBuyer.Status()
 }
 
 func (routes *Routes) accountsPage(res http.ResponseWriter, req *http.Request) {
@@ -593,17 +932,197 @@ func (routes *Routes) accountsPage(res http.ResponseWriter, req *http.Request) {
 	routes.renderPage("accounts.html", data, res)
 }
 
-func (routes *Routes) securityPage(res http.ResponseWriter, req *http.Request) {
+func (routes *Routes) createAccount(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	accountName := strings.TrimSpace(req.FormValue("name"))
+	passphrase := req.FormValue("passphrase")
+
+	if accountName == "" {
+		data["error"] = "Account name cannot be empty"
+		return
+	}
+
+	accountNumber, err := routes.walletMiddleware.NextAccount(accountName, passphrase)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Error creating account: %s", err.Error())
+		return
+	}
+
+	data["accountNumber"] = accountNumber
+}
+
+func (routes *Routes) renameAccount(res http.ResponseWriter, req *http.Request) {
 	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	accountNumber, err := strconv.ParseUint(req.FormValue("account"), 10, 32)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Invalid account selected: %s", req.FormValue("account"))
+		return
+	}
+
+	newName := strings.TrimSpace(req.FormValue("name"))
+	if newName == "" {
+		data["error"] = "Account name cannot be empty"
+		return
+	}
+
+	err = routes.walletMiddleware.RenameAccount(uint32(accountNumber), newName)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Error renaming account: %s", err.Error())
+		return
+	}
+
+	data["name"] = newName
+}
+
+func (routes *Routes) accountExtendedPubKey(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	accountNumberStr := chi.URLParam(req, "accountNumber")
+	accountNumber, err := strconv.ParseUint(accountNumberStr, 10, 32)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Invalid account selected: %s", accountNumberStr)
+		return
+	}
+
+	extendedPubKey, err := routes.walletMiddleware.AccountExtendedPubKey(uint32(accountNumber))
+	if err != nil {
+		data["error"] = fmt.Sprintf("Error getting account extended public key: %s", err.Error())
+		return
+	}
+
+	data["extendedPubKey"] = extendedPubKey
+}
+
+func (routes *Routes) taxReportPage(res http.ResponseWriter, req *http.Request) {
+	currency := routes.fiatCurrency()
+	if currencyValue := conversion.NormalizeCurrency(req.FormValue("currency")); currencyValue != "" {
+		currency = currencyValue
+	}
+
+	report, err := taxreport.Generate(routes.walletMiddleware, routes.txRates, currency)
+	if err != nil {
+		routes.renderError(fmt.Sprintf("Error generating tax report: %s", err.Error()), res)
+		return
+	}
+
+	data := map[string]interface{}{
+		"currency":       currency,
+		"fiatCurrencies": conversion.FiatCurrencies,
+		"years":          report.Years,
+		"selectedYear":   0,
+	}
+
+	yearReports := report.Years
+	if yearValue := req.FormValue("year"); yearValue != "" {
+		year, err := strconv.Atoi(yearValue)
+		if err != nil {
+			routes.renderError(fmt.Sprintf("Invalid year: %s", yearValue), res)
+			return
+		}
+		data["selectedYear"] = year
+		yearReports = nil
+		if yearReport := report.Year(year); yearReport != nil {
+			yearReports = []*taxreport.YearReport{yearReport}
+		}
+	}
+
+	var missingRates int
+	for _, yearReport := range yearReports {
+		missingRates += yearReport.MissingRates
+	}
+	data["yearReports"] = yearReports
+	data["missingRates"] = missingRates
+
+	routes.renderPage("taxreport.html", data, res)
+}
+
+func (routes *Routes) securityPage(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{
+		"seedBackupVerified": routes.walletMiddleware.SeedBackupVerified(),
+	}
 	routes.renderPage("security.html", data, res)
 }
 
+func (routes *Routes) verifySeedBackup(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	seed := req.FormValue("seed")
+	if strings.TrimSpace(seed) == "" {
+		data["error"] = "Seed cannot be empty"
+		return
+	}
+
+	err := routes.walletMiddleware.VerifySeedBackup(seed)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Error verifying seed backup: %s", err.Error())
+		return
+	}
+
+	data["success"] = true
+}
+
+func (routes *Routes) signMessage(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	address := req.FormValue("address")
+	message := req.FormValue("message")
+	passphrase := req.FormValue("passphrase")
+
+	if address == "" || message == "" {
+		data["error"] = "Address and message cannot be empty"
+		return
+	}
+
+	signature, err := routes.walletMiddleware.SignMessage(address, message, passphrase)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Error signing message: %s", err.Error())
+		return
+	}
+
+	data["signature"] = signature
+}
+
+func (routes *Routes) verifyMessage(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	address := req.FormValue("address")
+	message := req.FormValue("message")
+	signature := req.FormValue("signature")
+
+	if address == "" || message == "" || signature == "" {
+		data["error"] = "Address, message and signature cannot be empty"
+		return
+	}
+
+	valid, err := routes.walletMiddleware.VerifyMessage(address, message, signature)
+	if err != nil {
+		data["error"] = fmt.Sprintf("Error verifying message: %s", err.Error())
+		return
+	}
+
+	data["valid"] = valid
+}
+
 func (routes *Routes) settingsPage(res http.ResponseWriter, req *http.Request) {
+	currencyConverter, _ := conversion.CanonicalSourceName(routes.settings.CurrencyConverter)
+
 	data := map[string]interface{}{
 		"spendUnconfirmedFunds":               routes.settings.SpendUnconfirmed,
 		"showIncomingTransactionNotification": routes.settings.ShowIncomingTransactionNotification,
 		"showNewBlockNotification":            routes.settings.ShowNewBlockNotification,
-		"currencyConverter":                   routes.settings.CurrencyConverter,
+		"currencyConverter":                   currencyConverter,
+		"currencyConverters":                  conversion.SourceNames,
+		"fiatCurrency":                        routes.fiatCurrency(),
+		"fiatCurrencies":                      conversion.FiatCurrencies,
+		"rescanInProgress":                    routes.rescanInProgress(),
 	}
 
 	routes.renderPage("settings.html", data, res)
@@ -691,7 +1210,17 @@ func (routes *Routes) updateSetting(res http.ResponseWriter, req *http.Request)
 		routes.settings.ShowNewBlockNotification = showNewBlockNotification
 	}
 
-	if currencyConverter := req.FormValue("currency-converter"); currencyConverter != "" {
+	if currencyConverterValue := req.FormValue("currency-converter"); currencyConverterValue != "" {
+		currencyConverter, ok := conversion.CanonicalSourceName(currencyConverterValue)
+		if !ok {
+			data["error"] = fmt.Sprintf("Error updating settings. Unknown currency converter: %s", currencyConverterValue)
+			return
+		}
+		if currencyConverter == conversion.SourceFile && routes.settings.ExchangeRatesFile == "" {
+			data["error"] = "Error updating settings. Set exchangeratesfile in the config file to use rates from a file"
+			return
+		}
+
 		err := config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
 			cnfg.CurrencyConverter = currencyConverter
 		})
@@ -703,6 +1232,18 @@ func (routes *Routes) updateSetting(res http.ResponseWriter, req *http.Request)
 		routes.settings.CurrencyConverter = currencyConverter
 	}
 
+	if fiatCurrency := conversion.NormalizeCurrency(req.FormValue("fiat-currency")); fiatCurrency != "" {
+		err := config.UpdateConfigFile(func(cnfg *config.ConfFileOptions) {
+			cnfg.FiatCurrency = fiatCurrency
+		})
+		if err != nil {
+			data["error"] = fmt.Sprintf("Error updating settings. %s", err.Error())
+			return
+		}
+
+		routes.settings.FiatCurrency = fiatCurrency
+	}
+
 	if defaultAccountStr := req.FormValue("default-account"); defaultAccountStr != "" {
 		defaultAccountInt, err := strconv.Atoi(defaultAccountStr)
 		if err != nil {
@@ -788,13 +1329,64 @@ func (routes *Routes) updateSetting(res http.ResponseWriter, req *http.Request)
 	}
 }
 
+// rescanBlockchain starts a rescan of the current wallet in the background.
+// The rescan progress is sent to the browser over the websocket while the wallet is the current wallet.
 func (routes *Routes) rescanBlockchain(res http.ResponseWriter, req *http.Request) {
-	err := routes.walletMiddleware.RescanBlockChain()
-	if err != nil {
-		renderJSON(map[string]interface{}{"error": err.Error()}, res)
-	} else {
-		renderJSON(map[string]interface{}{"success": true}, res)
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	walletName := routes.wallets.CurrentName()
+	walletMiddleware := routes.walletMiddleware
+	currentWalletState := routes.walletStates[walletName]
+
+	routes.rescanMu.Lock()
+	defer routes.rescanMu.Unlock()
+
+	if currentWalletState.cancelRescan != nil {
+		data["error"] = "A rescan is already in progress"
+		return
 	}
+
+	ctx, cancel := context.WithCancel(routes.ctx)
+	currentWalletState.cancelRescan = cancel
+
+	go func() {
+		defer cancel()
+
+		err := walletMiddleware.RescanBlockChain(ctx, func(progress walletcore.RescanProgress) {
+			if routes.wallets.CurrentName() == walletName {
+				routes.sendWsRescanProgress(progress, nil)
+			}
+		})
+
+		routes.rescanMu.Lock()
+		currentWalletState.cancelRescan = nil
+		routes.rescanMu.Unlock()
+
+		if err != nil && routes.ctx.Err() == nil && routes.wallets.CurrentName() == walletName {
+			routes.sendWsRescanProgress(walletcore.RescanProgress{}, err)
+		}
+	}()
+
+	data["success"] = true
+}
+
+// cancelRescan stops the rescan of the current wallet.
+func (routes *Routes) cancelRescan(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	routes.rescanMu.Lock()
+	cancelRescan := routes.walletStates[routes.wallets.CurrentName()].cancelRescan
+	routes.rescanMu.Unlock()
+
+	if cancelRescan == nil {
+		data["error"] = "No rescan is in progress"
+		return
+	}
+
+	cancelRescan()
+	data["success"] = true
 }
 
 func (routes *Routes) deleteWallet(res http.ResponseWriter, req *http.Request) {
@@ -806,6 +1398,6 @@ func (routes *Routes) deleteWallet(res http.ResponseWriter, req *http.Request) {
 		data["error"] = fmt.Sprintf("Error in deleting wallet: %s", err.Error())
 		return
 	}
-	
+
 	data["success"] = true
 }
@@ -16,7 +16,9 @@ type sendPagePayload struct {
 	sourceAccount         uint32
 	passphrase            string
 	requiredConfirmations int32
+	feeRate               int64
 	useCustom             bool
+	coinSelection         string
 	sendDestinations      []txhelper.TransactionDestination
 	totalSendAmount       dcrutil.Amount
 	changeDestinations    []txhelper.TransactionDestination
@@ -25,7 +27,7 @@ type sendPagePayload struct {
 
 // retrieveSendPagePayload parses the req for the send parameters submitted;
 // the order of form fields on the front end is followed:
-// source account - spend unconfirmed - custom inputs - send destinations - custom change outputs
+// source account - spend unconfirmed - fee rate - custom inputs - send destinations - custom change outputs
 func retrieveSendPagePayload(req *http.Request, addressFunc func(accountNumber uint32) (string, error)) (payload *sendPagePayload, err error) {
 	payload = new(sendPagePayload)
 
@@ -47,11 +49,23 @@ func retrieveSendPagePayload(req *http.Request, addressFunc func(accountNumber u
 		payload.requiredConfirmations = 0
 	}
 
+	payload.feeRate = walletcore.DefaultFeeRate
+	if feeRate := req.FormValue("fee-rate"); feeRate != "" {
+		payload.feeRate, err = strconv.ParseInt(feeRate, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee rate: %s", feeRate)
+		}
+		if err = walletcore.ValidateFeeRate(payload.feeRate); err != nil {
+			return nil, err
+		}
+	}
+
 	// parse custom inputs form data
 	payload.useCustom = req.FormValue("use-custom") != ""
 	if payload.useCustom {
-		// set selected utxos
+		// set selected utxos and the strategy used to select them automatically
 		payload.utxos = req.Form["utxo"]
+		payload.coinSelection = req.FormValue("coin-selection")
 
 		// set total selected inputs amount
 		var totalInputAmountDcr float64
@@ -5,15 +5,34 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/web/weblog"
 )
 
+// pageHeaderData holds the values displayed in the header of every page
+type pageHeaderData struct {
+	walletcore.ConnectionInfo
+	WatchingOnly bool
+
+	// WalletNames lists the opened wallets, a wallet switcher is shown if there's more than one
+	WalletNames   []string
+	CurrentWallet string
+}
+
 func (routes *Routes) renderPage(tplName string, data map[string]interface{}, res http.ResponseWriter) {
 	connectionInfo, err := routes.walletMiddleware.WalletConnectionInfo()
 	if err != nil {
 		weblog.LogError(err)
 	}
-	data["connectionInfo"] = connectionInfo
+	// watch-only wallets cannot spend, so links and forms for spending are hidden from pages
+	watchingOnly := routes.walletMiddleware.IsWatchingOnlyWallet()
+	data["connectionInfo"] = pageHeaderData{
+		ConnectionInfo: connectionInfo,
+		WatchingOnly:   watchingOnly,
+		WalletNames:    routes.wallets.Names(),
+		CurrentWallet:  routes.wallets.CurrentName(),
+	}
+	data["watchingOnly"] = watchingOnly
 	routes.render(tplName, data, res)
 }
 
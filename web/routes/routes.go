@@ -4,39 +4,65 @@ import (
 	"context"
 	"html/template"
 	"log"
+	"sync"
 
 	"github.com/go-chi/chi"
 	"github.com/gobuffalo/packr/v2"
 	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
 	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/addressbook"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/conversion"
+	"github.com/raedahgroup/godcr/app/ticketbuyer"
+	"github.com/raedahgroup/godcr/app/txrates"
 )
 
 // Routes holds data required to process web server routes and display appropriate content on a page
 type Routes struct {
+	// walletMiddleware is the current wallet in wallets, replaced when another wallet is selected
 	walletMiddleware app.WalletMiddleware
+	wallets          *app.WalletManager
+	walletStates     map[string]*walletState
 	//walletExists       bool
 	templates          map[string]*template.Template
 	syncProgressReport *defaultsynclistener.ProgressReport
 	ctx                context.Context
 	settings           *config.Settings
+	ticketBuyer        *ticketbuyer.TicketBuyer
+	addressBook        *addressbook.AddressBook
+	txRates            *txrates.Store
+
+	// rateSource is created from the currency converter settings on first use, see exchangeRateSource.
+	rateSourceMu     sync.Mutex
+	rateSource       conversion.RateSource
+	rateSourceConfig string
+
+	// rescanMu guards the cancelRescan functions of walletStates
+	rescanMu sync.Mutex
 }
 
 // OpenWalletAndSetupRoutes attempts to open the wallet, prepares page templates and creates route handlers
-// returns syncBlockChain function
-func OpenWalletAndSetupRoutes(ctx context.Context, walletMiddleware app.WalletMiddleware, router chi.Router, settings *config.Settings) (func(), error) {
+// returns syncBlockChain function that syncs all opened wallets
+// the ticket buyer of each wallet in ticketBuyerPassphrases is started with the wallet's passphrase
+func OpenWalletAndSetupRoutes(ctx context.Context, walletManager *app.WalletManager, router chi.Router, settings *config.Settings,
+	ticketBuyerConfig config.TicketBuyerConfig, ticketBuyerPassphrases map[string]string, addressBook *addressbook.AddressBook,
+	txRates *txrates.Store) (func(), error) {
 	// todo: main.go now requires that the user select a wallet or create one before launching interfaces, so need for this check
 	//walletExists, err := walletMiddleware.WalletExists()
 	//if err != nil {
 	//	return nil, err
 	//}
 	routes := &Routes{
-		walletMiddleware:   walletMiddleware,
-		templates:          map[string]*template.Template{},
-		syncProgressReport: defaultsynclistener.InitProgressReport(),
-		ctx:                ctx,
+		wallets:   walletManager,
+		templates: map[string]*template.Template{},
+		ctx:       ctx,
 		//walletExists:       walletExists,
-		settings: settings,
+		settings:    settings,
+		addressBook: addressBook,
+		txRates:     txRates,
+	}
+	if err := routes.prepareWalletStates(ticketBuyerConfig, ticketBuyerPassphrases); err != nil {
+		return nil, err
 	}
 
 	routes.loadTemplates()
@@ -81,7 +107,9 @@ func (routes *Routes) loadRoutes(router chi.Router) {
 	router.Post("/change-password", routes.changeSpendingPassword)
 	router.Put("/settings", routes.updateSetting)
 	router.Post("/rescan-blockchain", routes.rescanBlockchain)
+	router.Post("/cancel-rescan", routes.cancelRescan)
 	router.Delete("/delete-wallet", routes.deleteWallet)
+	router.Post("/switch-wallet", routes.switchWallet)
 
 	router.Get("/ws", routes.wsHandler)
 	go routes.waitToSendMessagesToClients()
@@ -104,12 +132,27 @@ func (routes *Routes) registerRoutesRequiringWallet(router chi.Router) {
 	router.Get("/receive", routes.receivePage)
 	router.Get("/generate-address/{accountNumber}", routes.generateReceiveAddress)
 	router.Get("/unspent-outputs/{accountNumber}", routes.getUnspentOutputs)
+	router.Post("/lock-unspent", routes.lockUnspent)
+	router.Get("/select-coins", routes.selectCoins)
 	router.Get("/random-change-outputs", routes.getRandomChangeOutputs)
 	router.Get("/history", routes.historyPage)
 	router.Get("/next-history-page", routes.getNextHistoryPage)
+	router.Get("/search-history", routes.searchHistory)
+	router.Get("/history/export", routes.exportHistory)
+	router.Get("/tax-report", routes.taxReportPage)
 	router.Get("/transaction-details/{hash}", routes.transactionDetailsPage)
+	router.Post("/label-transaction", routes.labelTransaction)
 	router.Get("/staking", routes.stakingPage)
 	router.Post("/purchase-tickets", routes.submitPurchaseTicketsForm)
+	router.Post("/revoke-tickets", routes.revokeTickets)
+	router.Post("/ticket-buyer/start", routes.startTicketBuyer)
+	router.Post("/ticket-buyer/stop", routes.stopTicketBuyer)
 	router.Get("/accounts", routes.accountsPage)
+	router.Post("/create-account", routes.createAccount)
+	router.Post("/rename-account", routes.renameAccount)
+	router.Get("/account-xpub/{accountNumber}", routes.accountExtendedPubKey)
 	router.Get("/security", routes.securityPage)
+	router.Post("/sign-message", routes.signMessage)
+	router.Post("/verify-message", routes.verifyMessage)
+	router.Post("/verify-seed-backup", routes.verifySeedBackup)
 }
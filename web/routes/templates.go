@@ -22,6 +22,7 @@ func templates() []string {
 		"send.html",
 		"receive.html",
 		"history.html",
+		"taxreport.html",
 		"transaction_details.html",
 		"staking.html",
 		"accounts.html",
@@ -70,12 +70,24 @@ func (routes *Routes) walletLoaderFn(next http.Handler) http.Handler {
 	})
 }
 
+// syncBlockChain starts syncing all opened wallets, only the sync progress of the current wallet is sent to the browser.
 func (routes *Routes) syncBlockChain() {
-	routes.walletMiddleware.SyncBlockChain(false, func(report *defaultsynclistener.ProgressReport) {
-		routes.syncProgressReport = report
-		routes.sendWsSyncProgress()
-		routes.sendWsConnectionInfoUpdate()
-	})
+	for _, walletName := range routes.wallets.Names() {
+		walletName := walletName
+		wallet, _ := routes.wallets.Wallet(walletName)
+		state := routes.walletStates[walletName]
+
+		wallet.SyncBlockChain(false, func(report *defaultsynclistener.ProgressReport) {
+			state.syncProgressReport = report
+			if routes.wallets.CurrentName() != walletName {
+				return
+			}
+
+			routes.syncProgressReport = report
+			routes.sendWsSyncProgress()
+			routes.sendWsConnectionInfoUpdate()
+		})
+	}
 }
 
 func (routes *Routes) prepareSyncInfoMap() (map[string]interface{}, error) {
@@ -0,0 +1,115 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/raedahgroup/dcrlibwallet/defaultsynclistener"
+	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/ticketbuyer"
+	"github.com/raedahgroup/godcr/app/walletcore"
+)
+
+// walletState holds the sync progress, ticket buyer and rescan of an opened wallet,
+// so they are kept when switching to another wallet and back.
+type walletState struct {
+	syncProgressReport *defaultsynclistener.ProgressReport
+	ticketBuyer        *ticketbuyer.TicketBuyer
+
+	// cancelRescan stops the rescan of the wallet, it is nil if no rescan is in progress
+	cancelRescan context.CancelFunc
+}
+
+// prepareWalletStates creates the state of each opened wallet and sets up the current wallet's state for use by page handlers.
+// The ticket buyers of the wallets in ticketBuyerPassphrases are started, they buy tickets once the wallets are synced.
+func (routes *Routes) prepareWalletStates(ticketBuyerConfig config.TicketBuyerConfig, ticketBuyerPassphrases map[string]string) error {
+	routes.walletStates = make(map[string]*walletState)
+	for _, walletName := range routes.wallets.Names() {
+		wallet, _ := routes.wallets.Wallet(walletName)
+		ticketBuyer := ticketbuyer.New(wallet, ticketBuyerConfig, routes.ticketBuyerStatusUpdated(walletName))
+		routes.walletStates[walletName] = &walletState{
+			syncProgressReport: defaultsynclistener.InitProgressReport(),
+			ticketBuyer:        ticketBuyer,
+		}
+		if passphrase, ok := ticketBuyerPassphrases[walletName]; ok {
+			if err := ticketBuyer.Start(routes.ctx, passphrase); err != nil {
+				return fmt.Errorf("error starting ticket buyer of wallet %s: %s", walletName, err.Error())
+			}
+		}
+		wallet.Events().Subscribe(routes.walletEventReceived(walletName), events.TxReceived, events.TxConfirmed,
+			events.BlockAttached, events.BalanceChanged, events.ConnectionChanged)
+	}
+
+	currentWalletState := routes.walletStates[routes.wallets.CurrentName()]
+	routes.walletMiddleware = routes.wallets.Current()
+	routes.syncProgressReport = currentWalletState.syncProgressReport
+	routes.ticketBuyer = currentWalletState.ticketBuyer
+	return nil
+}
+
+// ticketBuyerStatusUpdated returns a function that sends ticket buyer status updates of the wallet named `walletName`
+// to the browser while that wallet is the current wallet.
+func (routes *Routes) ticketBuyerStatusUpdated(walletName string) func(ticketbuyer.Status) {
+	return func(status ticketbuyer.Status) {
+		if routes.wallets.CurrentName() == walletName {
+			routes.sendWsTicketBuyerStatus(status)
+		}
+	}
+}
+
+// walletEventReceived returns a function that updates the browser with the events of the wallet named `walletName`
+// while that wallet is the current wallet.
+func (routes *Routes) walletEventReceived(walletName string) func(events.Event) {
+	return func(event events.Event) {
+		if routes.wallets.CurrentName() != walletName {
+			return
+		}
+
+		switch event.Type {
+		case events.TxReceived, events.TxConfirmed:
+			routes.sendWsNewTransaction(event.Type, event.Data.(events.Tx))
+
+		case events.BlockAttached:
+			routes.sendWsConnectionInfoUpdate()
+
+		case events.BalanceChanged:
+			routes.sendWsBalance()
+
+		case events.ConnectionChanged:
+			if event.Data.(events.Connection).Connected {
+				routes.sendWsConnectionState(walletcore.ConnectionStateConnected)
+				routes.sendWsConnectionInfoUpdate()
+			} else {
+				routes.sendWsConnectionState(walletcore.ConnectionStateReconnecting)
+			}
+		}
+	}
+}
+
+// rescanInProgress returns true if the current wallet is being rescanned.
+func (routes *Routes) rescanInProgress() bool {
+	routes.rescanMu.Lock()
+	defer routes.rescanMu.Unlock()
+	return routes.walletStates[routes.wallets.CurrentName()].cancelRescan != nil
+}
+
+// switchWallet makes the wallet selected in the page header the wallet used by all pages.
+func (routes *Routes) switchWallet(res http.ResponseWriter, req *http.Request) {
+	data := map[string]interface{}{}
+	defer renderJSON(data, res)
+
+	walletMiddleware, err := routes.wallets.Switch(req.FormValue("wallet"))
+	if err != nil {
+		data["error"] = err.Error()
+		return
+	}
+
+	currentWalletState := routes.walletStates[routes.wallets.CurrentName()]
+	routes.walletMiddleware = walletMiddleware
+	routes.syncProgressReport = currentWalletState.syncProgressReport
+	routes.ticketBuyer = currentWalletState.ticketBuyer
+
+	data["success"] = true
+}
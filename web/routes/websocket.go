@@ -1,12 +1,15 @@
 package routes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 
 	"github.com/decred/dcrd/dcrutil"
 	"github.com/gorilla/websocket"
+	"github.com/raedahgroup/godcr/app/events"
+	"github.com/raedahgroup/godcr/app/ticketbuyer"
 	"github.com/raedahgroup/godcr/app/walletcore"
 	"github.com/raedahgroup/godcr/web/weblog"
 )
@@ -22,9 +25,13 @@ var upgrader = websocket.Upgrader{
 type eventType string
 
 const (
-	updateConnectionInfo eventType = "updateConnInfo"
-	updateBalance        eventType = "updateBalance"
-	updateSyncProgress   eventType = "updateSyncProgress"
+	updateConnectionInfo  eventType = "updateConnInfo"
+	updateBalance         eventType = "updateBalance"
+	updateSyncProgress    eventType = "updateSyncProgress"
+	updateTicketBuyer     eventType = "updateTicketBuyer"
+	updateConnectionState eventType = "updateConnectionState"
+	updateRescanProgress  eventType = "updateRescanProgress"
+	newTransaction        eventType = "newTransaction"
 )
 
 type Packet struct {
@@ -118,3 +125,51 @@ func (routes *Routes) sendWsSyncProgress() {
 		Message: syncInfo,
 	}
 }
+
+func (routes *Routes) sendWsTicketBuyerStatus(status ticketbuyer.Status) {
+	wsBroadcast <- Packet{
+		Event:   updateTicketBuyer,
+		Message: status,
+	}
+}
+
+func (routes *Routes) sendWsConnectionState(state walletcore.ConnectionState) {
+	wsBroadcast <- Packet{
+		Event:   updateConnectionState,
+		Message: state,
+	}
+}
+
+// sendWsRescanProgress sends the progress of a rescan to the browser, or the error that ended the rescan if `rescanErr` is set.
+func (routes *Routes) sendWsRescanProgress(progress walletcore.RescanProgress, rescanErr error) {
+	message := map[string]interface{}{
+		"currentHeight": progress.CurrentHeight,
+		"totalHeight":   progress.TotalHeight,
+		"percentage":    progress.Percentage(),
+		"done":          progress.Done,
+	}
+	if rescanErr == context.Canceled {
+		message["canceled"] = true
+	} else if rescanErr != nil {
+		message["error"] = rescanErr.Error()
+	}
+
+	wsBroadcast <- Packet{
+		Event:   updateRescanProgress,
+		Message: message,
+	}
+}
+
+// sendWsNewTransaction tells the browser about a transaction of the current wallet that was just received or mined.
+func (routes *Routes) sendWsNewTransaction(txEventType events.Type, tx events.Tx) {
+	wsBroadcast <- Packet{
+		Event: newTransaction,
+		Message: map[string]interface{}{
+			"hash":        tx.Hash,
+			"amount":      dcrutil.Amount(tx.Amount).String(),
+			"direction":   tx.Direction,
+			"blockHeight": tx.BlockHeight,
+			"confirmed":   txEventType == events.TxConfirmed,
+		},
+	}
+}
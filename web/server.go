@@ -14,13 +14,17 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/gobuffalo/packr/v2"
 	"github.com/raedahgroup/godcr/app"
+	"github.com/raedahgroup/godcr/app/addressbook"
 	"github.com/raedahgroup/godcr/app/config"
+	"github.com/raedahgroup/godcr/app/txrates"
 	"github.com/raedahgroup/godcr/cli/termio/terminalprompt"
 	"github.com/raedahgroup/godcr/web/routes"
 	"github.com/raedahgroup/godcr/web/weblog"
 )
 
-func StartServer(ctx context.Context, walletMiddleware app.WalletMiddleware, httpHost, httpPort string, settings *config.Settings) error {
+func StartServer(ctx context.Context, walletManager *app.WalletManager, httpHost, httpPort string, settings *config.Settings,
+	ticketBuyerConfig config.TicketBuyerConfig, ticketBuyerPassphrases map[string]string, addressBook *addressbook.AddressBook,
+	txRates *txrates.Store) error {
 	router := chi.NewRouter()
 
 	// setup static file serving
@@ -29,7 +33,8 @@ func StartServer(ctx context.Context, walletMiddleware app.WalletMiddleware, htt
 
 	// setup routes for templated pages, returns sync blockchain function if wallet is successfully opened
 	// returns error if wallet exists but could not be opened
-	syncBlockchain, err := routes.OpenWalletAndSetupRoutes(ctx, walletMiddleware, router, settings)
+	syncBlockchain, err := routes.OpenWalletAndSetupRoutes(ctx, walletManager, router, settings, ticketBuyerConfig, ticketBuyerPassphrases,
+		addressBook, txRates)
 	if err != nil {
 		return err
 	}
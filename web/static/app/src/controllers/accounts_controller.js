@@ -1,10 +1,13 @@
 import { Controller } from 'stimulus'
 import axios from 'axios'
-import { showErrorNotification, showSuccessNotification } from '../utils'
+import { hide, show, showErrorNotification, showSuccessNotification } from '../utils'
 
 export default class extends Controller {
   static get targets () {
-    return ['hideAccount', 'defaultAccount']
+    return [
+      'hideAccount', 'defaultAccount', 'accountName', 'extendedPubKey',
+      'newAccountName', 'newAccountPassphrase', 'createAccountErrorMessage'
+    ]
   }
 
   toggleHideAccount (e) {
@@ -52,4 +55,81 @@ export default class extends Controller {
       showErrorNotification('A server error occurred')
     })
   }
+
+  renameAccount (e) {
+    e.preventDefault()
+    const accountNumber = e.currentTarget.getAttribute('data-account')
+    const nameEl = this.accountNameTargets.find(el => el.getAttribute('data-account') === accountNumber)
+    if (nameEl.value.trim() === '') {
+      showErrorNotification('Account name cannot be empty')
+      return
+    }
+
+    const postData = `account=${accountNumber}&name=${encodeURIComponent(nameEl.value)}`
+    axios.post('/rename-account', postData).then((response) => {
+      const result = response.data
+      if (result.error) {
+        showErrorNotification(result.error)
+      } else {
+        showSuccessNotification('Account renamed successfully')
+        window.location.reload()
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+    })
+  }
+
+  showExtendedPubKey (e) {
+    const accountNumber = e.currentTarget.getAttribute('data-account')
+    const keyEl = this.extendedPubKeyTargets.find(el => el.getAttribute('data-account') === accountNumber)
+
+    axios.get(`/account-xpub/${accountNumber}`).then((response) => {
+      const result = response.data
+      if (result.error) {
+        showErrorNotification(result.error)
+      } else {
+        keyEl.textContent = result.extendedPubKey
+        show(keyEl)
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+    })
+  }
+
+  createAccount (e) {
+    e.preventDefault()
+    hide(this.createAccountErrorMessageTarget)
+
+    if (this.newAccountNameTarget.value.trim() === '') {
+      this.showCreateAccountError('Account name is required')
+      return
+    }
+
+    let submitBtn = e.currentTarget
+    submitBtn.textContent = 'Creating...'
+    submitBtn.setAttribute('disabled', true)
+
+    const _this = this
+    const postData = $('#create-account-form').serialize()
+    axios.post('/create-account', postData).then((response) => {
+      const result = response.data
+      if (result.error) {
+        _this.showCreateAccountError(result.error)
+      } else {
+        showSuccessNotification('Account created successfully')
+        window.location.reload()
+      }
+    }).catch(() => {
+      _this.showCreateAccountError('A server error occurred')
+    }).then(() => {
+      _this.newAccountPassphraseTarget.value = ''
+      submitBtn.textContent = 'Create'
+      submitBtn.removeAttribute('disabled')
+    })
+  }
+
+  showCreateAccountError (message) {
+    this.createAccountErrorMessageTarget.textContent = message
+    show(this.createAccountErrorMessageTarget)
+  }
 }
@@ -1,5 +1,5 @@
 import { Controller } from 'stimulus'
-import { hide, show } from '../utils'
+import { hide, show, showSuccessNotification } from '../utils'
 import ws from '../services/messagesocket_service'
 
 export default class extends Controller {
@@ -7,35 +7,61 @@ export default class extends Controller {
     return [
       'totalBalance',
       'peersConnected',
+      'syncSource',
       'latestBlock',
       'networkType',
-      'blockScanProgress'
+      'blockScanProgress',
+      'reconnecting'
     ]
   }
 
   connect () {
     ws.registerEvtHandler('updateConnInfo', data => {
-      this.peersConnectedTarget.textContent = data.peersConnected
+      // peers are not shown for wallets that sync with a dcrd node over rpc
+      if (this.hasPeersConnectedTarget) {
+        this.peersConnectedTarget.textContent = data.peersConnected
+      }
+      if (this.hasSyncSourceTarget) {
+        this.syncSourceTarget.textContent = data.syncSource
+      }
       this.totalBalanceTarget.textContent = data.totalBalance
       this.latestBlockTarget.textContent = data.latestBlock
       this.networkTypeTarget.textContent = data.networkType
+      this.showConnectionState(data.connectionState)
+    })
+
+    ws.registerEvtHandler('updateConnectionState', state => {
+      this.showConnectionState(state)
     })
 
     ws.registerEvtHandler('updateBalance', data => {
       this.totalBalanceTarget.textContent = data.total
     })
 
-    ws.registerEvtHandler('updateSyncProgress', syncInfo => {
-      // hide the persistent blocks rescan progress section if this is the initial sync on server start (i.e. !syncInfo.done)
-      // or if block headers rescan has not started or has completed (i.e. syncInfo.rescanProgress <= 0 || syncInfo.rescanProgress >= 100)
-      if (!syncInfo.done || syncInfo.rescanProgress <= 0 || syncInfo.rescanProgress >= 100) {
+    ws.registerEvtHandler('newTransaction', tx => {
+      // direction 1 is received, confirmations of received txs are not notified to avoid notifying each tx twice
+      if (tx.direction === 1 && !tx.confirmed) {
+        showSuccessNotification(`You have received ${tx.amount}`)
+      }
+    })
+
+    ws.registerEvtHandler('updateRescanProgress', progress => {
+      if (progress.done || progress.error || progress.canceled) {
         hide(this.blockScanProgressTarget)
         return
       }
 
-      this.blockScanProgressTarget.textContent = `Rescanning block headers ${syncInfo.rescanProgress}%. `
-      this.blockScanProgressTarget.textContent += `Scanning ${syncInfo.currentRescanHeight} of ${syncInfo.totalHeadersToFetch} block headers.`
+      this.blockScanProgressTarget.textContent = `Rescanning blockchain ${progress.percentage}%. `
+      this.blockScanProgressTarget.textContent += `Scanned ${progress.currentHeight} of ${progress.totalHeight} blocks.`
       show(this.blockScanProgressTarget)
     })
   }
+
+  showConnectionState (state) {
+    if (state === 'reconnecting') {
+      show(this.reconnectingTarget)
+    } else {
+      hide(this.reconnectingTarget)
+    }
+  }
 }
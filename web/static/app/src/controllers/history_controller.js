@@ -1,11 +1,12 @@
 import { Controller } from 'stimulus'
 import axios from 'axios'
 import { hide, show, truncate } from '../utils'
+import ws from '../services/messagesocket_service'
 
 export default class extends Controller {
   static get targets () {
     return [
-      'selectedFilter',
+      'selectedFilter', 'filterInput', 'searchInput',
       'transactionCountContainer', 'transactionCount', 'transactionTotalCount',
       'stickyTableHeader', 'historyTable',
       'txRowTemplate',
@@ -18,6 +19,16 @@ export default class extends Controller {
   connect () {
     window.addEventListener('resize', this.alignTableHeaderWithStickyHeader.bind(this))
     this.alignTableHeaderWithStickyHeader()
+
+    ws.registerEvtHandler('newTransaction', () => {
+      // reload the history to show new txs and the status of mined txs, search results are left as they are
+      if (this.searchInputTarget.value.trim() === '' && !this.isLoading) {
+        this.historyTableTarget.innerHTML = ''
+        this.nextPage = 1
+        this.isLoading = true
+        this.fetchMoreTxs()
+      }
+    })
   }
 
   alignTableHeaderWithStickyHeader () {
@@ -78,23 +89,74 @@ export default class extends Controller {
   }
 
   selectedFilterChanged () {
+    // keep the filter and export forms in sync with the selected filter
+    this.filterInputTargets.forEach(input => {
+      input.value = this.selectedFilterTarget.value
+    })
+    this.searchInputTarget.value = ''
     this.historyTableTarget.innerHTML = ''
     hide(this.transactionCountContainerTarget)
     this.nextPage = 1
     this.fetchMoreTxs()
   }
 
+  searchInputChanged () {
+    // wait for the user to stop typing before searching
+    clearTimeout(this.searchTimer)
+    this.searchTimer = setTimeout(this.searchLabels.bind(this), 300)
+  }
+
+  searchLabels () {
+    const query = this.searchInputTarget.value.trim()
+    if (query === '') {
+      this.selectedFilterChanged()
+      return
+    }
+
+    this.historyTableTarget.innerHTML = ''
+    hide(this.transactionCountContainerTarget)
+    show(this.loadingIndicatorTarget)
+    // search results are not paginated, disable loading more txs on scroll
+    this.nextPage = null
+    this.isLoading = true
+
+    const _this = this
+    axios.get(`/search-history?query=${encodeURIComponent(query)}`)
+      .then(function (response) {
+        // discard this response if the search text has changed before the result is gotten
+        if (_this.searchInputTarget.value.trim() !== query) {
+          return
+        }
+        let result = response.data
+        if (result.success) {
+          _this.historyTableTarget.innerHTML = ''
+          _this.transactionTotalCountTarget.textContent = result.transactionTotalCount
+          show(_this.transactionCountContainerTarget)
+          hide(_this.errorMessageTarget)
+          _this.displayTxs(result.txs)
+        } else {
+          _this.setErrorMessage(result.message)
+        }
+      }).catch(function (e) {
+        console.log(e)
+        _this.setErrorMessage('A server error occurred')
+      }).then(function () {
+        _this.isLoading = false
+        hide(_this.loadingIndicatorTarget)
+      })
+  }
+
   fetchMoreTxs () {
     show(this.loadingIndicatorTarget)
 
     const filter = this.selectedFilterTarget.value
 
     const _this = this
-    axios.get(`/next-history-page?page=${this.nextPage}&filter=${filter}`)
+    axios.get(`/next-history-page?page=${this.nextPage}&filter=${filter}&${this.criteriaQuery()}`)
       .then(function (response) {
         // since results are appended to the table, discard this response
-        // if the user has changed the filter before the result is gotten
-        if (_this.selectedFilterTarget.value !== filter) {
+        // if the user has changed the filter or started a search before the result is gotten
+        if (_this.selectedFilterTarget.value !== filter || _this.searchInputTarget.value.trim() !== '') {
           return
         }
         let result = response.data
@@ -120,6 +182,14 @@ export default class extends Controller {
       })
   }
 
+  criteriaQuery () {
+    // the date, amount, address, account and hash filters are set in the page url by the filter form
+    const params = new URLSearchParams(window.location.search)
+    params.delete('page')
+    params.delete('filter')
+    return params.toString()
+  }
+
   displayTxs (txs) {
     const directions = ['Sent', 'Received', 'Yourself']
     const txDirection = (direction) => {
@@ -183,6 +253,7 @@ export default class extends Controller {
 
       fields[6].innerText = tx.status
       fields[7].innerHTML = `<a href="/transaction-details/${tx.hash}">${truncate(tx.hash, 10)}</a>`
+      fields[8].innerText = tx.label
 
       _this.historyTableTarget.appendChild(txRow)
     })
@@ -8,7 +8,8 @@ export default class extends Controller {
       'errorMessage',
       'account',
       'generatedAddressContainer', 'generatedAddress', 'qrCodeImage',
-      'generateNewAddressButton'
+      'generateNewAddressButton',
+      'requestAmount', 'requestLabel', 'requestMessage', 'paymentUriContainer', 'paymentUri'
     ]
   }
 
@@ -21,6 +22,11 @@ export default class extends Controller {
     showSuccessNotification('Copied to clipboard')
   }
 
+  copyPaymentUriToClipboard () {
+    copyToClipboard(this.paymentUriTarget.textContent)
+    showSuccessNotification('Copied to clipboard')
+  }
+
   generateNewAddress () {
     this.generateAddress(true)
   }
@@ -32,10 +38,14 @@ export default class extends Controller {
     this.generateNewAddressButtonTarget.setAttribute('disabled', 'disabled')
     clearMessages(this)
 
-    let url = '/generate-address/' + this.accountTarget.value
+    const params = new URLSearchParams()
     if (newAddress) {
-      url += '?new=yes'
+      params.append('new', 'yes')
     }
+    params.append('amount', this.requestAmountTarget.value)
+    params.append('label', this.requestLabelTarget.value)
+    params.append('message', this.requestMessageTarget.value)
+    const url = `/generate-address/${this.accountTarget.value}?${params.toString()}`
 
     const _this = this
     axios.get(url)
@@ -44,6 +54,12 @@ export default class extends Controller {
         if (result.success) {
           _this.generatedAddressTarget.textContent = result.generatedAddress
           _this.qrCodeImageTarget.setAttribute('src', `data:image/png;base64,${result.qrCodeBase64Image}`)
+          _this.paymentUriTarget.textContent = result.paymentURI || ''
+          if (result.paymentURI) {
+            show(_this.paymentUriContainerTarget)
+          } else {
+            hide(_this.paymentUriContainerTarget)
+          }
           show(_this.generatedAddressContainerTarget)
         } else {
           setErrorMessage(_this, result.errorMessage)
@@ -0,0 +1,118 @@
+import { Controller } from 'stimulus'
+import axios from 'axios'
+import { hide, show } from '../utils'
+
+export default class extends Controller {
+  static get targets () {
+    return [
+      'signAddress', 'signMessage', 'signPassphrase', 'signMessageErrorMessage', 'signature', 'signatureText',
+      'verifyAddress', 'verifyMessage', 'verifySignature', 'verifyMessageErrorMessage', 'verificationResult',
+      'verifySeed', 'verifySeedErrorMessage', 'seedBackupStatus'
+    ]
+  }
+
+  signMessage (e) {
+    e.preventDefault()
+    hide(this.signMessageErrorMessageTarget)
+    hide(this.signatureTarget)
+
+    if (this.signAddressTarget.value === '' || this.signMessageTarget.value === '') {
+      this.showError(this.signMessageErrorMessageTarget, 'Address and message are required')
+      return
+    }
+
+    let submitBtn = e.currentTarget
+    submitBtn.textContent = 'Signing...'
+    submitBtn.setAttribute('disabled', true)
+
+    const _this = this
+    const postData = $('#sign-message-form').serialize()
+    axios.post('/sign-message', postData).then((response) => {
+      let result = response.data
+      if (result.error) {
+        _this.showError(_this.signMessageErrorMessageTarget, result.error)
+      } else {
+        _this.signPassphraseTarget.value = ''
+        _this.signatureTextTarget.textContent = result.signature
+        show(_this.signatureTarget)
+      }
+    }).catch(() => {
+      _this.showError(_this.signMessageErrorMessageTarget, 'A server error occurred')
+    }).then(() => {
+      submitBtn.textContent = 'Sign'
+      submitBtn.removeAttribute('disabled')
+    })
+  }
+
+  verifyMessage (e) {
+    e.preventDefault()
+    hide(this.verifyMessageErrorMessageTarget)
+    hide(this.verificationResultTarget)
+
+    if (this.verifyAddressTarget.value === '' || this.verifyMessageTarget.value === '' || this.verifySignatureTarget.value === '') {
+      this.showError(this.verifyMessageErrorMessageTarget, 'Address, message and signature are required')
+      return
+    }
+
+    const _this = this
+    const postData = $('#verify-message-form').serialize()
+    axios.post('/verify-message', postData).then((response) => {
+      let result = response.data
+      if (result.error) {
+        _this.showError(_this.verifyMessageErrorMessageTarget, result.error)
+        return
+      }
+
+      _this.verificationResultTarget.classList.remove('alert-success', 'alert-danger')
+      if (result.valid) {
+        _this.verificationResultTarget.classList.add('alert-success')
+        _this.verificationResultTarget.textContent = 'Valid signature'
+      } else {
+        _this.verificationResultTarget.classList.add('alert-danger')
+        _this.verificationResultTarget.textContent = 'Invalid signature'
+      }
+      show(_this.verificationResultTarget)
+    }).catch(() => {
+      _this.showError(_this.verifyMessageErrorMessageTarget, 'A server error occurred')
+    })
+  }
+
+  verifySeedBackup (e) {
+    e.preventDefault()
+    hide(this.verifySeedErrorMessageTarget)
+
+    if (this.verifySeedTarget.value.trim() === '') {
+      this.showError(this.verifySeedErrorMessageTarget, 'Seed is required')
+      return
+    }
+
+    let submitBtn = e.currentTarget
+    submitBtn.textContent = 'Verifying...'
+    submitBtn.setAttribute('disabled', true)
+
+    const _this = this
+    const postData = $('#verify-seed-backup-form').serialize()
+    axios.post('/verify-seed-backup', postData).then((response) => {
+      let result = response.data
+      if (result.error) {
+        _this.showError(_this.verifySeedErrorMessageTarget, result.error)
+        return
+      }
+
+      _this.verifySeedTarget.value = ''
+      _this.seedBackupStatusTarget.classList.remove('alert-warning')
+      _this.seedBackupStatusTarget.classList.add('alert-success')
+      _this.seedBackupStatusTarget.textContent = 'Your seed backup has been verified'
+    }).catch(() => {
+      _this.showError(_this.verifySeedErrorMessageTarget, 'A server error occurred')
+    }).then(() => {
+      submitBtn.textContent = 'Verify'
+      submitBtn.removeAttribute('disabled')
+    })
+  }
+
+  showError (target, message) {
+    target.textContent = message
+    show(target)
+  }
+}
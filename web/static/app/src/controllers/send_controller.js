@@ -1,6 +1,6 @@
 import { Controller } from 'stimulus'
 import axios from 'axios'
-import { hide, show, listenForBalanceUpdate, isHidden } from '../utils'
+import { hide, show, listenForBalanceUpdate, isHidden, showSuccessNotification } from '../utils'
 
 export default class extends Controller {
   static get targets () {
@@ -8,10 +8,10 @@ export default class extends Controller {
       'errorMessage', 'successMessage',
       'form',
       'sourceAccount', 'sourceAccountSpan',
-      'spendUnconfirmed',
+      'spendUnconfirmed', 'feeRateOption', 'feeRate',
       'destinations', 'destinationTemplate', 'address', 'addressError', 'amount', 'amountUsd', 'amountError', 'maxSendAmountCheck', 'removeDestinationBtn',
       'destinationAccounts', 'destinationAccountTemplate', 'destinationAccount',
-      'useCustom', 'toggleCustomInputPnl', 'fetchingUtxos', 'utxoSelectionProgressBar', 'customInputsTable', 'utxoCheckbox',
+      'useCustom', 'toggleCustomInputPnl', 'coinSelection', 'selectCoinsButton', 'fetchingUtxos', 'utxoSelectionProgressBar', 'customInputsTable', 'utxoCheckbox',
       'changeOutputs', 'numberOfChangeOutputs', 'useRandomChangeOutputs', 'generateOutputsButton', 'generatedChangeOutputs',
       'changeOutputTemplate', 'changeOutputPercentage', 'changeOutputAddress', 'changeOutputAmount',
       'errors',
@@ -37,7 +37,8 @@ export default class extends Controller {
     }
 
     this.exchangeRate = parseFloat(this.sourceAccountTarget.getAttribute('data-echange-rate'))
-    if (this.exchangeRate === 0) {
+    this.fiatCurrency = this.sourceAccountTarget.getAttribute('data-fiat-currency')
+    if (!(this.exchangeRate > 0)) {
       this.exchangeRateTarget.textContent = 'N/A'
       this.amountUsdTargets.forEach(target => {
         hide(target.parentElement)
@@ -66,6 +67,23 @@ export default class extends Controller {
     }
   }
 
+  feeRateOptionChanged () {
+    if (this.feeRateOptionTarget.value === '') {
+      this.feeRateTarget.removeAttribute('readonly')
+      this.feeRateTarget.focus()
+      return
+    }
+
+    this.feeRateTarget.setAttribute('readonly', 'readonly')
+    this.feeRateTarget.value = this.feeRateOptionTarget.value
+    this.feeRateChanged()
+  }
+
+  feeRateChanged () {
+    this.updateMaxAmountFieldIfSet()
+    this.updateSendButtonState()
+  }
+
   toggleUseCustom () {
     if (!this.useCustomTarget.checked) {
       hide(this.toggleCustomInputPnlTarget.parentElement)
@@ -248,7 +266,7 @@ export default class extends Controller {
 
     this.updateSendButtonState()
 
-    axios.post('/validate-address?address=' + editedAddress.value)
+    axios.post('/validate-address?address=' + encodeURIComponent(editedAddress.value))
       .then((response) => {
         let result = response.data
         if (!result.valid) {
@@ -256,12 +274,42 @@ export default class extends Controller {
           return
         }
         _this.clearDestinationFieldError(editedAddress)
+        if (result.paymentRequest) {
+          _this.fillPaymentRequest(editedAddress, result.paymentRequest)
+        }
       })
       .catch(() => {
         _this.setDestinationFieldError(editedAddress, 'Cannot validate address. You can continue if you are sure')
       })
   }
 
+  // fillPaymentRequest replaces a payment uri entered in the address field with the address it pays to
+  // and sets the requested amount, if any, in the amount field of the same destination.
+  fillPaymentRequest (addressInput, paymentRequest) {
+    addressInput.value = paymentRequest.address
+    if (paymentRequest.amount > 0) {
+      const amountInput = addressInput.closest('.destination').querySelector('input[name="destination-amount"]')
+      amountInput.value = paymentRequest.amount
+      // trigger amount validation
+      amountInput.dispatchEvent(new Event('keyup'))
+    }
+    if (paymentRequest.label || paymentRequest.message) {
+      showSuccessNotification([paymentRequest.label, paymentRequest.message].filter(text => text).join(': '))
+    }
+  }
+
+  contactSelected (event) {
+    const contactSelect = event.currentTarget
+    if (contactSelect.value === '') {
+      return
+    }
+
+    const addressInput = contactSelect.closest('.destination').querySelector('input[name="destination-address"]')
+    addressInput.value = contactSelect.value
+    // trigger address validation
+    addressInput.dispatchEvent(new Event('change'))
+  }
+
   destinationAmountEdited (event) {
     this.updateSendButtonState()
 
@@ -690,10 +738,14 @@ export default class extends Controller {
                     <input data-target='send.utxoCheckbox' data-action='click->send#utxoSelectedOrDeselected' type='checkbox' class='custom-input' 
                     name='utxo' value='${utxo.key}' data-amount='${dcrAmount}' data-address='${utxo.address}' />
                   </td>
-                  <td width='40%'>${utxo.address}</td>
+                  <td width='35%'>${utxo.address}</td>
                   <td width='15%'>${dcrAmount} DCR</td>
-                  <td width='25%'>${receiveDateTime}</td>
+                  <td width='20%'>${receiveDateTime}</td>
                   <td width='15%'>${utxo.confirmations} confirmation(s)</td>
+                  <td width='10%'>
+                    <button data-action='click->send#toggleUtxoLock' data-key='${utxo.key}' data-locked='${utxo.locked}'
+                      type='button' class='btn btn-sm ${utxo.locked ? 'btn-warning' : 'btn-outline-secondary'}'>${utxo.locked ? 'Frozen' : 'Freeze'}</button>
+                  </td>
                 </tr>`
       })
 
@@ -734,6 +786,71 @@ export default class extends Controller {
     })
   }
 
+  selectCoins () {
+    if (!this.destinationFieldsValid()) {
+      return
+    }
+
+    this.selectCoinsButtonTarget.setAttribute('disabled', 'disabled')
+    this.selectCoinsButtonTarget.innerHTML = 'Selecting...'
+
+    let queryParams = $('#send-form').serialize()
+    queryParams += `&totalSelectedInputAmountDcr=${this.getSelectedInputsSum()}`
+    if (this.spendUnconfirmedTarget.checked) {
+      queryParams += '&spend-unconfirmed=true'
+    }
+
+    this.setBusy(true)
+    let _this = this
+    axios.get('/select-coins?' + queryParams)
+      .then((response) => {
+        let result = response.data
+        if (result.success) {
+          _this.utxoCheckboxTargets.forEach(utxoCheckbox => {
+            utxoCheckbox.checked = result.message.indexOf(utxoCheckbox.value) >= 0
+          })
+          _this.utxoSelectedOrDeselected()
+        } else {
+          _this.setErrorMessage(result.message)
+        }
+      })
+      .catch(() => {
+        _this.setErrorMessage('A server error occurred')
+      })
+      .then(() => {
+        _this.selectCoinsButtonTarget.removeAttribute('disabled')
+        _this.selectCoinsButtonTarget.innerHTML = 'Select Automatically'
+        _this.setBusy(false)
+      })
+  }
+
+  toggleUtxoLock (event) {
+    const lockButton = event.currentTarget
+    const lock = lockButton.getAttribute('data-locked') !== 'true'
+    const postData = `output-key=${encodeURIComponent(lockButton.getAttribute('data-key'))}&lock=${lock}`
+
+    lockButton.setAttribute('disabled', 'disabled')
+    let _this = this
+    axios.post('/lock-unspent', postData)
+      .then((response) => {
+        let result = response.data
+        if (result.success) {
+          lockButton.setAttribute('data-locked', lock)
+          lockButton.innerHTML = lock ? 'Frozen' : 'Freeze'
+          lockButton.classList.toggle('btn-warning', lock)
+          lockButton.classList.toggle('btn-outline-secondary', !lock)
+        } else {
+          _this.setErrorMessage(result.message)
+        }
+      })
+      .catch(() => {
+        _this.setErrorMessage('A server error occurred')
+      })
+      .then(() => {
+        lockButton.removeAttribute('disabled')
+      })
+  }
+
   // triggered when destination amount fields are edited or when utxo is selected
   calculateCustomInputsPercentage () {
     if (!this.useCustomTarget.checked) {
@@ -961,7 +1078,7 @@ export default class extends Controller {
         const amount = parseFloat(utxoCheckbox.getAttribute('data-amount'))
         let usdAmountStr = ''
         if (_this.exchangeRate > 0) {
-          usdAmountStr = `($${(amount * _this.exchangeRate).toFixed(2)}) `
+          usdAmountStr = `(${(amount * _this.exchangeRate).toFixed(2)} ${_this.fiatCurrency}) `
         }
         inputs += `<li>${amount} DCR ${usdAmountStr}from ${utxo}`
       })
@@ -985,7 +1102,7 @@ export default class extends Controller {
         let amount = parseFloat(currentAmountTarget.value)
         let usdAmountStr = ''
         if (_this.exchangeRate > 0) {
-          usdAmountStr = `($${(amount * _this.exchangeRate).toFixed(2)}) `
+          usdAmountStr = `(${(amount * _this.exchangeRate).toFixed(2)} ${_this.fiatCurrency}) `
         }
 
         destinations += `<li>${amount} DCR ${usdAmountStr}to ${addressTarget.value}</li>`
@@ -1005,7 +1122,7 @@ export default class extends Controller {
         let amount = parseFloat(currentAmountTarget.value)
         let usdAmountStr = ''
         if (_this.exchangeRate > 0) {
-          usdAmountStr = `($${(amount * _this.exchangeRate).toFixed(2)}) `
+          usdAmountStr = `(${(amount * _this.exchangeRate).toFixed(2)} ${_this.fiatCurrency}) `
         }
         const accountName = accountTarget.options[accountTarget.selectedIndex].getAttribute('data-account-name')
         destinations += `<li>${parseFloat(currentAmountTarget.value)} DCR ${usdAmountStr}to <b>${accountName}</b></li>`
@@ -1027,7 +1144,7 @@ export default class extends Controller {
       let amount = parseFloat(currentAmountTarget.value)
       let usdAmountStr = ''
       if (_this.exchangeRate > 0) {
-        usdAmountStr = `($${(amount * _this.exchangeRate).toFixed(2)}) `
+        usdAmountStr = `(${(amount * _this.exchangeRate).toFixed(2)} ${_this.fiatCurrency}) `
       }
       changeOutputs += `<li>${(amount.toFixed(2))} DCR ${usdAmountStr}to ${changeOutputAddressTarget.value} (change)</li>`
     })
@@ -1060,6 +1177,14 @@ export default class extends Controller {
       valid = false
     }
 
+    const minFeeRate = parseInt(this.feeRateTarget.getAttribute('min'))
+    if (!(parseInt(this.feeRateTarget.value) >= minFeeRate)) {
+      if (!dontModifyErrorOutput) {
+        this.showError(`The fee rate must be at least ${minFeeRate} atoms/kB`)
+      }
+      valid = false
+    }
+
     return valid
   }
 
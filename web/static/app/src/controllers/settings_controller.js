@@ -1,6 +1,7 @@
 import { Controller } from 'stimulus'
 import axios from 'axios'
 import { hide, show, showErrorNotification, showSuccessNotification } from '../utils'
+import ws from '../services/messagesocket_service'
 
 export default class extends Controller {
   static get targets () {
@@ -8,11 +9,30 @@ export default class extends Controller {
       'oldPassword', 'oldPasswordError', 'newPassword', 'newPasswordError', 'confirmPassword',
       'confirmPasswordError', 'changePasswordErrorMessage',
       'spendUnconfirmedFunds', 'showIncomingTransactionNotification', 'showNewBlockNotification',
-      'changeCurrencyConverterErrorMessage', 'currencyConverterNone', 'currencyConverterBitrex', 'updateCurrencyConverterButton',
-      'rescanBlockChainButton'
+      'changeCurrencyConverterErrorMessage', 'currencyConverter', 'fiatCurrency', 'updateCurrencyConverterButton',
+      'rescanBlockChainButton', 'cancelRescanButton'
     ]
   }
 
+  connect () {
+    ws.registerEvtHandler('updateRescanProgress', progress => {
+      if (progress.error) {
+        showErrorNotification(`Blockchain rescan failed. ${progress.error}`)
+      } else if (progress.canceled) {
+        showSuccessNotification('Blockchain rescan canceled')
+      } else if (!progress.done) {
+        this.rescanBlockChainButtonTarget.textContent = `Rescan Blockchain (In Progress ${progress.percentage}%)`
+        show(this.cancelRescanButtonTarget)
+        return
+      } else {
+        showSuccessNotification('Blockchain rescan completed')
+      }
+
+      this.rescanBlockChainButtonTarget.textContent = 'Rescan Blockchain'
+      hide(this.cancelRescanButtonTarget)
+    })
+  }
+
   changePassword (e) {
     e.preventDefault()
     if (!this.validateChangePasswordFields()) {
@@ -142,17 +162,19 @@ export default class extends Controller {
 
   updateCurrencyConverter () {
     const _this = this
-    const postData = `currency-converter=${(this.currencyConverterBitrexTarget.checked ? 'bitrex' : 'none')}`
+    const postData = `currency-converter=${this.currencyConverterTarget.value}&fiat-currency=${this.fiatCurrencyTarget.value}`
     axios.put('/settings', postData).then((response) => {
       let result = response.data
       if (result.error) {
         _this.changeCurrencyConverterErrorMessageTarget.textContent = result.error ? result.error : 'Something went wrong, please try again later'
+        show(_this.changeCurrencyConverterErrorMessageTarget)
         return
       }
       showSuccessNotification('Changes saved successfully')
       $('#currency-converter-modal').modal('hide')
     }).catch(() => {
       _this.changeCurrencyConverterErrorMessageTarget.textContent = 'A server error occurred'
+      show(_this.changeCurrencyConverterErrorMessageTarget)
     })
   }
 
@@ -166,19 +188,30 @@ export default class extends Controller {
     axios.post('/rescan-blockchain').then((response) => {
       let result = response.data
       if (result.error) {
-        showErrorNotification(`Block headers rescan failed. ${result.error}`)
+        showErrorNotification(`Blockchain rescan failed. ${result.error}`)
         _this.rescanBlockChainButtonTarget.textContent = 'Rescan Blockchain'
       } else {
-        showSuccessNotification('Block headers rescan started')
+        showSuccessNotification('Blockchain rescan started')
         _this.rescanBlockChainButtonTarget.textContent = 'Rescan Blockchain (In Progress)'
-        document.getElementById('blocks-rescan-progress').innerText = 'Block headers rescan in progress'
+        show(_this.cancelRescanButtonTarget)
       }
     }).catch(() => {
-      showErrorNotification('Block headers rescan failed. A server error occurred')
+      showErrorNotification('Blockchain rescan failed. A server error occurred')
       _this.rescanBlockChainButtonTarget.textContent = 'Rescan Blockchain'
     })
   }
 
+  cancelRescan () {
+    axios.post('/cancel-rescan').then((response) => {
+      let result = response.data
+      if (result.error) {
+        showErrorNotification(result.error)
+      }
+    }).catch(() => {
+      showErrorNotification('Cancelling the rescan failed. A server error occurred')
+    })
+  }
+
   deleteWallet () {
     axios.delete('/delete-wallet').then((response) => {
       let result = response.data
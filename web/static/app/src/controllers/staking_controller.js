@@ -1,12 +1,16 @@
 import { Controller } from 'stimulus'
 import axios from 'axios'
 import { hide, show, listenForBalanceUpdate } from '../utils'
+import ws from '../services/messagesocket_service'
 
 export default class extends Controller {
   static get targets () {
     return [
       'errorMessage', 'successMessage',
-      'sourceAccount', 'numberOfTickets', 'spendUnconfirmed', 'errors', 'submitButton',
+      'sourceAccount', 'numberOfTickets', 'spendUnconfirmed', 'feeRateOption', 'feeRate', 'errors', 'submitButton',
+      'revokeErrorMessage', 'revokeSuccessMessage', 'revokeButton',
+      'ticketBuyerRunning', 'ticketBuyerTicketsPurchased', 'ticketBuyerLastDecision', 'ticketBuyerErrorMessage',
+      'ticketBuyerStartButton', 'ticketBuyerStopButton',
       // from wallet passphrase modal (utils.html)
       'walletPassphrase', 'passwordError'
     ]
@@ -14,6 +18,20 @@ export default class extends Controller {
 
   connect () {
     listenForBalanceUpdate(this)
+    ws.registerEvtHandler('updateTicketBuyer', status => {
+      this.updateTicketBuyerStatus(status)
+    })
+  }
+
+  feeRateOptionChanged () {
+    if (this.feeRateOptionTarget.value === '') {
+      this.feeRateTarget.removeAttribute('readonly')
+      this.feeRateTarget.focus()
+      return
+    }
+
+    this.feeRateTarget.setAttribute('readonly', 'readonly')
+    this.feeRateTarget.value = this.feeRateOptionTarget.value
   }
 
   validateForm () {
@@ -30,6 +48,12 @@ export default class extends Controller {
       formValid = false
     }
 
+    const minFeeRate = parseInt(this.feeRateTarget.getAttribute('min'))
+    if (!(parseInt(this.feeRateTarget.value) >= minFeeRate)) {
+      this.showError(`The fee rate must be at least ${minFeeRate} atoms/kB`)
+      formValid = false
+    }
+
     return formValid
   }
 
@@ -40,6 +64,16 @@ export default class extends Controller {
 
     $('#passphrase-modal').modal('hide')
 
+    // the passphrase modal is shared by the purchase, revoke and ticket buyer forms
+    if (this.passphraseAction === 'revoke') {
+      this.submitRevokeTickets()
+      return
+    }
+    if (this.passphraseAction === 'ticketbuyer') {
+      this.startTicketBuyer()
+      return
+    }
+
     this.submitButtonTarget.innerHTML = 'Purchasing...'
     this.submitButtonTarget.setAttribute('disabled', 'disabled')
 
@@ -81,7 +115,106 @@ export default class extends Controller {
     return true
   }
 
+  submitRevokeTickets () {
+    this.revokeButtonTarget.innerHTML = 'Revoking...'
+    this.revokeButtonTarget.setAttribute('disabled', 'disabled')
+
+    const postData = 'wallet-passphrase=' + encodeURIComponent(this.walletPassphraseTarget.value)
+    this.walletPassphraseTarget.value = ''
+
+    let _this = this
+    axios.post('/revoke-tickets', postData).then((response) => {
+      let result = response.data
+      if (!result.success) {
+        hide(_this.revokeSuccessMessageTarget)
+        _this.revokeErrorMessageTarget.textContent = result.message
+        show(_this.revokeErrorMessageTarget)
+      } else {
+        hide(_this.revokeErrorMessageTarget)
+        _this.revokeSuccessMessageTarget.textContent = 'Missed and expired tickets revoked successfully'
+        show(_this.revokeSuccessMessageTarget)
+      }
+    }).catch(() => {
+      _this.revokeErrorMessageTarget.textContent = 'A server error occurred'
+      show(_this.revokeErrorMessageTarget)
+    }).then(() => {
+      _this.revokeButtonTarget.innerHTML = 'Revoke'
+      _this.revokeButtonTarget.removeAttribute('disabled')
+    })
+  }
+
+  getWalletPassphraseAndRevoke () {
+    hide(this.revokeErrorMessageTarget)
+    hide(this.revokeSuccessMessageTarget)
+    this.passphraseAction = 'revoke'
+    $('#passphrase-modal').modal()
+  }
+
+  getWalletPassphraseAndStartTicketBuyer () {
+    hide(this.ticketBuyerErrorMessageTarget)
+    this.passphraseAction = 'ticketbuyer'
+    $('#passphrase-modal').modal()
+  }
+
+  startTicketBuyer () {
+    this.ticketBuyerStartButtonTarget.setAttribute('disabled', 'disabled')
+
+    const postData = 'wallet-passphrase=' + encodeURIComponent(this.walletPassphraseTarget.value)
+    this.walletPassphraseTarget.value = ''
+
+    this.submitTicketBuyerRequest('/ticket-buyer/start', postData, this.ticketBuyerStartButtonTarget)
+  }
+
+  stopTicketBuyer () {
+    this.ticketBuyerStopButtonTarget.setAttribute('disabled', 'disabled')
+    this.submitTicketBuyerRequest('/ticket-buyer/stop', '', this.ticketBuyerStopButtonTarget)
+  }
+
+  submitTicketBuyerRequest (url, postData, button) {
+    let _this = this
+    axios.post(url, postData).then((response) => {
+      let result = response.data
+      if (!result.success) {
+        _this.ticketBuyerErrorMessageTarget.textContent = result.message
+        show(_this.ticketBuyerErrorMessageTarget)
+      } else {
+        _this.updateTicketBuyerStatus(result.message)
+      }
+    }).catch(() => {
+      _this.ticketBuyerErrorMessageTarget.textContent = 'A server error occurred'
+      show(_this.ticketBuyerErrorMessageTarget)
+    }).then(() => {
+      button.removeAttribute('disabled')
+    })
+  }
+
+  updateTicketBuyerStatus (status) {
+    this.ticketBuyerRunningTarget.textContent = status.running ? 'Running' : 'Stopped'
+    this.ticketBuyerTicketsPurchasedTarget.textContent = status.ticketsPurchased
+    let lastDecision = status.lastDecision
+    if (status.lastBlock > 0) {
+      lastDecision = 'Block ' + status.lastBlock + ': ' + lastDecision
+    }
+    this.ticketBuyerLastDecisionTarget.textContent = lastDecision
+
+    if (status.error) {
+      this.ticketBuyerErrorMessageTarget.textContent = status.error
+      show(this.ticketBuyerErrorMessageTarget)
+    } else {
+      hide(this.ticketBuyerErrorMessageTarget)
+    }
+
+    if (status.running) {
+      hide(this.ticketBuyerStartButtonTarget)
+      show(this.ticketBuyerStopButtonTarget)
+    } else {
+      show(this.ticketBuyerStartButtonTarget)
+      hide(this.ticketBuyerStopButtonTarget)
+    }
+  }
+
   getWalletPassphraseAndSubmit () {
+    this.passphraseAction = 'purchase'
     this.clearMessages()
     if (!this.validateForm()) {
       return
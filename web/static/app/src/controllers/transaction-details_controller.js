@@ -0,0 +1,29 @@
+import { Controller } from 'stimulus'
+import axios from 'axios'
+import { showErrorNotification, showSuccessNotification } from '../utils'
+
+export default class extends Controller {
+  static get targets () {
+    return [
+      'label'
+    ]
+  }
+
+  saveLabel (e) {
+    e.preventDefault()
+    const txHash = this.data.get('hash')
+    const postData = `hash=${txHash}&label=${encodeURIComponent(this.labelTarget.value)}`
+
+    axios.post('/label-transaction', postData).then((response) => {
+      const result = response.data
+      if (result.error) {
+        showErrorNotification(result.error)
+      } else {
+        this.labelTarget.value = result.label
+        showSuccessNotification(result.label === '' ? 'Transaction label removed' : 'Transaction label saved')
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+    })
+  }
+}
@@ -0,0 +1,26 @@
+import { Controller } from 'stimulus'
+import axios from 'axios'
+import { showErrorNotification } from '../utils'
+
+export default class extends Controller {
+  static get targets () {
+    return [
+      'wallet'
+    ]
+  }
+
+  switchWallet () {
+    const postData = `wallet=${encodeURIComponent(this.walletTarget.value)}`
+    axios.post('/switch-wallet', postData).then((response) => {
+      let result = response.data
+      if (result.success) {
+        // reload the page to show the selected wallet's data
+        window.location.reload()
+      } else {
+        showErrorNotification(result.error ? result.error : 'Something went wrong, please try again later')
+      }
+    }).catch(() => {
+      showErrorNotification('A server error occurred')
+    })
+  }
+}